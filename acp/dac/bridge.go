@@ -12,11 +12,13 @@ package dac
 
 import (
 	"context"
+	"sort"
 	"strconv"
 
 	protoTypes "github.com/cosmos/gogoproto/types"
 
 	"github.com/sourcenetwork/corelog"
+	"github.com/sourcenetwork/immutable"
 	"github.com/valyala/fastjson"
 
 	"github.com/sourcenetwork/defradb/acp"
@@ -129,6 +131,20 @@ func (a *bridgeDocumentACP) IsDocRegistered(
 	resourceName string,
 	docID string,
 ) (bool, error) {
+	maybeActor, err := a.DocOwner(ctx, policyID, resourceName, docID)
+	if err != nil {
+		return false, err
+	}
+
+	return maybeActor.HasValue(), nil
+}
+
+func (a *bridgeDocumentACP) DocOwner(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+	docID string,
+) (immutable.Option[string], error) {
 	maybeActor, err := a.clientACP.ObjectOwner(
 		ctx,
 		policyID,
@@ -136,10 +152,16 @@ func (a *bridgeDocumentACP) IsDocRegistered(
 		docID,
 	)
 	if err != nil {
-		return false, acp.NewErrFailedToCheckIfDocIsRegisteredWithACP(err, "Local", policyID, resourceName, docID)
+		return immutable.None[string](), acp.NewErrFailedToCheckIfDocIsRegisteredWithACP(
+			err,
+			"Local",
+			policyID,
+			resourceName,
+			docID,
+		)
 	}
 
-	return maybeActor.HasValue(), nil
+	return maybeActor, nil
 }
 
 func (a *bridgeDocumentACP) CheckDocAccess(
@@ -232,6 +254,152 @@ func (a *bridgeDocumentACP) CheckDocAccess(
 	return hasAccess, nil
 }
 
+func (a *bridgeDocumentACP) FieldPermissions(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+) ([]acpTypes.FieldResourcePermission, error) {
+	resource, err := a.resource(ctx, policyID, resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldPermissions := []acpTypes.FieldResourcePermission{}
+	for permissionName := range resource.Permissions {
+		fieldPermission, ok := acpTypes.ParseFieldResourcePermission(permissionName)
+		if ok {
+			fieldPermissions = append(fieldPermissions, fieldPermission)
+		}
+	}
+	// Permissions are held in a map, sort them so that the result is deterministic.
+	sort.Slice(fieldPermissions, func(i, j int) bool {
+		return fieldPermissions[i].String() < fieldPermissions[j].String()
+	})
+
+	return fieldPermissions, nil
+}
+
+func (a *bridgeDocumentACP) CheckDocFieldAccess(
+	ctx context.Context,
+	permission acpTypes.FieldResourcePermission,
+	actorID string,
+	policyID string,
+	resourceName string,
+	docID string,
+) (bool, error) {
+	hasAccess, err := a.clientACP.VerifyAccessRequest(
+		ctx,
+		permission,
+		actorID,
+		policyID,
+		resourceName,
+		docID,
+	)
+
+	if err != nil {
+		return false, acp.NewErrFailedToVerifyDocAccessWithACP(
+			err,
+			"Local",
+			permission.String(),
+			policyID,
+			actorID,
+			resourceName,
+			docID,
+		)
+	}
+
+	log.InfoContext(
+		ctx,
+		"Document field accessible="+strconv.FormatBool(hasAccess),
+		corelog.Any("Permission", permission.String()),
+		corelog.Any("PolicyID", policyID),
+		corelog.Any("Resource", resourceName),
+		corelog.Any("ActorID", actorID),
+		corelog.Any("DocID", docID),
+	)
+
+	return hasAccess, nil
+}
+
+func (a *bridgeDocumentACP) HasCollectionPermission(
+	ctx context.Context,
+	permission acpTypes.CollectionResourcePermission,
+	policyID string,
+	resourceName string,
+) (bool, error) {
+	resource, err := a.resource(ctx, policyID, resourceName)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := resource.Permissions[permission.String()]
+	return ok, nil
+}
+
+func (a *bridgeDocumentACP) CheckCollectionAccess(
+	ctx context.Context,
+	permission acpTypes.CollectionResourcePermission,
+	actorID string,
+	policyID string,
+	resourceName string,
+	collectionID string,
+) (bool, error) {
+	hasAccess, err := a.clientACP.VerifyAccessRequest(
+		ctx,
+		permission,
+		actorID,
+		policyID,
+		resourceName,
+		collectionID,
+	)
+
+	if err != nil {
+		return false, acp.NewErrFailedToVerifyDocAccessWithACP(
+			err,
+			"Local",
+			permission.String(),
+			policyID,
+			actorID,
+			resourceName,
+			collectionID,
+		)
+	}
+
+	log.InfoContext(
+		ctx,
+		"Collection accessible="+strconv.FormatBool(hasAccess),
+		corelog.Any("Permission", permission.String()),
+		corelog.Any("PolicyID", policyID),
+		corelog.Any("Resource", resourceName),
+		corelog.Any("ActorID", actorID),
+		corelog.Any("CollectionID", collectionID),
+	)
+
+	return hasAccess, nil
+}
+
+// resource returns the resource with the given name on the policy matching the given policyID.
+func (a *bridgeDocumentACP) resource(
+	ctx context.Context,
+	policyID string,
+	resourceName string,
+) (*acpTypes.Resource, error) {
+	maybePolicy, err := a.clientACP.Policy(ctx, policyID)
+	if err != nil {
+		return nil, acp.NewErrPolicyValidationFailedWithACP(err, policyID)
+	}
+	if !maybePolicy.HasValue() {
+		return nil, acp.NewErrPolicyDoesNotExistWithACP(nil, policyID)
+	}
+
+	resource, ok := maybePolicy.Value().Resources[resourceName]
+	if !ok {
+		return nil, acp.NewErrResourceDoesNotExistOnTargetPolicy(resourceName, policyID)
+	}
+
+	return resource, nil
+}
+
 func (a *bridgeDocumentACP) AddDocActorRelationship(
 	ctx context.Context,
 	policyID string,
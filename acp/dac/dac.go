@@ -83,6 +83,15 @@ type DocumentACP interface {
 		docID string,
 	) (bool, error)
 
+	// DocOwner returns the identifier of the owner of the document, if the document is registered.
+	// If check failed then an error is returned.
+	DocOwner(
+		ctx context.Context,
+		policyID string,
+		resourceName string,
+		docID string,
+	) (immutable.Option[string], error)
+
 	// CheckDocAccess returns true if the check was successfull and the request has access to the document. If
 	// the check was successful but the request does not have access to the document, then returns false.
 	// Otherwise if check failed then an error is returned (and the boolean result should not be used).
@@ -98,6 +107,54 @@ type DocumentACP interface {
 		docID string,
 	) (bool, error)
 
+	// FieldPermissions returns the field scoped permissions ("read:<field>" or "update:<field>")
+	// declared on the resource of the given policy.
+	FieldPermissions(
+		ctx context.Context,
+		policyID string,
+		resourceName string,
+	) ([]acpTypes.FieldResourcePermission, error)
+
+	// CheckDocFieldAccess returns true if the check was successfull and the request has the field
+	// scoped permission on the document. If the check was successful but the request does not have
+	// the permission, then returns false. Otherwise if check failed then an error is returned.
+	//
+	// Note(s):
+	// - The field permission must be declared on the resource of the policy.
+	CheckDocFieldAccess(
+		ctx context.Context,
+		permission acpTypes.FieldResourcePermission,
+		actorID string,
+		policyID string,
+		resourceName string,
+		docID string,
+	) (bool, error)
+
+	// HasCollectionPermission returns true if the given collection scoped permission is declared
+	// on the resource of the given policy.
+	HasCollectionPermission(
+		ctx context.Context,
+		permission acpTypes.CollectionResourcePermission,
+		policyID string,
+		resourceName string,
+	) (bool, error)
+
+	// CheckCollectionAccess returns true if the check was successfull and the request has the collection
+	// scoped permission. If the check was successful but the request does not have the permission, then
+	// returns false. Otherwise if check failed then an error is returned.
+	//
+	// Note(s):
+	// - The collection is registered as an object (with the collectionID as the objectID) under the
+	// same policy and resource as its documents.
+	CheckCollectionAccess(
+		ctx context.Context,
+		permission acpTypes.CollectionResourcePermission,
+		actorID string,
+		policyID string,
+		resourceName string,
+		collectionID string,
+	) (bool, error)
+
 	// AddDocActorRelationship creates a relationship between document and the target actor.
 	//
 	// If failure occurs, the result will return an error. Upon success the boolean value will
@@ -0,0 +1,267 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package identity
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/sourcenetwork/immutable"
+)
+
+const (
+	// CapabilitiesClaim is the name of the claim field containing
+	// the capabilities delegated by a capability token.
+	CapabilitiesClaim = "cap"
+
+	// ProofClaim is the name of the claim field containing the capability
+	// token the capabilities of a capability token are delegated from.
+	ProofClaim = "prf"
+
+	// AnyAudience is the audience of a capability token that can be used by
+	// any actor, including requests without an identity.
+	AnyAudience = "*"
+)
+
+// Capability grants a document permission on the documents of a collection.
+type Capability struct {
+	// CollectionName is the name of the collection the capability applies to.
+	CollectionName string `json:"collection"`
+	// DocID is the ID of the document the capability applies to.
+	//
+	// If empty the capability applies to all the documents of the collection.
+	DocID string `json:"docID,omitempty"`
+	// Permission is the document permission that is granted, i.e. "read", "update" or "delete".
+	Permission string `json:"permission"`
+}
+
+// covers returns true if the given capability is granted by this capability.
+func (c Capability) covers(other Capability) bool {
+	return c.CollectionName == other.CollectionName &&
+		(c.DocID == "" || c.DocID == other.DocID) &&
+		c.Permission == other.Permission
+}
+
+// CapabilityToken is a signed and expiring token, delegating a subset of the permissions
+// of its issuer to its audience (UCAN-style).
+//
+// The capabilities are only granted if the actor they are delegated from (see [CapabilityToken.Delegator])
+// still has the permissions at the time they are used, no relationship is ever persisted.
+type CapabilityToken struct {
+	// Issuer is the DID of the actor that signed the token.
+	Issuer string
+	// Audience is the DID of the actor the capabilities are delegated to, or [AnyAudience].
+	Audience string
+	// Capabilities are the capabilities that are delegated.
+	Capabilities []Capability
+	// NotBefore is the time from which the token can be used.
+	NotBefore time.Time
+	// Expiration is the time from which the token can no longer be used.
+	Expiration time.Time
+	// Proof is the token the capabilities are re-delegated from, nil if the capabilities
+	// are delegated from the permissions of the issuer.
+	Proof *CapabilityToken
+
+	token string
+}
+
+// NewCapabilityToken creates and returns a new signed capability token, delegating the given capabilities
+// of the issuer to the audience for the given duration.
+//
+// The audience is the DID of an actor, or [AnyAudience] for a token that can be used by anyone holding it.
+//
+// If a proof is given the capabilities are re-delegated from the proof, which must have the issuer as
+// audience and grant all of the given capabilities.
+func NewCapabilityToken(
+	issuer FullIdentity,
+	audience string,
+	capabilities []Capability,
+	duration time.Duration,
+	proof immutable.Option[string],
+) ([]byte, error) {
+	if issuer.PrivateKey() == nil {
+		return nil, ErrPrivateKeyNotAvailable
+	}
+	if audience == "" {
+		return nil, ErrCapabilityTokenMissingAudience
+	}
+	if len(capabilities) == 0 {
+		return nil, ErrCapabilityTokenHasNoCapabilities
+	}
+
+	now := time.Now()
+
+	jwtBuilder := jwt.NewBuilder()
+	jwtBuilder = jwtBuilder.Subject(issuer.PublicKey().String())
+	jwtBuilder = jwtBuilder.Issuer(issuer.DID())
+	jwtBuilder = jwtBuilder.Audience([]string{audience})
+	jwtBuilder = jwtBuilder.Expiration(now.Add(duration))
+	jwtBuilder = jwtBuilder.NotBefore(now)
+	jwtBuilder = jwtBuilder.IssuedAt(now)
+	jwtBuilder = jwtBuilder.Claim(CapabilitiesClaim, capabilities)
+
+	if proof.HasValue() {
+		proofToken, err := ParseCapabilityToken([]byte(proof.Value()))
+		if err != nil {
+			return nil, err
+		}
+		err = validateCapabilityDelegation(issuer.DID(), capabilities, proofToken)
+		if err != nil {
+			return nil, err
+		}
+		jwtBuilder = jwtBuilder.Claim(ProofClaim, proof.Value())
+	}
+
+	token, err := jwtBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return signToken(token, issuer.PrivateKey())
+}
+
+// ParseCapabilityToken parses the given capability token, verifying its signature and the delegation
+// of its capabilities from its proof (if any).
+//
+// The time validity of the token is not verified, so that tokens that are not valid yet can be
+// parsed. It is checked each time the token is used, see [CapabilityToken.Grants].
+func ParseCapabilityToken(data []byte) (*CapabilityToken, error) {
+	issuer, err := FromToken(data)
+	if err != nil {
+		return nil, NewErrInvalidCapabilityToken(err)
+	}
+
+	err = verifyTokenSignature(data, issuer.PublicKey())
+	if err != nil {
+		return nil, NewErrInvalidCapabilityToken(err)
+	}
+
+	token, err := jwt.Parse(data, jwt.WithVerify(false))
+	if err != nil {
+		return nil, NewErrInvalidCapabilityToken(err)
+	}
+
+	if token.Issuer() != issuer.DID() {
+		return nil, NewErrInvalidCapabilityToken(ErrCapabilityTokenIssuerMismatch)
+	}
+	if len(token.Audience()) != 1 || token.Audience()[0] == "" {
+		return nil, NewErrInvalidCapabilityToken(ErrCapabilityTokenMissingAudience)
+	}
+
+	capabilitiesClaim, ok := token.Get(CapabilitiesClaim)
+	if !ok {
+		return nil, NewErrInvalidCapabilityToken(ErrCapabilityTokenHasNoCapabilities)
+	}
+	// The claim is parsed as a generic json value, so it is converted back into capabilities.
+	capabilitiesJSON, err := json.Marshal(capabilitiesClaim)
+	if err != nil {
+		return nil, NewErrInvalidCapabilityToken(err)
+	}
+	var capabilities []Capability
+	err = json.Unmarshal(capabilitiesJSON, &capabilities)
+	if err != nil {
+		return nil, NewErrInvalidCapabilityToken(err)
+	}
+	if len(capabilities) == 0 {
+		return nil, NewErrInvalidCapabilityToken(ErrCapabilityTokenHasNoCapabilities)
+	}
+
+	capabilityToken := &CapabilityToken{
+		Issuer:       token.Issuer(),
+		Audience:     token.Audience()[0],
+		Capabilities: capabilities,
+		NotBefore:    token.NotBefore(),
+		Expiration:   token.Expiration(),
+		token:        string(data),
+	}
+
+	proofClaim, ok := token.Get(ProofClaim)
+	if ok {
+		proofData, ok := proofClaim.(string)
+		if !ok {
+			return nil, NewErrInvalidCapabilityToken(ErrInvalidCapabilityTokenProofClaimType)
+		}
+		proof, err := ParseCapabilityToken([]byte(proofData))
+		if err != nil {
+			return nil, err
+		}
+		err = validateCapabilityDelegation(capabilityToken.Issuer, capabilities, proof)
+		if err != nil {
+			return nil, NewErrInvalidCapabilityToken(err)
+		}
+		capabilityToken.Proof = proof
+	}
+
+	return capabilityToken, nil
+}
+
+// validateCapabilityDelegation validates that the given capabilities can be re-delegated by the
+// issuer from the given proof.
+func validateCapabilityDelegation(issuer string, capabilities []Capability, proof *CapabilityToken) error {
+	if proof.Audience != issuer {
+		return ErrCapabilityProofAudienceMismatch
+	}
+	for _, capability := range capabilities {
+		isDelegated := slices.ContainsFunc(proof.Capabilities, func(proofCapability Capability) bool {
+			return proofCapability.covers(capability)
+		})
+		if !isDelegated {
+			return NewErrCapabilityNotDelegated(capability.CollectionName, capability.DocID, capability.Permission)
+		}
+	}
+	return nil
+}
+
+// Token returns the signed token.
+func (t *CapabilityToken) Token() string {
+	return t.token
+}
+
+// Delegator returns the DID of the actor whose permissions are delegated, this is the
+// issuer of the first token of the delegation chain.
+func (t *CapabilityToken) Delegator() string {
+	if t.Proof != nil {
+		return t.Proof.Delegator()
+	}
+	return t.Issuer
+}
+
+// IsValidAt returns true if the token, and all the tokens it is delegated from, can be
+// used at the given time.
+func (t *CapabilityToken) IsValidAt(now time.Time) bool {
+	if now.Before(t.NotBefore) || !now.Before(t.Expiration) {
+		return false
+	}
+	return t.Proof == nil || t.Proof.IsValidAt(now)
+}
+
+// Grants returns true if the token currently delegates the given permission on the given
+// document to the given actor.
+//
+// The actor is the DID of the actor that uses the token, empty if the request has no identity.
+func (t *CapabilityToken) Grants(actorID string, collectionName string, docID string, permission string) bool {
+	if t.Audience != AnyAudience && t.Audience != actorID {
+		return false
+	}
+	if !t.IsValidAt(time.Now()) {
+		return false
+	}
+	requested := Capability{
+		CollectionName: collectionName,
+		DocID:          docID,
+		Permission:     permission,
+	}
+	return slices.ContainsFunc(t.Capabilities, func(capability Capability) bool {
+		return capability.covers(requested)
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package identity
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/crypto"
+)
+
+func TestCapabilityToken_GrantsDelegatedCapability(t *testing.T) {
+	issuer, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	audience, err := Generate(crypto.KeyTypeEd25519)
+	require.NoError(t, err)
+
+	data, err := NewCapabilityToken(
+		issuer,
+		audience.DID(),
+		[]Capability{{CollectionName: "Users", DocID: "bae-1", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	token, err := ParseCapabilityToken(data)
+	require.NoError(t, err)
+
+	require.Equal(t, issuer.DID(), token.Issuer)
+	require.Equal(t, issuer.DID(), token.Delegator())
+	require.Equal(t, string(data), token.Token())
+
+	require.True(t, token.Grants(audience.DID(), "Users", "bae-1", "read"))
+	require.False(t, token.Grants(audience.DID(), "Users", "bae-1", "update"))
+	require.False(t, token.Grants(audience.DID(), "Users", "bae-2", "read"))
+	require.False(t, token.Grants(issuer.DID(), "Users", "bae-1", "read"))
+	require.False(t, token.Grants("", "Users", "bae-1", "read"))
+}
+
+func TestCapabilityToken_WithAnyAudience_GrantsAnyActor(t *testing.T) {
+	issuer, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	data, err := NewCapabilityToken(
+		issuer,
+		AnyAudience,
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	token, err := ParseCapabilityToken(data)
+	require.NoError(t, err)
+
+	require.True(t, token.Grants("", "Users", "bae-1", "read"))
+	require.True(t, token.Grants("did:key:z6MkkHsQbp3tXECqmUJoCJwyuxSKn1BDF1RHzwDGg9tHbXKw", "Users", "bae-2", "read"))
+	require.False(t, token.Grants("", "Books", "bae-1", "read"))
+}
+
+func TestCapabilityToken_Expired_Error(t *testing.T) {
+	issuer, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	data, err := NewCapabilityToken(
+		issuer,
+		AnyAudience,
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		-time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	_, err = ParseCapabilityToken(data)
+	require.ErrorIs(t, err, ErrInvalidCapabilityToken)
+}
+
+func TestCapabilityToken_TamperedSignature_Error(t *testing.T) {
+	issuer, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	other, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	data, err := NewCapabilityToken(
+		issuer,
+		AnyAudience,
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	otherData, err := NewCapabilityToken(
+		other,
+		AnyAudience,
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	// Replace the signature of the token with the signature of another token.
+	tampered := append(data[:bytes.LastIndexByte(data, '.')], otherData[bytes.LastIndexByte(otherData, '.'):]...)
+
+	_, err = ParseCapabilityToken(tampered)
+	require.ErrorIs(t, err, ErrInvalidCapabilityToken)
+}
+
+func TestCapabilityToken_ReDelegated_GrantsSubsetOfProof(t *testing.T) {
+	owner, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	service, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	user, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	proof, err := NewCapabilityToken(
+		owner,
+		service.DID(),
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	data, err := NewCapabilityToken(
+		service,
+		user.DID(),
+		[]Capability{{CollectionName: "Users", DocID: "bae-1", Permission: "read"}},
+		time.Minute,
+		immutable.Some(string(proof)),
+	)
+	require.NoError(t, err)
+
+	token, err := ParseCapabilityToken(data)
+	require.NoError(t, err)
+
+	require.Equal(t, owner.DID(), token.Delegator())
+	require.True(t, token.Grants(user.DID(), "Users", "bae-1", "read"))
+	require.False(t, token.Grants(user.DID(), "Users", "bae-2", "read"))
+}
+
+func TestCapabilityToken_ReDelegatedBeyondProof_Error(t *testing.T) {
+	owner, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	service, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	proof, err := NewCapabilityToken(
+		owner,
+		service.DID(),
+		[]Capability{{CollectionName: "Users", DocID: "bae-1", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	_, err = NewCapabilityToken(
+		service,
+		AnyAudience,
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.Some(string(proof)),
+	)
+	require.ErrorIs(t, err, ErrCapabilityNotDelegated)
+}
+
+func TestCapabilityToken_ReDelegatedByOtherActor_Error(t *testing.T) {
+	owner, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	service, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	other, err := Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	proof, err := NewCapabilityToken(
+		owner,
+		service.DID(),
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	_, err = NewCapabilityToken(
+		other,
+		AnyAudience,
+		[]Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Minute,
+		immutable.Some(string(proof)),
+	)
+	require.ErrorIs(t, err, ErrCapabilityProofAudienceMismatch)
+}
@@ -48,3 +48,27 @@ func FullFromContext(ctx context.Context) immutable.Option[FullIdentity] {
 	}
 	return immutable.None[FullIdentity]()
 }
+
+// capabilityContextKey is the key type for capability token context values.
+type capabilityContextKey struct{}
+
+// CapabilityFromContext returns the capability token from the given context.
+//
+// If a capability token does not exist `None` is returned.
+func CapabilityFromContext(ctx context.Context) immutable.Option[*CapabilityToken] {
+	token, ok := ctx.Value(capabilityContextKey{}).(*CapabilityToken)
+	if ok && token != nil {
+		return immutable.Some(token)
+	}
+	return immutable.None[*CapabilityToken]()
+}
+
+// WithCapability returns a new context with the capability token value set.
+//
+// This will overwrite any previously set capability token value.
+func WithCapability(ctx context.Context, token immutable.Option[*CapabilityToken]) context.Context {
+	if token.HasValue() {
+		return context.WithValue(ctx, capabilityContextKey{}, token.Value())
+	}
+	return context.WithValue(ctx, capabilityContextKey{}, nil)
+}
@@ -20,6 +20,14 @@ const (
 	errInvalidKeyTypeClaimType = "key type claim must be a string"
 	errPrivateKeyNotAvailable  = "private key not available"
 	errMustBeTokenIdentity     = "identity must be a TokenIdentity"
+
+	errInvalidCapabilityToken               = "invalid capability token"
+	errCapabilityTokenMissingAudience       = "capability token must have a single audience"
+	errCapabilityTokenHasNoCapabilities     = "capability token must delegate at least one capability"
+	errCapabilityTokenIssuerMismatch        = "capability token issuer does not match the signing key"
+	errInvalidCapabilityTokenProofClaimType = "capability token proof claim must be a string"
+	errCapabilityProofAudienceMismatch      = "capability token issuer must be the audience of its proof"
+	errCapabilityNotDelegated               = "capability is not delegated by the proof"
 )
 
 var (
@@ -33,4 +41,36 @@ var (
 	ErrPrivateKeyNotAvailable = errors.New(errPrivateKeyNotAvailable)
 	// ErrMustBeTokenIdentity is returned when used identity does not implement TokenIdentity.
 	ErrMustBeTokenIdentity = errors.New(errMustBeTokenIdentity)
+	// ErrInvalidCapabilityToken is returned when a capability token can not be parsed or verified.
+	ErrInvalidCapabilityToken = errors.New(errInvalidCapabilityToken)
+	// ErrCapabilityTokenMissingAudience is returned when a capability token is not delegated to a single audience.
+	ErrCapabilityTokenMissingAudience = errors.New(errCapabilityTokenMissingAudience)
+	// ErrCapabilityTokenHasNoCapabilities is returned when a capability token does not delegate any capability.
+	ErrCapabilityTokenHasNoCapabilities = errors.New(errCapabilityTokenHasNoCapabilities)
+	// ErrCapabilityTokenIssuerMismatch is returned when the issuer of a capability token is not the signer.
+	ErrCapabilityTokenIssuerMismatch = errors.New(errCapabilityTokenIssuerMismatch)
+	// ErrInvalidCapabilityTokenProofClaimType is returned when the proof claim of a capability token is not a string.
+	ErrInvalidCapabilityTokenProofClaimType = errors.New(errInvalidCapabilityTokenProofClaimType)
+	// ErrCapabilityProofAudienceMismatch is returned when a capability token re-delegates capabilities from
+	// a proof that was not delegated to its issuer.
+	ErrCapabilityProofAudienceMismatch = errors.New(errCapabilityProofAudienceMismatch)
+	// ErrCapabilityNotDelegated is returned when a capability token re-delegates a capability that is not
+	// granted by its proof.
+	ErrCapabilityNotDelegated = errors.New(errCapabilityNotDelegated)
 )
+
+// NewErrInvalidCapabilityToken returns a new error indicating that a capability token is invalid.
+func NewErrInvalidCapabilityToken(inner error) error {
+	return errors.Wrap(errInvalidCapabilityToken, inner)
+}
+
+// NewErrCapabilityNotDelegated returns a new error indicating that the given capability is not
+// granted by the proof of a capability token.
+func NewErrCapabilityNotDelegated(collectionName string, docID string, permission string) error {
+	return errors.New(
+		errCapabilityNotDelegated,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Permission", permission),
+	)
+}
@@ -140,27 +140,27 @@ func (f *fullIdentity) NewToken(
 		}
 	}
 
-	err = token.Set(KeyTypeClaim, string(f.privateKey.Type()))
+	return signToken(token, f.privateKey)
+}
+
+// signToken sets the key type claim of the given token, and signs it with the given private key.
+func signToken(token jwt.Token, privateKey crypto.PrivateKey) ([]byte, error) {
+	err := token.Set(KeyTypeClaim, string(privateKey.Type()))
 	if err != nil {
 		return nil, err
 	}
 
 	// For now we only support ECDSA with secp256k1 or Ed25519 for bearer tokens
-	if f.privateKey.Type() != crypto.KeyTypeSecp256k1 && f.privateKey.Type() != crypto.KeyTypeEd25519 {
-		return nil, crypto.NewErrUnsupportedKeyType(f.privateKey.Type())
+	if privateKey.Type() != crypto.KeyTypeSecp256k1 && privateKey.Type() != crypto.KeyTypeEd25519 {
+		return nil, crypto.NewErrUnsupportedKeyType(privateKey.Type())
 	}
 
-	privKey := f.privateKey.Underlying()
+	privKey := privateKey.Underlying()
 	if secpPrivKey, ok := privKey.(*secp256k1.PrivateKey); ok {
 		privKey = secpPrivKey.ToECDSA()
 	}
 
-	signedToken, err := jwt.Sign(token, jwt.WithKey(keyTypeToJWK(f.privateKey.Type()), privKey))
-	if err != nil {
-		return nil, err
-	}
-
-	return signedToken, nil
+	return jwt.Sign(token, jwt.WithKey(keyTypeToJWK(privateKey.Type()), privKey))
 }
 
 // SetBearerToken sets the bearerToken to the specified token for fullIdentity.
@@ -191,22 +191,24 @@ func VerifyAuthToken(ident TokenIdentity, audience string) error {
 		return err
 	}
 
+	return verifyTokenSignature([]byte(ident.BearerToken()), ident.PublicKey())
+}
+
+// verifyTokenSignature verifies that the given token was signed by the private key of the given
+// public key.
+func verifyTokenSignature(token []byte, publicKey crypto.PublicKey) error {
 	// For now we only support ECDSA with secp256k1 or Ed25519 for bearer tokens
-	if ident.PublicKey().Type() != crypto.KeyTypeSecp256k1 && ident.PublicKey().Type() != crypto.KeyTypeEd25519 {
-		return crypto.NewErrUnsupportedKeyType(ident.PublicKey().Type())
+	if publicKey.Type() != crypto.KeyTypeSecp256k1 && publicKey.Type() != crypto.KeyTypeEd25519 {
+		return crypto.NewErrUnsupportedKeyType(publicKey.Type())
 	}
 
-	pubKey := ident.PublicKey().Underlying()
+	pubKey := publicKey.Underlying()
 	if secpPubkey, ok := pubKey.(*secp256k1.PublicKey); ok {
 		pubKey = secpPubkey.ToECDSA()
 	}
 
-	_, err = jws.Verify([]byte(ident.BearerToken()), jws.WithKey(keyTypeToJWK(ident.PublicKey().Type()), pubKey))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	_, err := jws.Verify(token, jws.WithKey(keyTypeToJWK(publicKey.Type()), pubKey))
+	return err
 }
 
 // keyTypeToJWK maps a crypto.KeyType to the corresponding JWA signature algorithm.
@@ -69,6 +69,77 @@ var ImplyDocumentReadPerm = []DocumentResourcePermission{
 	DocumentDeletePerm,
 }
 
+// FieldPermissionSeparator separates the document permission from the name of the field
+// in the name of a field scoped permission, for example "read:salary".
+const FieldPermissionSeparator = ":"
+
+// FieldResourcePermission is a resource interface permission for the access control of a single
+// document field.
+//
+// Unlike the document permissions, field permissions are optional. Only the fields with a
+// field permission declared on the resource are restricted beyond the document permissions.
+type FieldResourcePermission struct {
+	// Permission is the document permission that is scoped to the field, only read
+	// and update permissions can be scoped to a field.
+	Permission DocumentResourcePermission
+	// FieldName is the name of the field the permission applies to.
+	FieldName string
+}
+
+var _ ResourceInterfacePermission = FieldResourcePermission{}
+
+func (resourcePermission FieldResourcePermission) String() string {
+	return resourcePermission.Permission.String() + FieldPermissionSeparator + resourcePermission.FieldName
+}
+
+// ParseFieldResourcePermission parses the given permission name as a field scoped permission.
+//
+// Returns false if the name is not a field scoped read or update permission.
+func ParseFieldResourcePermission(name string) (FieldResourcePermission, bool) {
+	permissionName, fieldName, found := strings.Cut(name, FieldPermissionSeparator)
+	if !found || fieldName == "" {
+		return FieldResourcePermission{}, false
+	}
+	for _, permission := range []DocumentResourcePermission{DocumentReadPerm, DocumentUpdatePerm} {
+		if permission.String() == permissionName {
+			return FieldResourcePermission{Permission: permission, FieldName: fieldName}, true
+		}
+	}
+	return FieldResourcePermission{}, false
+}
+
+// ImplyFieldReadPerm is a list of field permissions that imply the field can be read, as
+// being able to update a field implies being able to read it.
+var ImplyFieldReadPerm = []DocumentResourcePermission{
+	DocumentReadPerm,
+	DocumentUpdatePerm,
+}
+
+// CollectionResourcePermission is a resource interface permission for the access control of a
+// whole collection, rather than of a single document within it.
+//
+// Like field permissions, collection permissions are optional. A collection is only restricted
+// beyond the document permissions if the permission is declared on the resource.
+type CollectionResourcePermission int
+
+// Resource interface permission types for collection access control.
+const (
+	CollectionCreatePerm CollectionResourcePermission = iota
+)
+
+// List of all valid resource interface permissions for collection access control, the order of
+// permissions in this list must match the above defined ordering such that iota matches the
+// index position within the list.
+var OptionalResourcePermissionsForCollection = []string{
+	"create",
+}
+
+var _ ResourceInterfacePermission = (*CollectionResourcePermission)(nil)
+
+func (resourcePermission CollectionResourcePermission) String() string {
+	return OptionalResourcePermissionsForCollection[resourcePermission]
+}
+
 // NodeResourcePermission is a resource interface permission for node access control.
 type NodeResourcePermission int
 
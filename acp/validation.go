@@ -12,6 +12,7 @@ package acp
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	acpTypes "github.com/sourcenetwork/defradb/acp/types"
@@ -90,6 +91,29 @@ func ValidateResourceInterface(
 		}
 	}
 
+	if acpType == acpTypes.NodeACP {
+		return nil
+	}
+
+	// Field and collection scoped permissions are optional, but just like the required
+	// permissions they must be granted to the "owner" relation.
+	for permissionName, permissionResponse := range resourceResponse.Permissions {
+		_, isFieldPermission := acpTypes.ParseFieldResourcePermission(permissionName)
+		isCollectionPermission := slices.Contains(
+			acpTypes.OptionalResourcePermissionsForCollection,
+			permissionName,
+		)
+		if !isFieldPermission && !isCollectionPermission {
+			continue
+		}
+		if err := validateExpressionOfRequiredPermission(
+			permissionResponse.Expression,
+			permissionName,
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -24,6 +24,7 @@ typedef struct {
     const char* peers;
     const char* identityKeyType;
     const char* identityPrivateKey;
+    const char* blindIndexSecret;
     int inMemory;
     int disableP2P;
     int disableAPI;
@@ -74,6 +74,24 @@ func ACPDeleteDACActorRelationship(
 	return returnC(gcr)
 }
 
+//export ACPAddDACActorRelationships
+func ACPAddDACActorRelationships(n int, cIdentity *C.char, cBatch *C.char, cTxnID C.ulonglong) *C.Result {
+	gcr := cbindings.ACPAddDACActorRelationships(n, C.GoString(cIdentity), C.GoString(cBatch), uint64(cTxnID))
+	return returnC(gcr)
+}
+
+//export ACPDeleteDACActorRelationships
+func ACPDeleteDACActorRelationships(n int, cIdentity *C.char, cBatch *C.char, cTxnID C.ulonglong) *C.Result {
+	gcr := cbindings.ACPDeleteDACActorRelationships(n, C.GoString(cIdentity), C.GoString(cBatch), uint64(cTxnID))
+	return returnC(gcr)
+}
+
+//export ACPGetACPAuditLog
+func ACPGetACPAuditLog(n int, cIdentity *C.char, cQuery *C.char, cTxnID C.ulonglong) *C.Result {
+	gcr := cbindings.ACPGetACPAuditLog(n, C.GoString(cIdentity), C.GoString(cQuery), uint64(cTxnID))
+	return returnC(gcr)
+}
+
 //export ACPDisableNAC
 func ACPDisableNAC(n int, cIdentity *C.char, cTxnID C.ulonglong) *C.Result {
 	gcr := cbindings.ACPDisableNAC(n, C.GoString(cIdentity), uint64(cTxnID))
@@ -154,6 +172,26 @@ func CollectionDelete(n int, cDocID *C.char, cFilter *C.char, cOptions C.Collect
 	return returnC(gcr)
 }
 
+//export CollectionRotateKey
+func CollectionRotateKey(
+	n int,
+	cDocID *C.char,
+	cFields *C.char,
+	cReEncrypt C.int,
+	cOptions C.CollectionOptions,
+) *C.Result {
+	gocOptions := convertCOptionsToGoCOptions(cOptions)
+	gcr := cbindings.CollectionRotateKey(n, C.GoString(cDocID), C.GoString(cFields), cReEncrypt != 0, gocOptions)
+	return returnC(gcr)
+}
+
+//export CollectionMigrateDocuments
+func CollectionMigrateDocuments(n int, cBatchSize C.int, cOptions C.CollectionOptions) *C.Result {
+	gocOptions := convertCOptionsToGoCOptions(cOptions)
+	gcr := cbindings.CollectionMigrateDocuments(n, int(cBatchSize), gocOptions)
+	return returnC(gcr)
+}
+
 //export CollectionDescribe
 func CollectionDescribe(n int, cOptions C.CollectionOptions) *C.Result {
 	gocOptions := convertCOptionsToGoCOptions(cOptions)
@@ -214,6 +252,7 @@ func IndexCreate(
 	cIndexName *C.char,
 	cFields *C.char,
 	cIsUnique C.int,
+	cIsBlind C.int,
 	cTxnID C.ulonglong,
 ) *C.Result {
 	gcr := cbindings.IndexCreate(
@@ -222,6 +261,7 @@ func IndexCreate(
 		C.GoString(cIndexName),
 		C.GoString(cFields),
 		cIsUnique != 0,
+		cIsBlind != 0,
 		uint64(cTxnID),
 	)
 	return returnC(gcr)
@@ -55,6 +55,7 @@ func convertNodeInitOptionsToGoNodeInitOptions(cOptions C.NodeInitOptions) cbind
 		Peers:                    C.GoString(cOptions.peers),
 		IdentityKeyType:          C.GoString(cOptions.identityKeyType),
 		IdentityPrivateKey:       C.GoString(cOptions.identityPrivateKey),
+		BlindIndexSecret:         C.GoString(cOptions.blindIndexSecret),
 		InMemory:                 int(cOptions.inMemory),
 		DisableP2P:               int(cOptions.disableP2P),
 		DisableAPI:               int(cOptions.disableAPI),
@@ -12,6 +12,7 @@ package cbindings
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/sourcenetwork/defradb/client"
 )
@@ -95,6 +96,84 @@ func ACPDeleteDACActorRelationship(
 	return marshalJSONToGoCResult(result)
 }
 
+func ACPAddDACActorRelationships(n int, identityPrivateKey string, batchArg string, TxnID uint64) GoCResult {
+	ctx := context.Background()
+
+	ctx, err := contextWithIdentity(ctx, identityPrivateKey)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	ctx, err = contextWithTransaction(n, ctx, TxnID)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	var batch client.DACActorRelationshipBatch
+	if err := json.Unmarshal([]byte(batchArg), &batch); err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	result, err := GetNode(n).DB.AddDACActorRelationships(ctx, batch)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	return marshalJSONToGoCResult(result)
+}
+
+func ACPDeleteDACActorRelationships(n int, identityPrivateKey string, batchArg string, TxnID uint64) GoCResult {
+	ctx := context.Background()
+
+	ctx, err := contextWithIdentity(ctx, identityPrivateKey)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	ctx, err = contextWithTransaction(n, ctx, TxnID)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	var batch client.DACActorRelationshipBatch
+	if err := json.Unmarshal([]byte(batchArg), &batch); err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	result, err := GetNode(n).DB.DeleteDACActorRelationships(ctx, batch)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	return marshalJSONToGoCResult(result)
+}
+
+func ACPGetACPAuditLog(n int, identityPrivateKey string, queryArg string, TxnID uint64) GoCResult {
+	ctx := context.Background()
+
+	ctx, err := contextWithIdentity(ctx, identityPrivateKey)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	ctx, err = contextWithTransaction(n, ctx, TxnID)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	var query client.ACPAuditLogQuery
+	if err := json.Unmarshal([]byte(queryArg), &query); err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	records, err := GetNode(n).DB.GetACPAuditLog(ctx, query)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	return marshalJSONToGoCResult(records)
+}
+
 func ACPDisableNAC(n int, identityPrivateKey string, TxnID uint64) GoCResult {
 	ctx := context.Background()
 
@@ -185,6 +185,79 @@ func CollectionDelete(n int, docID string, filter string, gocOptions GoCOptions)
 	}
 }
 
+func CollectionRotateKey(
+	n int,
+	docID string,
+	fields string,
+	reEncrypt bool,
+	gocOptions GoCOptions,
+) GoCResult {
+	ctx := context.Background()
+	options := parseCollectionOptions(gocOptions)
+
+	ctx, err := contextWithIdentity(ctx, gocOptions.Identity)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	ctx, err = contextWithTransaction(n, ctx, gocOptions.TxID)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	col, err := getCollectionForCollectionCommand(n, ctx, options)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	ID, err := client.NewDocIDFromString(docID)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	var rotatedFields []string
+	if fields != "" {
+		rotatedFields = strings.Split(fields, ",")
+	}
+
+	err = col.RotateEncryptionKey(
+		ctx,
+		ID,
+		client.RotateKeyOfFields(rotatedFields),
+		client.RotateKeyWithReEncryption(reEncrypt),
+	)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+	return returnGoC(0, "", "")
+}
+
+func CollectionMigrateDocuments(n int, batchSize int, gocOptions GoCOptions) GoCResult {
+	ctx := context.Background()
+	options := parseCollectionOptions(gocOptions)
+
+	ctx, err := contextWithIdentity(ctx, gocOptions.Identity)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	ctx, err = contextWithTransaction(n, ctx, gocOptions.TxID)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	col, err := getCollectionForCollectionCommand(n, ctx, options)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+
+	progress, err := col.MigrateDocuments(ctx, batchSize)
+	if err != nil {
+		return returnGoC(1, err.Error(), "")
+	}
+	return marshalJSONToGoCResult(progress)
+}
+
 func CollectionDescribe(n int, gocOptions GoCOptions) GoCResult {
 	ctx := context.Background()
 	options := parseCollectionOptions(gocOptions)
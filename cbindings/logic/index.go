@@ -23,6 +23,7 @@ func IndexCreate(
 	indexName string,
 	fieldsStr string,
 	isUnique bool,
+	isBlind bool,
 	txnID uint64,
 ) GoCResult {
 	ctx := context.Background()
@@ -61,6 +62,7 @@ func IndexCreate(
 		Name:   indexName,
 		Fields: fields,
 		Unique: isUnique,
+		Blind:  isBlind,
 	}
 	col, err := GetNode(n).DB.GetCollectionByName(ctx, collectionName)
 	if err != nil {
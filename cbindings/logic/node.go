@@ -12,6 +12,7 @@ package cbindings
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strconv"
@@ -130,6 +131,13 @@ func NodeInit(n int, cOptions GoNodeInitOptions) GoCResult {
 	if cOptions.EnableNodeACP != 0 {
 		opts = append(opts, node.WithEnableNodeACP(true))
 	}
+	if cOptions.BlindIndexSecret != "" {
+		blindIndexSecret, err := hex.DecodeString(cOptions.BlindIndexSecret)
+		if err != nil {
+			return returnGoC(1, err.Error(), "")
+		}
+		opts = append(opts, db.WithBlindIndexSecret(blindIndexSecret))
+	}
 	opts = append(opts, node.WithDocumentACPPath(""))
 	opts = append(opts, node.WithNodeACPPath(""))
 
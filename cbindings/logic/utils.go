@@ -48,6 +48,7 @@ type GoNodeInitOptions struct {
 	Peers                    string
 	IdentityKeyType          string
 	IdentityPrivateKey       string
+	BlindIndexSecret         string
 	InMemory                 int
 	DisableP2P               int
 	DisableAPI               int
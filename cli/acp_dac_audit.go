@@ -0,0 +1,131 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeDocumentACPAuditCommand() *cobra.Command {
+	var (
+		query    client.ACPAuditLogQuery
+		sinceArg string
+		untilArg string
+	)
+
+	var cmd = &cobra.Command{
+		Use:   "audit [-a --actor] [-c --collection] [--docID] [--since] [--until] [--denied] [--limit] [-i --identity]",
+		Short: "Export the records of the document acp audit log",
+		Long: `Export the records of the document acp audit log
+
+The audit log records the authorization decisions made by the document acp system, it
+must be enabled on the node with the 'acp.document.audit.enable' config option.
+
+Each record holds the identity that was checked, the operation, the collection, the docID,
+the permission that was checked, the decision and the time the decision was made.
+The records are output from the oldest to the newest.
+
+The requesting identity MUST be the identity of the node, as the log holds the access
+patterns of all actors.
+
+Example: Export all the records:
+  defradb client acp document audit
+
+Example: Export the denied decisions on a collection since a point in time:
+  defradb client acp document audit \
+	--collection Users \
+	--since 2025-01-01T00:00:00Z \
+	--denied
+
+Example: Export the last decisions made for an actor on a document:
+  defradb client acp document audit \
+	--actor did:key:z7r8os2G88XXBNBTLj3kFR5rzUJ4VAesbX7PgsA68ak9B5RYcXF5EZEmjRzzinZndPSSwujXb4XKHG6vmKEFG6ZfsfcQn \
+	--docID bae-ff3ceb1c-b5c0-5e86-a024-dd1b16a4261c \
+	--limit 10
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliClient := mustGetContextCLIClient(cmd)
+
+			if sinceArg != "" {
+				since, err := time.Parse(time.RFC3339Nano, sinceArg)
+				if err != nil {
+					return err
+				}
+				query.Since = since
+			}
+			if untilArg != "" {
+				until, err := time.Parse(time.RFC3339Nano, untilArg)
+				if err != nil {
+					return err
+				}
+				query.Until = until
+			}
+
+			records, err := cliClient.GetACPAuditLog(cmd.Context(), query)
+			if err != nil {
+				return err
+			}
+
+			return writeJSON(cmd, records)
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&query.Identity,
+		"actor",
+		"a",
+		"",
+		"Only output the records of the given actor",
+	)
+	cmd.Flags().StringVarP(
+		&query.CollectionName,
+		"collection",
+		"c",
+		"",
+		"Only output the records of the given collection",
+	)
+	cmd.Flags().StringVar(
+		&query.DocID,
+		"docID",
+		"",
+		"Only output the records of the given document",
+	)
+	cmd.Flags().StringVar(
+		&sinceArg,
+		"since",
+		"",
+		"Only output the records made at or after the given RFC3339 time",
+	)
+	cmd.Flags().StringVar(
+		&untilArg,
+		"until",
+		"",
+		"Only output the records made before the given RFC3339 time",
+	)
+	cmd.Flags().BoolVar(
+		&query.OnlyDenied,
+		"denied",
+		false,
+		"Only output the records of the denied decisions",
+	)
+	cmd.Flags().IntVar(
+		&query.Limit,
+		"limit",
+		0,
+		"Maximum number of records to output, zero outputs all the records",
+	)
+
+	return cmd
+}
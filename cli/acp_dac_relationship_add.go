@@ -41,6 +41,8 @@ Notes:
   - The requesting identity MUST either be the owner OR the manager (manages the relation) of the resource.
   - If the specified relation was not granted the minimum DRI permissions within the policy,
   and a relationship is formed, the subject/actor will still not be able to access the resource.
+  - If the Target DocID is empty and the policy declares a collection scoped permission (i.e. "create"),
+  the relationship is made with the collection itself (i.e. to grant who can create documents in it).
   - Learn more about the DefraDB [ACP System](/acp/README.md)
 
 Example: Let another actor (4d092126012ebaf56161716018a71630d99443d9d5217e9d8502bb5c5456f2c5) read a private document:
@@ -59,6 +61,14 @@ Example: Let all actors read a private document:
 	--actor "*" \
 	--identity e3b722906ee4e56368f581cd8b18ab0f48af1ea53e635e3f7b8acd076676f6ac
 
+Example: Let another actor create documents in a collection that has a create permission:
+  defradb client acp relationship add \
+	--collection Invoices \
+	--docID "" \
+	--relation creator \
+	--actor did:key:z7r8os2G88XXBNBTLj3kFR5rzUJ4VAesbX7PgsA68ak9B5RYcXF5EZEmjRzzinZndPSSwujXb4XKHG6vmKEFG6ZfsfcQn \
+	--identity e3b722906ee4e56368f581cd8b18ab0f48af1ea53e635e3f7b8acd076676f6ac
+
 Example: Creating a dummy relationship does nothing (from database perspective):
   defradb client acp relationship add \
 	-c Users \
@@ -116,7 +126,7 @@ Example: Creating a dummy relationship does nothing (from database perspective):
 		&docIDArg,
 		"docID",
 		"",
-		"Document Identifier (ObjectID) to make relationship for",
+		"Document Identifier (ObjectID) to make relationship for, empty for the collection itself (if it has a collection scoped permission)",
 	)
 	_ = cmd.MarkFlagRequired("docID")
 
@@ -0,0 +1,80 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeDocumentACPRelationshipBatchCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "batch",
+		Short: "Add or delete a relationship on many documents at once",
+		Long: `Add or delete a relationship on many documents at once.
+
+The documents are selected by a list of docIDs, by a filter on the collection, or both.
+The whole batch is applied atomically, if a relationship can not be changed on any of
+the documents then the changes already made by the batch are reverted.`,
+	}
+
+	return cmd
+}
+
+// addDocumentACPRelationshipBatchFlags adds the flags shared by the batch add and
+// delete commands to the given command.
+func addDocumentACPRelationshipBatchFlags(
+	cmd *cobra.Command,
+	batch *client.DACActorRelationshipBatch,
+	filterArg *string,
+) {
+	cmd.Flags().StringVarP(
+		&batch.CollectionName,
+		"collection",
+		"c",
+		"",
+		"Collection that has the resource and policy for the objects",
+	)
+	_ = cmd.MarkFlagRequired("collection")
+
+	cmd.Flags().StringVarP(
+		&batch.Relation,
+		"relation",
+		"r",
+		"",
+		"Relation of the relationships",
+	)
+	_ = cmd.MarkFlagRequired("relation")
+
+	cmd.Flags().StringVarP(
+		&batch.TargetActor,
+		"actor",
+		"a",
+		"",
+		"Actor of the relationships",
+	)
+	_ = cmd.MarkFlagRequired("actor")
+
+	cmd.Flags().StringSliceVar(
+		&batch.DocIDs,
+		"docID",
+		nil,
+		"Document Identifiers (ObjectIDs) of the documents in the batch",
+	)
+
+	cmd.Flags().StringVar(
+		filterArg,
+		"filter",
+		"",
+		"Filter selecting the documents in the batch",
+	)
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeDocumentACPRelationshipBatchDeleteCommand() *cobra.Command {
+	var (
+		batch     client.DACActorRelationshipBatch
+		filterArg string
+	)
+
+	var cmd = &cobra.Command{
+		Use:   "delete [--docID] [--filter] [-c --collection] [-r --relation] [-a --actor] [-i --identity]",
+		Short: "Delete a relationship from many documents",
+		Long: `Delete a relationship from many documents
+
+The documents are selected by a list of docIDs (--docID), by a filter on the
+collection (--filter), or both. The batch is applied atomically, if any of the
+relationships can not be deleted then all the changes of the batch are reverted.
+
+The requesting identity MUST either be the owner OR the manager (manages the relation)
+of every document in the batch.
+
+Outputs the number of relationships that were changed, and the number that were
+left unchanged because they did not exist.
+
+Example: Stop another actor from reading all the users with an age over 30:
+  defradb client acp document relationship batch delete \
+	--collection Users \
+	--filter '{"age": {"_gt": 30}}' \
+	--relation reader \
+	--actor did:key:z7r8os2G88XXBNBTLj3kFR5rzUJ4VAesbX7PgsA68ak9B5RYcXF5EZEmjRzzinZndPSSwujXb4XKHG6vmKEFG6ZfsfcQn \
+	--identity e3b722906ee4e56368f581cd8b18ab0f48af1ea53e635e3f7b8acd076676f6ac
+
+Example: Stop another actor from reading a list of documents:
+  defradb client acp document relationship batch delete \
+	--collection Users \
+	--docID bae-ff3ceb1c-b5c0-5e86-a024-dd1b16a4261c,bae-3a7df128-bfa9-559a-a9c5-96f2bf6d1038 \
+	--relation reader \
+	--actor did:key:z7r8os2G88XXBNBTLj3kFR5rzUJ4VAesbX7PgsA68ak9B5RYcXF5EZEmjRzzinZndPSSwujXb4XKHG6vmKEFG6ZfsfcQn \
+	--identity e3b722906ee4e56368f581cd8b18ab0f48af1ea53e635e3f7b8acd076676f6ac
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliClient := mustGetContextCLIClient(cmd)
+			if filterArg != "" {
+				if err := json.Unmarshal([]byte(filterArg), &batch.Filter); err != nil {
+					return err
+				}
+			}
+
+			batchResult, err := cliClient.DeleteDACActorRelationships(cmd.Context(), batch)
+			if err != nil {
+				return err
+			}
+
+			return writeJSON(cmd, batchResult)
+		},
+	}
+
+	addDocumentACPRelationshipBatchFlags(cmd, &batch, &filterArg)
+
+	return cmd
+}
@@ -41,6 +41,8 @@ Notes:
   - The target document must be registered with ACP already (policy & resource specified).
   - The requesting identity MUST either be the owner OR the manager (manages the relation) of the resource.
   - If the relationship record was not found, then it will be a no-op.
+  - If the Target DocID is empty and the policy declares a collection scoped permission (i.e. "create"),
+  the relationship with the collection itself is deleted.
   - Learn more about the DefraDB [ACP System](/acp/README.md)
 
 Example: Let another actor (4d092126012ebaf56161716018a71630d99443d9d5217e9d8502bb5c5456f2c5) read a private document:
@@ -100,7 +102,7 @@ Example: Let another actor (4d092126012ebaf56161716018a71630d99443d9d5217e9d8502
 		&docIDArg,
 		"docID",
 		"",
-		"Document Identifier (ObjectID) to delete relationship for",
+		"Document Identifier (ObjectID) to delete relationship for, empty for the collection itself (if it has a collection scoped permission)",
 	)
 	_ = cmd.MarkFlagRequired("docID")
 
@@ -54,11 +54,18 @@ func NewDefraCommand() *cobra.Command {
 		MakeP2PReplicatorDeleteCommand(),
 	)
 
+	p2p_bundle := MakeP2PBundleCommand()
+	p2p_bundle.AddCommand(
+		MakeP2PBundleExportCommand(),
+		MakeP2PBundleImportCommand(),
+	)
+
 	p2p := MakeP2PCommand()
 	p2p.AddCommand(
 		p2p_replicator,
 		p2p_collection,
 		p2p_document,
+		p2p_bundle,
 		MakeP2PInfoCommand(),
 	)
 
@@ -76,6 +83,7 @@ func NewDefraCommand() *cobra.Command {
 		MakeSchemaAddCommand(),
 		MakeSchemaPatchCommand(),
 		MakeSchemaSetActiveCommand(),
+		MakeSchemaMigrateCommand(),
 		MakeSchemaDescribeCommand(),
 	)
 
@@ -98,16 +106,24 @@ func NewDefraCommand() *cobra.Command {
 		MakeDocumentACPPolicyAddCommand(),
 	)
 
+	acp_document_relationship_batch := MakeDocumentACPRelationshipBatchCommand()
+	acp_document_relationship_batch.AddCommand(
+		MakeDocumentACPRelationshipBatchAddCommand(),
+		MakeDocumentACPRelationshipBatchDeleteCommand(),
+	)
+
 	acp_document_relationship := MakeDocumentACPRelationshipCommand()
 	acp_document_relationship.AddCommand(
 		MakeDocumentACPRelationshipAddCommand(),
 		MakeDocumentACPRelationshipDeleteCommand(),
+		acp_document_relationship_batch,
 	)
 
 	dac := MakeDocumentACPCommand()
 	dac.AddCommand(
 		acp_document_policy,
 		acp_document_relationship,
+		MakeDocumentACPAuditCommand(),
 	)
 
 	acp := MakeACPCommand()
@@ -151,6 +167,7 @@ func NewDefraCommand() *cobra.Command {
 		MakeCollectionCreateCommand(),
 		MakeCollectionDescribeCommand(),
 		MakeCollectionPatchCommand(),
+		MakeCollectionRotateKeyCommand(),
 	)
 
 	block := MakeBlockCommand()
@@ -187,6 +204,7 @@ func NewDefraCommand() *cobra.Command {
 	identity := MakeIdentityCommand()
 	identity.AddCommand(
 		MakeIdentityNewCommand(),
+		MakeIdentityCapabilityCommand(),
 	)
 
 	root := MakeRootCommand()
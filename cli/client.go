@@ -17,6 +17,7 @@ import (
 func MakeClientCommand() *cobra.Command {
 	var txID uint64
 	var identity string
+	var capability string
 	var cmd = &cobra.Command{
 		Use:   "client",
 		Short: "Interact with a DefraDB node",
@@ -32,6 +33,9 @@ Execute queries, add schema types, obtain node info, etc.`,
 			if err := setContextIdentity(cmd, identity); err != nil {
 				return err
 			}
+			if err := setContextCapability(cmd, capability); err != nil {
+				return err
+			}
 			if err := setContextTransaction(cmd, txID); err != nil {
 				return err
 			}
@@ -40,6 +44,8 @@ Execute queries, add schema types, obtain node info, etc.`,
 	}
 	cmd.PersistentFlags().StringVarP(&identity, "identity", "i", "",
 		"Hex formatted private key used to authenticate with ACP")
+	cmd.PersistentFlags().StringVar(&capability, "capability", "",
+		"Capability token delegating document permissions to the identity")
 	cmd.PersistentFlags().Uint64Var(&txID, "tx", 0, "Transaction ID")
 	return cmd
 }
@@ -22,6 +22,7 @@ import (
 func MakeCollectionCommand() *cobra.Command {
 	var txID uint64
 	var identity string
+	var capability string
 	var name string
 	var collectionID string
 	var versionID string
@@ -41,6 +42,9 @@ func MakeCollectionCommand() *cobra.Command {
 			if err := setContextIdentity(cmd, identity); err != nil {
 				return err
 			}
+			if err := setContextCapability(cmd, capability); err != nil {
+				return err
+			}
 			if err := setContextTransaction(cmd, txID); err != nil {
 				return err
 			}
@@ -82,6 +86,8 @@ func MakeCollectionCommand() *cobra.Command {
 	cmd.PersistentFlags().Uint64Var(&txID, "tx", 0, "Transaction ID")
 	cmd.PersistentFlags().StringVarP(&identity, "identity", "i", "",
 		"Hex formatted private key used to authenticate with ACP")
+	cmd.PersistentFlags().StringVar(&capability, "capability", "",
+		"Capability token delegating document permissions to the identity")
 	cmd.PersistentFlags().StringVar(&name, "name", "", "Collection name")
 	cmd.PersistentFlags().StringVar(&collectionID, "collection-id", "", "Collection ID")
 	cmd.PersistentFlags().StringVar(&versionID, "version-id", "", "Collection version ID")
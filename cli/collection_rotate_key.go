@@ -0,0 +1,61 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionRotateKeyCommand() *cobra.Command {
+	var argDocID string
+	var fields []string
+	var reEncrypt bool
+	var cmd = &cobra.Command{
+		Use:   "rotate-key [-i --identity] --docID <docID> [--fields <fields>] [--re-encrypt]",
+		Short: "Rotate the encryption key of a document.",
+		Long: `Rotate the encryption key of a document, or the keys of its individually encrypted fields.
+
+The values written after the rotation are encrypted with the new key. The current values of the
+document are also encrypted with the new key if --re-encrypt is set, otherwise they remain encrypted
+with the previous key until they are updated.
+
+Example: rotate the key of a document:
+  defradb client collection rotate-key --name User --docID bae-123
+
+Example: rotate the keys of individually encrypted fields and re-encrypt their values:
+  defradb client collection rotate-key --name User --docID bae-123 --fields name,age --re-encrypt
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetContextCollection(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docID, err := client.NewDocIDFromString(argDocID)
+			if err != nil {
+				return err
+			}
+			return col.RotateEncryptionKey(
+				cmd.Context(),
+				docID,
+				client.RotateKeyOfFields(fields),
+				client.RotateKeyWithReEncryption(reEncrypt),
+			)
+		},
+	}
+	cmd.Flags().StringVar(&argDocID, "docID", "", "Document ID")
+	cmd.Flags().StringSliceVar(&fields, "fields", nil,
+		"Comma-separated list of individually encrypted fields whose keys are rotated")
+	cmd.Flags().BoolVar(&reEncrypt, "re-encrypt", false, "Re-encrypt the current values with the new key")
+	return cmd
+}
@@ -39,6 +39,8 @@ var configPaths = []string{
 	"api.pubkeypath",
 	"api.privkeypath",
 	"keyring.path",
+	"net.pskpath",
+	"api.auth.identities",
 }
 
 // configFlags is a mapping of cli flag names to config keys to bind.
@@ -60,9 +62,25 @@ var configFlags = map[string]string{
 	"peers":                      "net.peers",
 	"p2paddr":                    "net.p2paddresses",
 	"no-p2p":                     "net.p2pdisabled",
+	"p2p-psk-path":               "net.pskpath",
+	"p2p-mdns":                   "net.mdns",
+	"p2p-max-transfers":          "net.maxtransfers",
+	"p2p-max-peer-transfers":     "net.maxpeertransfers",
+	"p2p-max-bandwidth":          "net.maxbandwidth",
+	"p2p-max-block-size":         "net.maxblocksize",
+	"p2p-peer-message-rate":      "net.peermessagerate",
+	"p2p-ban-threshold":          "net.banthreshold",
+	"p2p-ban-duration":           "net.banduration",
+	"p2p-topic-encryption":       "net.topicencryption",
+	"p2p-topic-key-peers":        "net.topickeypeers",
 	"allowed-origins":            "api.allowed-origins",
 	"pubkeypath":                 "api.pubkeypath",
 	"privkeypath":                "api.privkeypath",
+	"auth-jwks":                  "api.auth.jwks",
+	"auth-issuer":                "api.auth.issuer",
+	"auth-audience":              "api.auth.audience",
+	"auth-claim":                 "api.auth.claim",
+	"auth-identities":            "api.auth.identities",
 	"keyring-namespace":          "keyring.namespace",
 	"keyring-backend":            "keyring.backend",
 	"keyring-path":               "keyring.path",
@@ -70,6 +88,8 @@ var configFlags = map[string]string{
 	"node-acp-enable":            "acp.node.enable",
 	"document-acp-type":          "acp.document.type",
 	"source-hub-address":         "acp.document.sourceHub.address",
+	"document-acp-audit":         "acp.document.audit.enable",
+	"document-audit-retention":   "acp.document.audit.retention",
 	"development":                "development",
 	"secret-file":                "secretfile",
 	"no-telemetry":               "telemetry.disabled",
@@ -80,6 +100,11 @@ var configFlags = map[string]string{
 var configDefaults = map[string]any{
 	"api.address":                       "127.0.0.1:9181",
 	"api.allowed-origins":               []string{},
+	"api.auth.jwks":                     "",
+	"api.auth.issuer":                   "",
+	"api.auth.audience":                 "",
+	"api.auth.claim":                    "sub",
+	"api.auth.identities":               "",
 	"datastore.badger.path":             "data",
 	"datastore.maxtxnretries":           5,
 	"datastore.store":                   "badger",
@@ -90,6 +115,17 @@ var configDefaults = map[string]any{
 	"net.peers":                         []string{},
 	"net.pubSubEnabled":                 true,
 	"net.relay":                         false,
+	"net.pskpath":                       "",
+	"net.mdns":                          false,
+	"net.maxtransfers":                  64,
+	"net.maxpeertransfers":              16,
+	"net.maxbandwidth":                  0,
+	"net.maxblocksize":                  4 << 20,
+	"net.peermessagerate":               1000,
+	"net.banthreshold":                  100,
+	"net.banduration":                   "10m",
+	"net.topicencryption":               false,
+	"net.topickeypeers":                 []string{},
 	"keyring.backend":                   "file",
 	"keyring.disabled":                  false,
 	"keyring.namespace":                 "defradb",
@@ -107,6 +143,8 @@ var configDefaults = map[string]any{
 	"datastore.defaultkeytype":          "secp256k1",
 	"acp.node.enable":                   false,
 	"acp.document.type":                 "none",
+	"acp.document.audit.enable":         false,
+	"acp.document.audit.retention":      "0s",
 	"replicator.retryintervals":         []int{30, 60, 120, 240, 480, 960, 1920},
 }
 
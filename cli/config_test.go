@@ -13,6 +13,7 @@ package cli
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,17 @@ func TestLoadConfigNotExist(t *testing.T) {
 	assert.Equal(t, true, cfg.GetBool("net.pubsubenabled"))
 	assert.Equal(t, false, cfg.GetBool("net.relay"))
 	assert.Equal(t, []string{}, cfg.GetStringSlice("net.peers"))
+	assert.Equal(t, "", cfg.GetString("net.pskpath"))
+	assert.Equal(t, false, cfg.GetBool("net.mdns"))
+	assert.Equal(t, 64, cfg.GetInt("net.maxtransfers"))
+	assert.Equal(t, 16, cfg.GetInt("net.maxpeertransfers"))
+	assert.Equal(t, 0, cfg.GetInt("net.maxbandwidth"))
+	assert.Equal(t, 4<<20, cfg.GetInt("net.maxblocksize"))
+	assert.Equal(t, 1000, cfg.GetInt("net.peermessagerate"))
+	assert.Equal(t, 100, cfg.GetInt("net.banthreshold"))
+	assert.Equal(t, 10*time.Minute, cfg.GetDuration("net.banduration"))
+	assert.Equal(t, false, cfg.GetBool("net.topicencryption"))
+	assert.Equal(t, []string{}, cfg.GetStringSlice("net.topickeypeers"))
 
 	assert.Equal(t, "info", cfg.GetString("log.level"))
 	assert.Equal(t, "stderr", cfg.GetString("log.output"))
@@ -69,6 +81,15 @@ func TestLoadConfigNotExist(t *testing.T) {
 	assert.Equal(t, "defradb", cfg.GetString("keyring.namespace"))
 	assert.Equal(t, "file", cfg.GetString("keyring.backend"))
 
+	assert.Equal(t, "", cfg.GetString("api.auth.jwks"))
+	assert.Equal(t, "", cfg.GetString("api.auth.issuer"))
+	assert.Equal(t, "", cfg.GetString("api.auth.audience"))
+	assert.Equal(t, "sub", cfg.GetString("api.auth.claim"))
+	assert.Equal(t, "", cfg.GetString("api.auth.identities"))
+
+	assert.Equal(t, false, cfg.GetBool("acp.document.audit.enable"))
+	assert.Equal(t, time.Duration(0), cfg.GetDuration("acp.document.audit.retention"))
+
 	assert.Equal(t, false, cfg.GetBool("development"))
 	assert.Equal(t, false, cfg.GetBool("telemetry.disabled"))
 }
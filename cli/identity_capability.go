@@ -0,0 +1,129 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/sourcenetwork/immutable"
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/crypto"
+)
+
+func MakeIdentityCapabilityCommand() *cobra.Command {
+	var identityArg string
+	var audienceArg string
+	var collectionArg string
+	var docIDArgs []string
+	var permissionArgs []string
+	var expirationArg time.Duration
+	var proofArg string
+
+	var cmd = &cobra.Command{
+		Use:   "capability --identity <identity> --audience <did> --collection <collection>",
+		Short: "Create a capability token delegating document permissions",
+		Long: `Create a capability token delegating document permissions.
+
+A capability token is a signed and expiring token, delegating a subset of the document
+permissions of the signing identity to another actor (the audience). It is passed along
+with requests using the --capability flag, or the "Capability" http header.
+
+The capabilities are only granted as long as the signing identity has the permissions
+itself, no relationship is added to the policy.
+
+The audience is the DID of the actor the capabilities are delegated to, or "*" for a token
+that can be used by anyone holding it (for example a share-link).
+
+A token can be re-delegated, signing a new token with the previous token as --proof. The
+new token can only delegate capabilities granted by the proof, and must be signed by the
+audience of the proof.
+
+Example: delegate read access to a document for an hour:
+  defradb identity capability -i 028d53f37a19afb9a0dbc5b4be30c65731479ee8cfa0c9bc8f8bf198cc3c075f \
+	--audience did:key:z7r8os2G88XXBNBTLj3kFR5rzUJ4VAesbX7PgsA68ak9B5RYcXF5EZEmjRzzinZndPSSwujXb4XKHG6vmKEFG6ZfsfcQn \
+	--collection Users --docID bae-ff3ceb1c-b5c0-5e86-a024-dd1b16a4261c --permission read --expiration 1h
+
+Example: create a share-link token delegating read access to all the documents of a collection:
+  defradb identity capability -i 028d53f37a19afb9a0dbc5b4be30c65731479ee8cfa0c9bc8f8bf198cc3c075f \
+	--audience "*" --collection Users --permission read
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := hex.DecodeString(identityArg)
+			if err != nil {
+				return err
+			}
+			issuer, err := identity.FromPrivateKey(crypto.NewPrivateKey(secp256k1.PrivKeyFromBytes(data)))
+			if err != nil {
+				return err
+			}
+
+			var capabilities []identity.Capability
+			for _, permission := range permissionArgs {
+				if len(docIDArgs) == 0 {
+					capabilities = append(capabilities, identity.Capability{
+						CollectionName: collectionArg,
+						Permission:     permission,
+					})
+				}
+				for _, docID := range docIDArgs {
+					capabilities = append(capabilities, identity.Capability{
+						CollectionName: collectionArg,
+						DocID:          docID,
+						Permission:     permission,
+					})
+				}
+			}
+
+			var proof immutable.Option[string]
+			if proofArg != "" {
+				proof = immutable.Some(proofArg)
+			}
+
+			token, err := identity.NewCapabilityToken(issuer, audienceArg, capabilities, expirationArg, proof)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(token))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&identityArg, "identity", "i", "",
+		"Hex formatted private key of the identity delegating the permissions")
+	_ = cmd.MarkFlagRequired("identity")
+
+	cmd.Flags().StringVar(&audienceArg, "audience", "",
+		`DID of the actor the permissions are delegated to, or "*" for any actor`)
+	_ = cmd.MarkFlagRequired("audience")
+
+	cmd.Flags().StringVarP(&collectionArg, "collection", "c", "",
+		"Collection of the documents the permissions are delegated on")
+	_ = cmd.MarkFlagRequired("collection")
+
+	cmd.Flags().StringSliceVar(&docIDArgs, "docID", nil,
+		"Document Identifiers the permissions are delegated on, empty for all the documents of the collection")
+
+	cmd.Flags().StringSliceVar(&permissionArgs, "permission", []string{"read"},
+		"Document permissions that are delegated")
+
+	cmd.Flags().DurationVar(&expirationArg, "expiration", time.Hour,
+		"Duration after which the capability token expires")
+
+	cmd.Flags().StringVar(&proofArg, "proof", "",
+		"Capability token the permissions are re-delegated from")
+
+	return cmd
+}
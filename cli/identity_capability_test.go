@@ -0,0 +1,50 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/crypto"
+)
+
+func TestIdentityCapability(t *testing.T) {
+	privKey, err := crypto.GenerateSecp256k1()
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	cmd := NewDefraCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{
+		"identity", "capability",
+		"--identity", hex.EncodeToString(privKey.Serialize()),
+		"--audience", identity.AnyAudience,
+		"--collection", "Users",
+		"--docID", "bae-1",
+		"--permission", "read,update",
+	})
+
+	err = cmd.Execute()
+	require.NoError(t, err)
+
+	token, err := identity.ParseCapabilityToken([]byte(strings.TrimSpace(out.String())))
+	require.NoError(t, err)
+
+	require.True(t, token.Grants("", "Users", "bae-1", "read"))
+	require.True(t, token.Grants("", "Users", "bae-1", "update"))
+	require.False(t, token.Grants("", "Users", "bae-1", "delete"))
+}
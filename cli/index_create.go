@@ -23,13 +23,17 @@ func MakeIndexCreateCommand() *cobra.Command {
 	var nameArg string
 	var fieldsArg []string
 	var uniqueArg bool
+	var blindArg bool
 	var cmd = &cobra.Command{
-		Use:   "create -c --collection <collection> --fields <fields[:ASC|:DESC]> [-n --name <name>] [--unique]",
+		Use:   "create -c --collection <collection> --fields <fields[:ASC|:DESC]> [-n --name <name>] [--unique] [--blind]",
 		Short: "Creates a secondary index on a collection's field(s)",
 		Long: `Creates a secondary index on a collection's field(s).
 		
 The --name flag is optional. If not provided, a name will be generated automatically.
 The --unique flag is optional. If provided, the index will be unique.
+The --blind flag is optional. If provided, the index will store keyed hashes of the values
+instead of the values, allowing equality filters on encrypted fields. Blind indexes can only be
+created if the node has a blind index secret, which is kept in the keyring.
 If no order is specified for the field, the default value will be "ASC"
 
 Example: create an index for 'Users' collection on 'name' field:
@@ -40,6 +44,9 @@ Example: create a named index for 'Users' collection on 'name' field:
  
 Example: create a unique index for 'Users' collection on 'name' in ascending order, and 'age' in descending order:
   defradb client index create --collection Users --fields name:ASC,age:DESC --unique
+
+Example: create a blind index for 'Users' collection on the encrypted 'ssn' field:
+  defradb client index create --collection Users --fields ssn --blind
 `,
 		ValidArgs: []string{"collection", "fields", "name"},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,6 +80,7 @@ Example: create a unique index for 'Users' collection on 'name' in ascending ord
 				Name:   nameArg,
 				Fields: fields,
 				Unique: uniqueArg,
+				Blind:  blindArg,
 			}
 			col, err := cliClient.GetCollectionByName(cmd.Context(), collectionArg)
 			if err != nil {
@@ -90,6 +98,7 @@ Example: create a unique index for 'Users' collection on 'name' in ascending ord
 	cmd.Flags().StringVarP(&nameArg, "name", "n", "", "Index name")
 	cmd.Flags().StringSliceVar(&fieldsArg, "fields", []string{}, "Fields to index")
 	cmd.Flags().BoolVarP(&uniqueArg, "unique", "u", false, "Make the index unique")
+	cmd.Flags().BoolVar(&blindArg, "blind", false, "Make the index a blind index")
 
 	return cmd
 }
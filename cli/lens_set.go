@@ -39,6 +39,14 @@ Example: set from file:
 Example: add from stdin:
   cat schema_migration.lens | defradb client lens set bae123 bae456 -
 
+Example: set a built in migration transform:
+  defradb client lens set bae123 bae456 \
+    '{"lenses": [{"path": "defradb:set_default", "arguments": {"dst": "verified", "value": true}}]}'
+
+Built in migration transforms do not require a lens wasm module, and their inverse is derived automatically.
+Available transforms: defradb:rename, defradb:set_default, defradb:copy, defradb:split, defradb:concat
+and defradb:convert.
+
 Learn more about the DefraDB GraphQL Schema Language on https://docs.source.network.`,
 		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,25 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeP2PBundleCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "bundle",
+		Short: "Export or import offline P2P bundles",
+		Long: `Export document DAGs to or import them from an offline bundle.
+Bundles are CAR files that allow disconnected nodes to converge without a network connection.`,
+	}
+	return cmd
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeP2PBundleExportCommand() *cobra.Command {
+	var collections []string
+	var since string
+	var cmd = &cobra.Command{
+		Use:   "export [-c --collections] [--since <checkpoint>] <output_path>",
+		Short: "Export document DAGs to an offline bundle",
+		Long: `Export document DAGs to an offline bundle. If a file exists at the <output_path> location,
+it will be overwritten.
+
+If the --collections flag is provided, only the documents of those collections will be exported.
+Otherwise, the documents of all collections will be exported.
+
+Each export returns a checkpoint. If the --since flag is provided with the checkpoint of a previous
+export, only the blocks that were created after that export will be included in the bundle.
+
+Example: export the 'Users' collection:
+  defradb client p2p bundle export --collections Users users.car
+
+Example: export the changes made since a previous export:
+  defradb client p2p bundle export --collections Users --since bafkrei... users.car
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliClient := mustGetContextCLIClient(cmd)
+
+			for i := range collections {
+				collections[i] = strings.Trim(collections[i], " ")
+			}
+
+			res, err := cliClient.ExportBundle(cmd.Context(), client.BundleExportConfig{
+				Filepath:    args[0],
+				Collections: collections,
+				Since:       since,
+			})
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, res)
+		},
+	}
+	cmd.Flags().StringSliceVarP(&collections, "collections", "c", []string{}, "List of collections")
+	cmd.Flags().StringVar(&since, "since", "", "Checkpoint of a previous export to export the changes from")
+	return cmd
+}
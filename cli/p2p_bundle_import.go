@@ -0,0 +1,52 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
+
+func MakeP2PBundleImportCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "import <input_path>",
+		Short: "Import document DAGs from an offline bundle",
+		Long: `Import document DAGs from an offline bundle.
+
+The signatures of the imported blocks are verified before they are merged into the local documents.
+Bundles exported with a checkpoint can only be imported once the bundle of that checkpoint
+has been imported. Encryption keys are not part of bundles, documents whose keys are not
+available locally are not merged until they are synced with a peer that holds the keys.
+
+Example: import a bundle:
+  defradb client p2p bundle import users.car
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if timeout, _ := cmd.Flags().GetDuration("timeout"); timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			cliClient := mustGetContextCLIClient(cmd)
+			res, err := cliClient.ImportBundle(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, res)
+		},
+	}
+	cmd.Flags().Duration("timeout", 0, "Timeout for import operations")
+	return cmd
+}
@@ -11,17 +11,45 @@
 package cli
 
 import (
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
+// p2pInfo is the output of the p2p info command.
+type p2pInfo struct {
+	ID          peer.ID
+	Addrs       []string
+	BannedPeers []client.BannedPeer
+}
+
 func MakeP2PInfoCommand() *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "info",
 		Short: "Get peer info from a DefraDB node",
-		Long:  `Get peer info from a DefraDB node`,
+		Long: `Get peer info from a DefraDB node.
+
+The peers that are temporarily banned because of their misbehaviour are listed along with
+the time at which their ban expires.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliClient := mustGetContextCLIClient(cmd)
-			return writeJSON(cmd, cliClient.PeerInfo())
+
+			peerInfo := cliClient.PeerInfo()
+			bannedPeers, err := cliClient.GetBannedPeers(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			info := p2pInfo{
+				ID:          peerInfo.ID,
+				Addrs:       make([]string, len(peerInfo.Addrs)),
+				BannedPeers: bannedPeers,
+			}
+			for i, addr := range peerInfo.Addrs {
+				info.Addrs[i] = addr.String()
+			}
+			return writeJSON(cmd, info)
 		},
 	}
 	return cmd
@@ -0,0 +1,87 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/sourcenetwork/immutable"
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeSchemaMigrateCommand() *cobra.Command {
+	var collectionArg string
+	var versionArg string
+	var batchSize int
+	var maxBatches int
+	var cmd = &cobra.Command{
+		Use:   "migrate -c --collection <collection> [--to <versionID>] [--batch-size <size>] [--batches <count>]",
+		Short: "Migrate the stored documents of a collection to a collection version",
+		Long: `Migrate the stored documents of a collection to a collection version.
+
+Documents are rewritten to the given version in batches, and their index entries are updated
+accordingly. The progress of the migration is written out after each batch. If interrupted,
+running the command again will resume the migration from the last completed batch.
+
+If no version is given, documents are migrated to the active version of the named collection.
+Documents received later on over P2P are still migrated lazily when read.
+
+Only the documents the identity has the update permission on are migrated, the others are
+reported as skipped and may be migrated by running the command again with the node identity.
+
+Example: migrate all documents of the 'User' collection to the active version:
+  defradb client schema migrate --collection User
+
+Example: migrate a single batch of 50 documents to the given version:
+  defradb client schema migrate --collection User --to bae123 --batch-size 50 --batches 1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliClient := mustGetContextCLIClient(cmd)
+
+			options := client.CollectionFetchOptions{}
+			if versionArg != "" {
+				// Inactive collection versions are not looked up by name.
+				options.VersionID = immutable.Some(versionArg)
+				options.IncludeInactive = immutable.Some(true)
+			} else {
+				options.Name = immutable.Some(collectionArg)
+			}
+			cols, err := cliClient.GetCollections(cmd.Context(), options)
+			if err != nil {
+				return err
+			}
+			if len(cols) != 1 {
+				if versionArg != "" {
+					return client.NewErrCollectionNotFoundForCollectionVersion(versionArg)
+				}
+				return client.NewErrCollectionNotFoundForName(collectionArg)
+			}
+
+			for batch := 0; maxBatches <= 0 || batch < maxBatches; batch++ {
+				progress, err := cols[0].MigrateDocuments(cmd.Context(), batchSize)
+				if err != nil {
+					return err
+				}
+				if err := writeJSON(cmd, progress); err != nil {
+					return err
+				}
+				if progress.Done {
+					break
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&collectionArg, "collection", "c", "", "Collection name")
+	cmd.Flags().StringVar(&versionArg, "to", "", "Collection version ID to migrate the documents to")
+	cmd.Flags().IntVar(&batchSize, "batch-size", 100, "Number of documents to process per batch")
+	cmd.Flags().IntVar(&maxBatches, "batches", 0, "Maximum number of batches to run, or zero to run until done")
+	return cmd
+}
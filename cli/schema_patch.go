@@ -42,6 +42,14 @@ Example: patch from file:
 Example: patch from stdin:
   cat patch.json | defradb client schema patch -
 
+Example: rename a field using a built in migration transform:
+  defradb client schema patch '[{ "op": "replace", "path": "/Users/Fields/1/Name", "value": "fullName" }]' \
+    '{"lenses": [{"path": "defradb:rename", "arguments": {"src": "name", "dst": "fullName"}}]}'
+
+Built in migration transforms do not require a lens wasm module, and their inverse is derived automatically.
+Available transforms: defradb:rename, defradb:set_default, defradb:copy, defradb:split, defradb:concat
+and defradb:convert.
+
 To learn more about the DefraDB GraphQL Schema Language, refer to https://docs.source.network.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliClient := mustGetContextCLIClient(cmd)
@@ -73,7 +81,7 @@ To learn more about the DefraDB GraphQL Schema Language, refer to https://docs.s
 				if err != nil {
 					return err
 				}
-				patch = string(data)
+				lensCfgJson = string(data)
 			case len(args) == 2:
 				lensCfgJson = args[1]
 			}
@@ -12,11 +12,14 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/pnet"
 	"github.com/sourcenetwork/immutable"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -28,6 +31,7 @@ import (
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/http"
 	"github.com/sourcenetwork/defradb/internal/db"
+	"github.com/sourcenetwork/defradb/internal/kms"
 	"github.com/sourcenetwork/defradb/internal/telemetry"
 	"github.com/sourcenetwork/defradb/keyring"
 	netConfig "github.com/sourcenetwork/defradb/net/config"
@@ -104,6 +108,14 @@ func MakeStartCommand() *cobra.Command {
 				netConfig.WithEnablePubSub(cfg.GetBool("net.pubSubEnabled")),
 				netConfig.WithEnableRelay(cfg.GetBool("net.relayEnabled")),
 				netConfig.WithBootstrapPeers(cfg.GetStringSlice("net.peers")...),
+				netConfig.WithEnableMDNS(cfg.GetBool("net.mdns")),
+				netConfig.WithMaxTransfers(cfg.GetInt("net.maxtransfers")),
+				netConfig.WithMaxTransfersPerPeer(cfg.GetInt("net.maxpeertransfers")),
+				netConfig.WithMaxTransferBandwidth(cfg.GetInt("net.maxbandwidth")),
+				netConfig.WithMaxBlockSize(cfg.GetInt("net.maxblocksize")),
+				netConfig.WithPeerMessageRate(cfg.GetInt("net.peermessagerate")),
+				netConfig.WithBanThreshold(cfg.GetInt("net.banthreshold")),
+				netConfig.WithBanDuration(cfg.GetDuration("net.banduration")),
 				netConfig.WithRetryInterval(replicatorRetryIntervals),
 
 				// http server options
@@ -113,6 +125,24 @@ func MakeStartCommand() *cobra.Command {
 				http.WithTLSKeyPath(cfg.GetString("api.privKeyPath")),
 			}
 
+			pskPath := cfg.GetString("net.pskpath")
+			if pskPath != "" {
+				psk, err := readPrivateNetworkKey(pskPath)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, netConfig.WithPrivateNetworkKey(psk))
+			}
+
+			if cfg.GetBool("net.topicEncryption") {
+				// Topic keys are distributed through the KMS so it must be enabled.
+				opts = append(opts,
+					netConfig.WithEnableTopicEncryption(true),
+					node.WithKMS(kms.PubSubServiceType),
+					node.WithTopicKeyPeers(cfg.GetStringSlice("net.topicKeyPeers")...),
+				)
+			}
+
 			if cfg.GetString("datastore.store") != configStoreMemory {
 				rootDir := mustGetContextRootDir(cmd)
 				// TODO-ACP: Infuture when we add support for the --no-acp flag when node acp is implemented,
@@ -131,6 +161,8 @@ func MakeStartCommand() *cobra.Command {
 				opts = append(opts, node.WithDocumentACPType(node.DocumentACPType(documentACPType)))
 			}
 
+			// The secret used to derive the identities of the actors authenticated with third-party tokens.
+			var authIdentitySecret []byte
 			if !cfg.GetBool("keyring.disabled") {
 				kr, err := openKeyring(cmd)
 				if err != nil {
@@ -153,6 +185,18 @@ func MakeStartCommand() *cobra.Command {
 					return err
 				}
 
+				opts, err = getOrCreateBlindIndexSecret(kr, opts)
+				if err != nil {
+					return err
+				}
+
+				if cfg.GetString("api.auth.jwks") != "" {
+					authIdentitySecret, err = getOrCreateAuthIdentitySecret(kr)
+					if err != nil {
+						return err
+					}
+				}
+
 				// setup the sourcehub transaction signer
 				sourceHubKeyName := cfg.GetString("acp.document.sourceHub.KeyName")
 				if sourceHubKeyName != "" {
@@ -164,7 +208,19 @@ func MakeStartCommand() *cobra.Command {
 				}
 			}
 
+			if cfg.GetString("api.auth.jwks") != "" {
+				authenticator, err := newJWTAuthenticator(cmd.Context(), cfg, authIdentitySecret)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, http.WithAuthenticator(authenticator))
+			}
+
 			opts = append(opts, db.WithEnabledSigning(!cfg.GetBool("datastore.nosigning")))
+			opts = append(opts,
+				db.WithACPAuditLog(cfg.GetBool("acp.document.audit.enable")),
+				db.WithACPAuditRetention(cfg.GetDuration("acp.document.audit.retention")),
+			)
 
 			isDevMode := cfg.GetBool("development")
 			http.IsDevMode = isDevMode
@@ -278,6 +334,61 @@ func MakeStartCommand() *cobra.Command {
 		cfg.GetBool(configFlags["no-p2p"]),
 		"Disable the peer-to-peer network synchronization system",
 	)
+	cmd.PersistentFlags().Bool(
+		"p2p-mdns",
+		cfg.GetBool(configFlags["p2p-mdns"]),
+		"Enable the discovery of and connection to peers on the local network using mDNS",
+	)
+	cmd.PersistentFlags().Int(
+		"p2p-max-transfers",
+		cfg.GetInt(configFlags["p2p-max-transfers"]),
+		"Maximum number of concurrent block transfers with other peers. Zero means no limit",
+	)
+	cmd.PersistentFlags().Int(
+		"p2p-max-peer-transfers",
+		cfg.GetInt(configFlags["p2p-max-peer-transfers"]),
+		"Maximum number of concurrent block transfers with a single peer. Zero means no limit",
+	)
+	cmd.PersistentFlags().Int(
+		"p2p-max-bandwidth",
+		cfg.GetInt(configFlags["p2p-max-bandwidth"]),
+		"Maximum number of block bytes transferred with other peers per second. Zero means no limit",
+	)
+	cmd.PersistentFlags().Int(
+		"p2p-max-block-size",
+		cfg.GetInt(configFlags["p2p-max-block-size"]),
+		"Maximum size in bytes of the blocks pushed by other peers. Zero means no limit",
+	)
+	cmd.PersistentFlags().Int(
+		"p2p-peer-message-rate",
+		cfg.GetInt(configFlags["p2p-peer-message-rate"]),
+		"Maximum number of messages per second accepted from a single peer. Zero means no limit",
+	)
+	cmd.PersistentFlags().Int(
+		"p2p-ban-threshold",
+		cfg.GetInt(configFlags["p2p-ban-threshold"]),
+		"Misbehaviour score at which a peer is temporarily banned. Zero disables bans",
+	)
+	cmd.PersistentFlags().Duration(
+		"p2p-ban-duration",
+		cfg.GetDuration(configFlags["p2p-ban-duration"]),
+		"Amount of time a misbehaving peer is banned for",
+	)
+	cmd.PersistentFlags().String(
+		"p2p-psk-path",
+		cfg.GetString(configFlags["p2p-psk-path"]),
+		"Path to the pre-shared key file of the private p2p network to join (libp2p swarm key format)",
+	)
+	cmd.PersistentFlags().Bool(
+		"p2p-topic-encryption",
+		cfg.GetBool(configFlags["p2p-topic-encryption"]),
+		"Encrypt the payloads published to document and collection pubsub topics. Enables the KMS.",
+	)
+	cmd.PersistentFlags().StringSlice(
+		"p2p-topic-key-peers",
+		cfg.GetStringSlice(configFlags["p2p-topic-key-peers"]),
+		"IDs of the peers allowed to receive pubsub topic keys. If empty, only private network peers can receive them",
+	)
 	cmd.PersistentFlags().StringArray(
 		"allowed-origins",
 		cfg.GetStringSlice(configFlags["allowed-origins"]),
@@ -327,6 +438,34 @@ func MakeStartCommand() *cobra.Command {
 		"document-acp-type",
 		cfg.GetString(configFlags["document-acp-type"]),
 		"Specify the document acp engine to use (supported: none (default), local, source-hub)")
+	cmd.PersistentFlags().Bool(
+		"document-acp-audit",
+		cfg.GetBool(configFlags["document-acp-audit"]),
+		"Record the document acp authorization decisions in the audit log")
+	cmd.PersistentFlags().Duration(
+		"document-audit-retention",
+		cfg.GetDuration(configFlags["document-audit-retention"]),
+		"Amount of time the audit log records are kept for. Zero keeps the records forever")
+	cmd.Flags().String(
+		"auth-jwks",
+		cfg.GetString(configFlags["auth-jwks"]),
+		"Path or URL of the JWKS used to verify the third-party (OIDC) tokens authenticating the actors")
+	cmd.Flags().String(
+		"auth-issuer",
+		cfg.GetString(configFlags["auth-issuer"]),
+		"Required issuer of the third-party tokens. Must be set if auth-jwks is set")
+	cmd.Flags().String(
+		"auth-audience",
+		cfg.GetString(configFlags["auth-audience"]),
+		"Required audience of the third-party tokens. Must be set if auth-jwks is set")
+	cmd.Flags().String(
+		"auth-claim",
+		cfg.GetString(configFlags["auth-claim"]),
+		"Claim of the third-party tokens that is mapped to an identity")
+	cmd.Flags().String(
+		"auth-identities",
+		cfg.GetString(configFlags["auth-identities"]),
+		"Path to a JSON file mapping the identity claim values of the third-party tokens to identity DIDs")
 	cmd.PersistentFlags().IntSlice(
 		"replicator-retry-intervals",
 		cfg.GetIntSlice(configFlags["replicator-retry-intervals"]),
@@ -336,6 +475,15 @@ func MakeStartCommand() *cobra.Command {
 	return cmd
 }
 
+// readPrivateNetworkKey reads the private network pre-shared key from the swarm key file at the given path.
+func readPrivateNetworkKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return pnet.DecodeV1PSK(bytes.NewReader(data))
+}
+
 func getOrCreateEncryptionKey(kr keyring.Keyring, opts []node.Option) ([]node.Option, error) {
 	encryptionKey, err := kr.Get(encryptionKeyName)
 	if err != nil {
@@ -356,6 +504,78 @@ func getOrCreateEncryptionKey(kr keyring.Keyring, opts []node.Option) ([]node.Op
 	return opts, nil
 }
 
+func getOrCreateBlindIndexSecret(kr keyring.Keyring, opts []node.Option) ([]node.Option, error) {
+	secret, err := kr.Get(blindIndexKeyName)
+	if err != nil {
+		if !errors.Is(err, keyring.ErrNotFound) {
+			return nil, err
+		}
+		secret, err = crypto.GenerateAES256()
+		if err != nil {
+			return nil, err
+		}
+		err = kr.Set(blindIndexKeyName, secret)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("generated blind index key")
+	}
+	opts = append(opts, db.WithBlindIndexSecret(secret))
+	return opts, nil
+}
+
+func getOrCreateAuthIdentitySecret(kr keyring.Keyring) ([]byte, error) {
+	secret, err := kr.Get(authIdentityKeyName)
+	if err != nil {
+		if !errors.Is(err, keyring.ErrNotFound) {
+			return nil, err
+		}
+		secret, err = crypto.GenerateAES256()
+		if err != nil {
+			return nil, err
+		}
+		err = kr.Set(authIdentityKeyName, secret)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("generated auth identity key")
+	}
+	return secret, nil
+}
+
+// newJWTAuthenticator returns a new authenticator of the third-party tokens, configured
+// from the given config.
+//
+// The identities of unregistered actors are only derived if an identity secret is given.
+func newJWTAuthenticator(
+	ctx context.Context,
+	cfg *viper.Viper,
+	identitySecret []byte,
+) (*http.JWTAuthenticator, error) {
+	authOpts := []http.JWTAuthenticatorOpt{
+		http.WithJWTIssuer(cfg.GetString("api.auth.issuer")),
+		http.WithJWTAudience(cfg.GetString("api.auth.audience")),
+		http.WithJWTIdentityClaim(cfg.GetString("api.auth.claim")),
+		http.WithJWTIdentitySecret(identitySecret),
+	}
+
+	identitiesPath := cfg.GetString("api.auth.identities")
+	if identitiesPath != "" {
+		data, err := os.ReadFile(identitiesPath)
+		if err != nil {
+			return nil, err
+		}
+		var identities map[string]string
+		err = json.Unmarshal(data, &identities)
+		if err != nil {
+			return nil, err
+		}
+		authOpts = append(authOpts, http.WithJWTIdentities(identities))
+	}
+
+	return http.NewJWTAuthenticator(ctx, cfg.GetString("api.auth.jwks"), authOpts...)
+}
+
 func getOrCreatePeerKey(kr keyring.Keyring, opts []node.Option) ([]node.Option, error) {
 	peerKey, err := kr.Get(peerKeyName)
 	if err != nil && errors.Is(err, keyring.ErrNotFound) {
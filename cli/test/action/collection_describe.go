@@ -57,6 +57,7 @@ func (a *CollectionDescribe) Execute() {
 		require.Equal(a.s.T, expected.Version.Name, actual.Version.Name)
 		require.Equal(a.s.T, expected.Version.IsMaterialized, actual.Version.IsMaterialized)
 		require.Equal(a.s.T, expected.Version.IsBranchable, actual.Version.IsBranchable)
+		require.Equal(a.s.T, expected.Version.IsEncrypted, actual.Version.IsEncrypted)
 
 		if expected.Version.Indexes != nil || len(actual.Version.Indexes) != 0 {
 			// Dont bother asserting this if the expected is nil and the actual is nil/empty.
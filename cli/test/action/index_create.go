@@ -37,6 +37,9 @@ type IndexCreate struct {
 	// Whether the index should be unique (optional, default: false).
 	Unique bool
 
+	// Whether the index should be a blind index (optional, default: false).
+	Blind bool
+
 	// The expected index description.
 	// If provided, it will be compared with the actual result.
 	Expected immutable.Option[client.IndexDescription]
@@ -74,6 +77,10 @@ func (a *IndexCreate) Execute() {
 		args = append(args, "--unique")
 	}
 
+	if a.Blind {
+		args = append(args, "--blind")
+	}
+
 	args = append(args, a.AdditionalArgs...)
 	args = a.AppendDirections(args)
 
@@ -97,5 +104,6 @@ func (a *IndexCreate) Execute() {
 		}
 		require.Equal(a.s.T, expected.Fields, result.Fields)
 		require.Equal(a.s.T, expected.Unique, result.Unique)
+		require.Equal(a.s.T, expected.Blind, result.Blind)
 	}
 }
@@ -72,6 +72,7 @@ func (a *IndexList) Execute() {
 				require.Equal(a.s.T, expected.Name, actual.Name)
 				require.Equal(a.s.T, expected.Fields, actual.Fields)
 				require.Equal(a.s.T, expected.Unique, actual.Unique)
+				require.Equal(a.s.T, expected.Blind, actual.Blind)
 			}
 		}
 	} else {
@@ -103,6 +104,7 @@ func (a *IndexList) Execute() {
 					require.Equal(a.s.T, expected.Name, actual.Name)
 					require.Equal(a.s.T, expected.Fields, actual.Fields)
 					require.Equal(a.s.T, expected.Unique, actual.Unique)
+					require.Equal(a.s.T, expected.Blind, actual.Blind)
 				}
 			}
 		}
@@ -35,6 +35,8 @@ const (
 	peerKeyName         = "peer-key"
 	encryptionKeyName   = "encryption-key"
 	nodeIdentityKeyName = "node-identity-key"
+	authIdentityKeyName = "auth-identity-key"
+	blindIndexKeyName   = "blind-index-key"
 )
 
 type contextKey string
@@ -161,6 +163,20 @@ func setContextIdentity(cmd *cobra.Command, privateKeyHex string) error {
 	return nil
 }
 
+// setContextCapability sets the capability token for the current command context.
+func setContextCapability(cmd *cobra.Command, token string) error {
+	if token == "" {
+		return nil
+	}
+	capability, err := acpIdentity.ParseCapabilityToken([]byte(token))
+	if err != nil {
+		return err
+	}
+	ctx := acpIdentity.WithCapability(cmd.Context(), immutable.Some(capability))
+	cmd.SetContext(ctx)
+	return nil
+}
+
 // setContextRootDir sets the rootdir for the current command context.
 func setContextRootDir(cmd *cobra.Command) error {
 	rootdir, err := cmd.Root().PersistentFlags().GetString("rootdir")
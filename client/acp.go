@@ -10,6 +10,8 @@
 
 package client
 
+import "time"
+
 // PolicyDescription describes a policy using it's ID and it's resource name, where:
 // 1) the ID is the policyID of the registered policy on the document acp system and
 // 2) the resource name is of a valid resource that adheres to the corresponding
@@ -22,6 +24,23 @@ type PolicyDescription struct {
 
 	// ResourceName is the name of the corresponding resource within the policy.
 	ResourceName string
+
+	// FieldRelations contains the relations of the resource that are derived from document fields.
+	//
+	// The actors (DIDs) held in the field of a document are kept in a relationship with the
+	// document, as the document is created, updated and deleted.
+	FieldRelations []PolicyFieldRelation `json:",omitempty"`
+}
+
+// PolicyFieldRelation describes a relation of a policy resource that is derived from a document field.
+type PolicyFieldRelation struct {
+	// FieldName is the name of the field holding the actor(s) of the relation.
+	//
+	// The field must be a `String` or a `[String]` field.
+	FieldName string
+
+	// Relation is the name of the relation within the resource of the policy.
+	Relation string
 }
 
 // AddPolicyResult wraps the result of successfully adding/registering a Policy.
@@ -45,6 +64,90 @@ type DeleteActorRelationshipResult struct {
 	RecordFound bool
 }
 
+// DACActorRelationshipBatch describes a relationship between a target actor and a batch of documents.
+type DACActorRelationshipBatch struct {
+	// CollectionName is the name of the collection that has the documents.
+	CollectionName string
+
+	// DocIDs are the documents of the batch.
+	DocIDs []string
+
+	// Filter is an optional filter, all the documents of the collection that match the filter
+	// (and are readable by the requesting identity) are part of the batch, in addition to DocIDs.
+	Filter any
+
+	// Relation is the name of the relation (must be defined within the linked policy on collection).
+	Relation string
+
+	// TargetActor is the actor the relationships are made with (or deleted for).
+	TargetActor string
+}
+
+// ActorRelationshipBatchResult wraps the result of making or deleting the relationships of a batch.
+type ActorRelationshipBatchResult struct {
+	// Changed is the number of relationships that were made or deleted.
+	Changed int
+
+	// Unchanged is the number of relationships that existed already when making them, or
+	// were not found when deleting them (no-op).
+	Unchanged int
+}
+
+// ACPAuditRecord is a record of an authorization decision made by the document acp system, kept
+// in the acp audit log.
+type ACPAuditRecord struct {
+	// Timestamp is the time at which the decision was made.
+	Timestamp time.Time
+
+	// Identity is the DID of the actor that requested access, empty if the request had no identity.
+	Identity string
+
+	// Operation is the operation access was checked for, i.e. "query", "create", "update", "delete", or
+	// "sync" when a peer syncs the document.
+	//
+	// It may differ from the permission checked, for example an update checks the read permission of
+	// the documents it targets.
+	Operation string
+
+	// CollectionName is the name of the collection of the document.
+	CollectionName string
+
+	// DocID is the ID of the document, empty if the decision was made for the collection itself.
+	DocID string
+
+	// Permission is the permission that was checked, for example "read" or "read:salary".
+	Permission string
+
+	// Allowed is true if access was granted, and false if it was denied.
+	Allowed bool
+}
+
+// ACPAuditLogQuery selects the records of the acp audit log.
+//
+// The zero value of each field matches all records.
+type ACPAuditLogQuery struct {
+	// Identity only matches the records of the actor with this DID.
+	Identity string
+
+	// CollectionName only matches the records of this collection.
+	CollectionName string
+
+	// DocID only matches the records of this document.
+	DocID string
+
+	// Since only matches the records made at or after this time.
+	Since time.Time
+
+	// Until only matches the records made before this time.
+	Until time.Time
+
+	// OnlyDenied only matches the records of decisions that denied access.
+	OnlyDenied bool
+
+	// Limit is the maximum number of records to return, the oldest records are returned first.
+	Limit int
+}
+
 // NACStatus represents the current state/status of the Node ACP system.
 type NACStatus int
 
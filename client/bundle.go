@@ -0,0 +1,54 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+// BundleExportConfig holds the configuration parameters for offline bundle exports.
+type BundleExportConfig struct {
+	// Filepath is the location of the CAR file to write the bundle to.
+	//
+	// If a file already exists at this location, it will be truncated and overwriten.
+	Filepath string `json:"filepath"`
+	// List of collection names to select which one to export.
+	//
+	// If empty, all collections are exported.
+	Collections []string `json:"collections"`
+	// Since is the checkpoint of a previous export.
+	//
+	// If set, only the blocks that were created after that export are included in the bundle.
+	Since string `json:"since"`
+}
+
+// BundleExportResult contains the results of an offline bundle export.
+type BundleExportResult struct {
+	// Checkpoint identifies the state of the exported DAGs and can be used
+	// as the starting point of a later export.
+	Checkpoint string `json:"checkpoint"`
+	// Heads is the number of document heads described by the bundle.
+	Heads int `json:"heads"`
+	// Blocks is the number of blocks included in the bundle.
+	Blocks int `json:"blocks"`
+}
+
+// BundleImportResult contains the results of an offline bundle import.
+type BundleImportResult struct {
+	// Checkpoint is the checkpoint of the imported bundle.
+	Checkpoint string `json:"checkpoint"`
+	// Blocks is the number of blocks imported from the bundle.
+	Blocks int `json:"blocks"`
+	// Merged is the number of document heads that were merged.
+	Merged int `json:"merged"`
+	// Encrypted is the number of document heads that were not merged because
+	// the encryption keys of their blocks are not available locally.
+	//
+	// Their blocks are stored and they are merged by a later sync with a peer
+	// that can provide the keys.
+	Encrypted int `json:"encrypted"`
+}
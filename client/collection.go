@@ -44,6 +44,62 @@ func CreateDocWithEncryptedFields(encryptedFields []string) DocCreateOption {
 	}
 }
 
+// EncryptionKeyRotationOption is a functional option for rotating the encryption key of a document.
+type EncryptionKeyRotationOption func(*EncryptionKeyRotationOptions)
+
+// EncryptionKeyRotationOptions contains options for rotating the encryption key of a document.
+type EncryptionKeyRotationOptions struct {
+	// Fields are the individually encrypted fields whose keys are rotated.
+	//
+	// If empty, the key of the document is rotated instead.
+	Fields []string
+	// ReEncrypt re-encrypts the current values of the document with the new keys.
+	//
+	// Otherwise only the values written after the rotation are encrypted with the new keys.
+	ReEncrypt bool
+}
+
+// Apply applies the given EncryptionKeyRotationOptions to the EncryptionKeyRotationOptions receiver.
+func (o *EncryptionKeyRotationOptions) Apply(opts []EncryptionKeyRotationOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// RotateKeyOfFields specifies a list of individually encrypted fields whose keys are rotated.
+func RotateKeyOfFields(fields []string) EncryptionKeyRotationOption {
+	return func(opts *EncryptionKeyRotationOptions) {
+		opts.Fields = fields
+	}
+}
+
+// RotateKeyWithReEncryption enables or disables the re-encryption of the current values of the document.
+func RotateKeyWithReEncryption(reEncrypt bool) EncryptionKeyRotationOption {
+	return func(opts *EncryptionKeyRotationOptions) {
+		opts.ReEncrypt = reEncrypt
+	}
+}
+
+// DocumentMigrationProgress describes the progress of the migration of the stored documents of a
+// collection to its collection version.
+type DocumentMigrationProgress struct {
+	// Migrated is the number of documents rewritten by the last batch.
+	Migrated int `json:"migrated"`
+	// Processed is the number of documents processed so far, including those processed by
+	// previous batches.
+	Processed int `json:"processed"`
+	// Skipped is the number of documents processed so far that were not migrated, as the identity
+	// does not have the update permission on them.
+	//
+	// They may be migrated by running the migration again with an identity that has access to them,
+	// such as the node identity.
+	Skipped int `json:"skipped"`
+	// Total is the number of documents within the collection.
+	Total int `json:"total"`
+	// Done is true if all the documents of the collection have been processed.
+	Done bool `json:"done"`
+}
+
 // Collection represents a defradb collection.
 //
 // A Collection is mostly analogous to a SQL table, however a collection is specific to its
@@ -101,6 +157,27 @@ type Collection interface {
 	// This includes data, block, and head storage.
 	Delete(ctx context.Context, docID DocID) (bool, error)
 
+	// RotateEncryptionKey generates a new encryption key for the document with the given DocID, or for
+	// its individually encrypted fields if specified.
+	//
+	// The values written after the rotation are encrypted with the new key, and the current values are
+	// also re-encrypted with it if requested. Previous values remain encrypted with the key of their
+	// own epoch, which is still served to authorized peers.
+	//
+	// Will return a ErrDocumentNotFound error if the given document is not found.
+	RotateEncryptionKey(ctx context.Context, docID DocID, opts ...EncryptionKeyRotationOption) error
+
+	// MigrateDocuments rewrites the next batch of stored documents that are at a different schema
+	// version to the version of this collection, updating their index entries accordingly.
+	//
+	// Progress is persisted after each batch, allowing the migration to be resumed by calling this
+	// function again until the returned progress is done. Documents received later on over P2P are
+	// still migrated lazily when read.
+	//
+	// Only the documents the identity has the update permission on are migrated, the others are
+	// reported as skipped.
+	MigrateDocuments(ctx context.Context, batchSize int) (DocumentMigrationProgress, error)
+
 	// Exists checks if a given document exists with supplied DocID.
 	//
 	// Will return true if a matching document exists, otherwise will return false.
@@ -82,6 +82,17 @@ type CollectionVersion struct {
 	// another collection/query (is a View).
 	IsMaterialized bool
 
+	// IsAutoRefreshed defines whether the cached items of this materialized view are kept up
+	// to date automatically as the documents they are sourced from change.
+	//
+	// If it is true, the cache will be updated in the background after each change to a source
+	// document, if false, the cache will only be updated when the views are explicitly refreshed.
+	//
+	// The background updates are made on behalf of the node identity, if any.
+	//
+	// This can only be set to `true` if this collection is a materialized View without a policy.
+	IsAutoRefreshed bool
+
 	// IsBranchable defines whether the history of this collection is tracked as a single,
 	// verifiable entity.
 	//
@@ -103,6 +114,22 @@ type CollectionVersion struct {
 	// If true, it will not be directly queriable.
 	IsEmbeddedOnly bool
 
+	// IsJoin defines whether this collection was generated to hold the links of a many-to-many
+	// relation or not.
+	//
+	// If true, it will not be directly queriable, its documents are managed through the relation
+	// fields of the collections it links.
+	IsJoin bool
+
+	// IsEncrypted defines whether the documents of this collection are encrypted or not.
+	//
+	// If true, every document created in this collection will be encrypted, as if
+	// the creation had been requested with document encryption, regardless of the client
+	// the request was made from.
+	//
+	// Fields of this collection may not be indexed if it is true.
+	IsEncrypted bool
+
 	// VectorEmbeddings contains the configuration for generating embedding vectors.
 	//
 	// This is only usable with array fields.
@@ -208,8 +235,11 @@ type collectionVersion struct {
 	CollectionID     string
 	RootID           uint32
 	IsMaterialized   bool
+	IsAutoRefreshed  bool
 	IsBranchable     bool
 	IsEmbeddedOnly   bool
+	IsJoin           bool
+	IsEncrypted      bool
 	IsActive         bool
 	Policy           immutable.Option[PolicyDescription]
 	Indexes          []IndexDescription
@@ -231,8 +261,11 @@ func (c *CollectionVersion) UnmarshalJSON(bytes []byte) error {
 	c.VersionID = descMap.VersionID
 	c.CollectionID = descMap.CollectionID
 	c.IsMaterialized = descMap.IsMaterialized
+	c.IsAutoRefreshed = descMap.IsAutoRefreshed
 	c.IsBranchable = descMap.IsBranchable
 	c.IsEmbeddedOnly = descMap.IsEmbeddedOnly
+	c.IsJoin = descMap.IsJoin
+	c.IsEncrypted = descMap.IsEncrypted
 	c.IsActive = descMap.IsActive
 	c.Indexes = descMap.Indexes
 	c.Fields = descMap.Fields
@@ -42,6 +42,125 @@ type CollectionFieldDescription struct {
 	// Mutations on fields with a size constraint will fail if the size of the array
 	// does not match the constraint.
 	Size int
+
+	// Constraints contains the constraints that the values of this field must satisfy.
+	//
+	// Mutations that would result in a document violating a constraint will fail.
+	Constraints FieldConstraints
+
+	// IsEncrypted defines whether this field is individually encrypted or not.
+	//
+	// If true, the values of this field will be encrypted with a key of their own, as if
+	// the creation of every document had been requested with field encryption.
+	//
+	// Encrypted fields may not be indexed.
+	IsEncrypted bool
+
+	// OnDelete defines what happens to documents referencing another document through this field
+	// when the referenced document is deleted.
+	//
+	// It may only be set on the primary side of a relation.
+	OnDelete RelationDeleteAction
+
+	// Computed contains the expression that the value of this field is computed from, if this
+	// is a computed field.
+	//
+	// Computed fields may not be set directly.
+	Computed immutable.Option[ComputedFieldDescription]
+}
+
+// ComputedFieldDescription describes how the value of a computed field is derived from the other
+// fields of the same document.
+type ComputedFieldDescription struct {
+	// Expression is the expression evaluated to compute the value of the field.
+	//
+	// It may reference any non-computed scalar field of the collection by name, with the exception
+	// of encrypted fields and fields with field permissions.
+	Expression string
+
+	// IsStored defines whether the value of the field is computed when the document is written
+	// and stored with it, or computed each time the document is read.
+	//
+	// Only stored computed fields may be indexed. Stored values are recomputed when the fields
+	// referenced by the expression are updated, and are computed for the existing documents when
+	// a stored computed field is added to a collection by a patch.
+	IsStored bool
+}
+
+// FieldConstraints contains the constraints that the values of a field must satisfy.
+//
+// Constraints are validated when a document is created or updated. With the exception of [NotNull],
+// constraints are not applied to fields without a value.
+type FieldConstraints struct {
+	// Min is the minimum, inclusive, value of a numeric field.
+	Min immutable.Option[float64]
+
+	// Max is the maximum, inclusive, value of a numeric field.
+	Max immutable.Option[float64]
+
+	// MinLength is the minimum length of the value of a string field.
+	MinLength immutable.Option[int]
+
+	// MaxLength is the maximum length of the value of a string field.
+	MaxLength immutable.Option[int]
+
+	// Pattern is a regular expression that the value of a string field must match.
+	Pattern immutable.Option[string]
+
+	// OneOf contains the values that a string or integer field may hold.
+	//
+	// If empty, the values of the field are not restricted.
+	OneOf []any
+
+	// NotNull defines whether the field must always hold a value.
+	NotNull bool
+}
+
+// IsEmpty returns true if no constraint has been set.
+func (c FieldConstraints) IsEmpty() bool {
+	return !c.Min.HasValue() &&
+		!c.Max.HasValue() &&
+		!c.MinLength.HasValue() &&
+		!c.MaxLength.HasValue() &&
+		!c.Pattern.HasValue() &&
+		len(c.OneOf) == 0 &&
+		!c.NotNull
+}
+
+// RelationDeleteAction defines what happens to documents referencing another document through a
+// relation, when the referenced document is deleted.
+//
+// The action is applied by the node deleting the referenced document, within the same transaction.
+// The resulting changes to the referencing documents are replicated to other nodes as if they had
+// been requested directly, and the action is not applied again when a replicated delete is merged.
+type RelationDeleteAction uint8
+
+// Available relation delete actions.
+const (
+	// RelationDeleteNone leaves the referencing documents untouched, still referencing the deleted document.
+	RelationDeleteNone = RelationDeleteAction(iota)
+	// RelationDeleteCascade deletes the referencing documents along with the referenced document.
+	RelationDeleteCascade
+	// RelationDeleteRestrict prevents the deletion of a document that is referenced by another document.
+	RelationDeleteRestrict
+	// RelationDeleteSetNull clears the reference held by the referencing documents.
+	RelationDeleteSetNull
+)
+
+// String returns the string representation of the relation delete action.
+func (a RelationDeleteAction) String() string {
+	switch a {
+	case RelationDeleteNone:
+		return "NONE"
+	case RelationDeleteCascade:
+		return "CASCADE"
+	case RelationDeleteRestrict:
+		return "RESTRICT"
+	case RelationDeleteSetNull:
+		return "SET_NULL"
+	default:
+		return "UNKNOWN"
+	}
 }
 
 // collectionFieldDescription is a private type used to facilitate the unmarshalling
@@ -51,6 +170,10 @@ type collectionFieldDescription struct {
 	RelationName immutable.Option[string]
 	DefaultValue any
 	Size         int
+	Constraints  FieldConstraints
+	IsEncrypted  bool
+	OnDelete     RelationDeleteAction
+	Computed     immutable.Option[ComputedFieldDescription]
 
 	// Properties below this line are unmarshalled using custom logic in [UnmarshalJSON]
 	Kind json.RawMessage
@@ -67,6 +190,10 @@ func (f *CollectionFieldDescription) UnmarshalJSON(bytes []byte) error {
 	f.DefaultValue = descMap.DefaultValue
 	f.RelationName = descMap.RelationName
 	f.Size = descMap.Size
+	f.Constraints = descMap.Constraints
+	f.IsEncrypted = descMap.IsEncrypted
+	f.OnDelete = descMap.OnDelete
+	f.Computed = descMap.Computed
 	kind, err := parseFieldKind(descMap.Kind)
 	if err != nil {
 		return err
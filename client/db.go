@@ -68,6 +68,8 @@ type Store interface {
 	// Note:
 	// - The request actor must either be the owner or manager of the document.
 	// - If the target actor arg is "*", then the relationship applies to all actors implicitly.
+	// - If the docID is empty and the collection policy declares a collection scoped permission
+	//   (i.e. "create"), then the relationship is made with the collection itself.
 	AddDACActorRelationship(
 		ctx context.Context,
 		collectionName string,
@@ -86,6 +88,8 @@ type Store interface {
 	// - The request actor must either be the owner or manager of the document.
 	// - If the target actor arg is "*", then the implicitly added relationship with all actors is
 	//   removed, however this does not revoke access from actors that had explicit relationships.
+	// - If the docID is empty and the collection policy declares a collection scoped permission
+	//   (i.e. "create"), then the relationship with the collection itself is deleted.
 	DeleteDACActorRelationship(
 		ctx context.Context,
 		collectionName string,
@@ -94,6 +98,43 @@ type Store interface {
 		targetActor string,
 	) (DeleteActorRelationshipResult, error)
 
+	// AddDACActorRelationships creates a relationship between each document of the batch and the
+	// target actor.
+	//
+	// The batch is atomic, if making any of the relationships fails then the relationships that
+	// were already made by the batch are deleted again, and the error is returned.
+	//
+	// Note:
+	// - The request actor must either be the owner or manager of all the documents.
+	// - If the target actor is "*", then the relationships apply to all actors implicitly.
+	AddDACActorRelationships(
+		ctx context.Context,
+		batch DACActorRelationshipBatch,
+	) (ActorRelationshipBatchResult, error)
+
+	// DeleteDACActorRelationships deletes the relationship between each document of the batch and
+	// the target actor.
+	//
+	// The batch is atomic, if deleting any of the relationships fails then the relationships that
+	// were already deleted by the batch are made again, and the error is returned.
+	//
+	// Note:
+	// - The request actor must either be the owner or manager of all the documents.
+	DeleteDACActorRelationships(
+		ctx context.Context,
+		batch DACActorRelationshipBatch,
+	) (ActorRelationshipBatchResult, error)
+
+	// GetACPAuditLog returns the records of the acp audit log that match the given query, ordered
+	// from the oldest to the newest.
+	//
+	// The audit log records the authorization decisions made by the document acp system, it is
+	// only kept if it was enabled when the node was started.
+	//
+	// Note:
+	// - If node acp is enabled, the request actor must have the "dac-status" node permission.
+	GetACPAuditLog(ctx context.Context, query ACPAuditLogQuery) ([]ACPAuditRecord, error)
+
 	// AddNACActorRelationship creates a relationship to grant node access to the target actor.
 	//
 	// If failure occurs, the result will return an error. Upon success the boolean value will
@@ -184,6 +225,14 @@ type Store interface {
 	// [FieldKindStringToEnumMapping].
 	//
 	// A lens configuration may also be provided, it will be added to all collections using the schema.
+	//
+	// Fields may only be removed, or have their [FieldKind] changed, if a lens configuration is provided.
+	// Relation fields, indexed fields and array kinds may not be changed this way.  Values held by the
+	// removed fields remain available to migrations and to the older schema versions.
+	//
+	// As documents are migrated when read, the kind of a field may only be widened so that the values
+	// stored using the old kind remain valid: Int and Float32 to Float64, and any of Boolean, Int, Float64,
+	// Float32 and String to JSON.
 	PatchSchema(ctx context.Context, patch string, migration immutable.Option[model.Lens], setDefault bool) error
 
 	// PatchCollection takes the given JSON patch string and applies it to the set of CollectionVersions
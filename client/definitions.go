@@ -129,6 +129,17 @@ type FieldDefinition struct {
 	// Mutations on fields with a size constraint will fail if the size of the array
 	// does not match the constraint.
 	Size int
+
+	// IsEncrypted defines whether this field is individually encrypted or not.
+	IsEncrypted bool
+
+	// OnDelete defines what happens to documents referencing another document through this field
+	// when the referenced document is deleted.
+	OnDelete RelationDeleteAction
+
+	// IsComputed defines whether the value of this field is computed from the other fields of
+	// the document, instead of being set directly.
+	IsComputed bool
 }
 
 // NewFieldDefinition returns a new [FieldDefinition], combining the given local and global elements
@@ -149,6 +160,9 @@ func NewFieldDefinition(local CollectionFieldDescription, global SchemaFieldDesc
 		IsPrimaryRelation: kind.IsObject() && !kind.IsArray(),
 		DefaultValue:      local.DefaultValue,
 		Size:              local.Size,
+		IsEncrypted:       local.IsEncrypted,
+		OnDelete:          local.OnDelete,
+		IsComputed:        local.Computed.HasValue(),
 	}
 }
 
@@ -160,6 +174,9 @@ func NewLocalFieldDefinition(local CollectionFieldDescription) FieldDefinition {
 		RelationName: local.RelationName.Value(),
 		DefaultValue: local.DefaultValue,
 		Size:         local.Size,
+		IsEncrypted:  local.IsEncrypted,
+		OnDelete:     local.OnDelete,
+		IsComputed:   local.Computed.HasValue(),
 	}
 }
 
@@ -188,6 +205,41 @@ func (f FieldDefinition) GetSecondaryRelationField(c CollectionDefinition) (Fiel
 	return secondary, valid && !secondary.IsPrimaryRelation
 }
 
+// JoinCollectionLinkedFieldName is the name of the field on join collections recording whether
+// the two documents referenced by a join document are currently linked or not.
+const JoinCollectionLinkedFieldName = "linked"
+
+// JoinCollectionName returns the name of the collection holding the links of the many-to-many
+// relation with the given name.
+func JoinCollectionName(relationName string) string {
+	return "_" + relationName
+}
+
+// GetManyToManyRelationField returns the field on the given target definition forming the other
+// side of the many-to-many relation that the given field of the host definition belongs to.
+//
+// The documents linked through a many-to-many relation are held by the join collection of the
+// relation. The join collection has a field named after each side of the relation, the field
+// named after the host field references the documents of the target definition.
+//
+// If the given field does not belong to a many-to-many relation, default and false will be returned.
+func GetManyToManyRelationField(
+	host CollectionDefinition,
+	field FieldDefinition,
+	target CollectionDefinition,
+) (FieldDefinition, bool) {
+	if field.Kind == nil || !field.Kind.IsObject() || !field.Kind.IsArray() || field.RelationName == "" {
+		return FieldDefinition{}, false
+	}
+
+	otherField, ok := target.Version.GetFieldByRelation(field.RelationName, host.GetName(), field.Name)
+	if !ok || !otherField.Kind.HasValue() || !otherField.Kind.Value().IsArray() {
+		return FieldDefinition{}, false
+	}
+
+	return target.GetFieldByName(otherField.Name)
+}
+
 // DefinitionCache is an object providing easy access to cached collection definitions.
 type DefinitionCache struct {
 	// The full set of [CollectionDefinition]s within this cache
@@ -251,6 +251,14 @@ func validateFieldSchema(val any, field FieldDefinition) (NormalValue, error) {
 		return NewNormalString(v), nil
 	}
 
+	if enumKind, ok := field.Kind.(*EnumKind); ok {
+		v, err := getEnumOrdinal(val, field.Name, enumKind)
+		if err != nil {
+			return nil, err
+		}
+		return NewNormalInt(v), nil
+	}
+
 	switch field.Kind {
 	case FieldKind_DocID:
 		v, err := getString(val)
@@ -467,6 +475,32 @@ func getInt64(v any) (int64, error) {
 	}
 }
 
+// getEnumOrdinal returns the stored representation of the given enum value.
+//
+// Values may be provided either by name, or by their stored ordinal.
+func getEnumOrdinal(v any, fieldName string, kind *EnumKind) (int64, error) {
+	if jsonValue, ok := v.(*fastjson.Value); ok && jsonValue.Type() == fastjson.TypeString {
+		v = string(jsonValue.GetStringBytes())
+	}
+
+	if name, ok := v.(string); ok {
+		ordinal, ok := kind.Ordinal(name)
+		if !ok {
+			return 0, NewErrInvalidEnumValue(fieldName, kind.Name, name)
+		}
+		return ordinal, nil
+	}
+
+	ordinal, err := getInt64(v)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := kind.ValueName(ordinal); !ok {
+		return 0, NewErrInvalidEnumValue(fieldName, kind.Name, ordinal)
+	}
+	return ordinal, nil
+}
+
 func getDateTime(v any) (time.Time, error) {
 	var s string
 	switch val := v.(type) {
@@ -920,8 +954,25 @@ func (doc *Document) toMapWithKey() (map[string]any, error) {
 }
 
 // GenerateDocID generates the DocID corresponding to the document.
+//
+// Computed fields are derived from the other fields of the document, so their values
+// are not taken into consideration.
 func (doc *Document) GenerateDocID() (DocID, error) {
-	bytes, err := doc.Bytes()
+	docMap, err := doc.toMap(true)
+	if err != nil {
+		return DocID{}, err
+	}
+	for _, field := range doc.collectionDefinition.Version.Fields {
+		if field.Computed.HasValue() {
+			delete(docMap, field.Name)
+		}
+	}
+
+	em, err := CborEncodingOptions().EncMode()
+	if err != nil {
+		return DocID{}, err
+	}
+	bytes, err := em.Marshal(docMap)
 	if err != nil {
 		return DocID{}, err
 	}
@@ -47,6 +47,7 @@ const (
 	errInvalidResourcePermissionType      string = "invalid resource permission type"
 	errCanNotStartNACWithoutIdentity      string = "can not start nac without identity"
 	errCanNotDoThisNACOpWithNACIsDisabled string = "can not do this nac operation when nac is disabled"
+	errInvalidEnumValue                   string = "invalid enum value"
 )
 
 var (
@@ -91,6 +92,7 @@ var (
 	ErrInvalidResourcePermissionType        = errors.New(errInvalidResourcePermissionType)
 	ErrCanNotStartNACWithoutIdentity        = errors.New(errCanNotStartNACWithoutIdentity)
 	ErrCanNotDoThisNACOpWithNACIsDisabled   = errors.New(errCanNotDoThisNACOpWithNACIsDisabled)
+	ErrInvalidEnumValue                     = errors.New(errInvalidEnumValue)
 )
 
 // NewErrFieldNotExist returns an error indicating that the given field does not exist.
@@ -177,6 +179,13 @@ func NewErrCollectionNotFoundForSchema(schemaRoot string) error {
 	)
 }
 
+func NewErrCollectionNotFoundForCollectionID(collectionID string) error {
+	return errors.New(
+		errCollectionNotFound,
+		errors.NewKV("CollectionID", collectionID),
+	)
+}
+
 func NewErrCollectionNotFoundForName(name string) error {
 	return errors.New(
 		errCollectionNotFound,
@@ -263,3 +272,14 @@ func NewErrEmbeddingFieldEmbedding(fieldName string) error {
 func NewErrNotFound(kv errors.KV) error {
 	return errors.New(errNotFound, kv)
 }
+
+// NewErrInvalidEnumValue returns an error indicating that the given value is not a value of the
+// enum held by the given field.
+func NewErrInvalidEnumValue(fieldName string, enum string, value any) error {
+	return errors.New(
+		errInvalidEnumValue,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Enum", enum),
+		errors.NewKV("Value", value),
+	)
+}
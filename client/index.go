@@ -32,6 +32,12 @@ type IndexDescription struct {
 	Fields []IndexedFieldDescription
 	// Unique indicates whether the index is unique.
 	Unique bool
+	// Blind indicates whether the index is a blind index.
+	//
+	// A blind index stores a keyed HMAC of the indexed values instead of the values themselves,
+	// so that encrypted fields can be searched by equality without exposing their plaintext.
+	// Blind indexes can only be used by `_eq` and `_in` filters.
+	Blind bool
 }
 
 // IndexCreateRequest describes an index creation request.
@@ -44,6 +50,12 @@ type IndexCreateRequest struct {
 	Fields []IndexedFieldDescription
 	// Unique indicates whether the index is unique.
 	Unique bool
+	// Blind indicates whether the index is a blind index.
+	//
+	// A blind index stores a keyed HMAC of the indexed values instead of the values themselves,
+	// so that encrypted fields can be searched by equality without exposing their plaintext.
+	// Blind indexes can only be used by `_eq` and `_in` filters.
+	Blind bool
 }
 
 // CollectionIndex is an interface for indexing documents in a collection.
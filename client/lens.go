@@ -38,6 +38,48 @@ type LensConfig struct {
 	model.Lens
 }
 
+// LensTransformPathPrefix is the prefix of the [model.LensModule] paths that refer to one of the
+// declarative transforms built into DefraDB, instead of to a lens wasm module.
+//
+// Built in transforms are executed natively, and their inverse is derived automatically.  They may be
+// freely mixed with lens wasm modules within a single migration.
+const LensTransformPathPrefix = "defradb:"
+
+const (
+	// LensTransformRename moves the value of the `src` field to the `dst` field.
+	LensTransformRename = LensTransformPathPrefix + "rename"
+
+	// LensTransformSetDefault sets the `dst` field to `value` if it does not hold a value.
+	//
+	// Its inverse removes the `dst` field.
+	LensTransformSetDefault = LensTransformPathPrefix + "set_default"
+
+	// LensTransformCopy copies the value of the `src` field to the `dst` field.
+	//
+	// Its inverse removes the `dst` field.
+	LensTransformCopy = LensTransformPathPrefix + "copy"
+
+	// LensTransformSplit splits the string value of the `src` field by `separator`, and sets
+	// the parts to the fields named by the `dst` array, in order.  The last field receives the
+	// remainder of the string.
+	//
+	// Its inverse is [LensTransformConcat].
+	LensTransformSplit = LensTransformPathPrefix + "split"
+
+	// LensTransformConcat joins the string values of the fields named by the `src` array with
+	// `separator`, and sets the result to the `dst` field.
+	//
+	// Its inverse is [LensTransformSplit].
+	LensTransformConcat = LensTransformPathPrefix + "concat"
+
+	// LensTransformConvert converts the numeric value of the `src` field to a new unit, setting
+	// the result to the `dst` field (which defaults to `src`).  The result is calculated as
+	// `value * multiplier + offset`, `multiplier` defaults to 1 and `offset` defaults to 0.
+	//
+	// Its inverse applies the reverse conversion.
+	LensTransformConvert = LensTransformPathPrefix + "convert"
+)
+
 // TxnSource represents an object capable of constructing the transactions that
 // implicit-transaction registries need internally.
 type TxnSource interface {
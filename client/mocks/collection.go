@@ -630,6 +630,61 @@ func (_c *Collection_GetIndexes_Call) RunAndReturn(run func(ctx context.Context)
 	return _c
 }
 
+// MigrateDocuments provides a mock function for the type Collection
+func (_mock *Collection) MigrateDocuments(ctx context.Context, batchSize int) (client.DocumentMigrationProgress, error) {
+	ret := _mock.Called(ctx, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateDocuments")
+	}
+
+	var r0 client.DocumentMigrationProgress
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (client.DocumentMigrationProgress, error)); ok {
+		return returnFunc(ctx, batchSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) client.DocumentMigrationProgress); ok {
+		r0 = returnFunc(ctx, batchSize)
+	} else {
+		r0 = ret.Get(0).(client.DocumentMigrationProgress)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Collection_MigrateDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateDocuments'
+type Collection_MigrateDocuments_Call struct {
+	*mock.Call
+}
+
+// MigrateDocuments is a helper method to define mock.On call
+//   - ctx
+//   - batchSize
+func (_e *Collection_Expecter) MigrateDocuments(ctx interface{}, batchSize interface{}) *Collection_MigrateDocuments_Call {
+	return &Collection_MigrateDocuments_Call{Call: _e.mock.On("MigrateDocuments", ctx, batchSize)}
+}
+
+func (_c *Collection_MigrateDocuments_Call) Run(run func(ctx context.Context, batchSize int)) *Collection_MigrateDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Collection_MigrateDocuments_Call) Return(documentMigrationProgress client.DocumentMigrationProgress, err error) *Collection_MigrateDocuments_Call {
+	_c.Call.Return(documentMigrationProgress, err)
+	return _c
+}
+
+func (_c *Collection_MigrateDocuments_Call) RunAndReturn(run func(ctx context.Context, batchSize int) (client.DocumentMigrationProgress, error)) *Collection_MigrateDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function for the type Collection
 func (_mock *Collection) Name() string {
 	ret := _mock.Called()
@@ -674,6 +729,61 @@ func (_c *Collection_Name_Call) RunAndReturn(run func() string) *Collection_Name
 	return _c
 }
 
+// RotateEncryptionKey provides a mock function for the type Collection
+func (_mock *Collection) RotateEncryptionKey(ctx context.Context, docID client.DocID, opts ...client.EncryptionKeyRotationOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, docID, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, docID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateEncryptionKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DocID, ...client.EncryptionKeyRotationOption) error); ok {
+		r0 = returnFunc(ctx, docID, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Collection_RotateEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateEncryptionKey'
+type Collection_RotateEncryptionKey_Call struct {
+	*mock.Call
+}
+
+// RotateEncryptionKey is a helper method to define mock.On call
+//   - ctx
+//   - docID
+//   - opts
+func (_e *Collection_Expecter) RotateEncryptionKey(ctx interface{}, docID interface{}, opts ...interface{}) *Collection_RotateEncryptionKey_Call {
+	return &Collection_RotateEncryptionKey_Call{Call: _e.mock.On("RotateEncryptionKey",
+		append([]interface{}{ctx, docID}, opts...)...)}
+}
+
+func (_c *Collection_RotateEncryptionKey_Call) Run(run func(ctx context.Context, docID client.DocID, opts ...client.EncryptionKeyRotationOption)) *Collection_RotateEncryptionKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]client.EncryptionKeyRotationOption)
+		run(args[0].(context.Context), args[1].(client.DocID), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Collection_RotateEncryptionKey_Call) Return(err error) *Collection_RotateEncryptionKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Collection_RotateEncryptionKey_Call) RunAndReturn(run func(ctx context.Context, docID client.DocID, opts ...client.EncryptionKeyRotationOption) error) *Collection_RotateEncryptionKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type Collection
 func (_mock *Collection) Save(ctx context.Context, doc *client.Document, opts ...client.DocCreateOption) error {
 	var tmpRet mock.Arguments
@@ -100,6 +100,61 @@ func (_c *DB_AddDACActorRelationship_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// AddDACActorRelationships provides a mock function for the type DB
+func (_mock *DB) AddDACActorRelationships(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDACActorRelationships")
+	}
+
+	var r0 client.ActorRelationshipBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) client.ActorRelationshipBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		r0 = ret.Get(0).(client.ActorRelationshipBatchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, client.DACActorRelationshipBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DB_AddDACActorRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDACActorRelationships'
+type DB_AddDACActorRelationships_Call struct {
+	*mock.Call
+}
+
+// AddDACActorRelationships is a helper method to define mock.On call
+//   - ctx
+//   - batch
+func (_e *DB_Expecter) AddDACActorRelationships(ctx interface{}, batch interface{}) *DB_AddDACActorRelationships_Call {
+	return &DB_AddDACActorRelationships_Call{Call: _e.mock.On("AddDACActorRelationships", ctx, batch)}
+}
+
+func (_c *DB_AddDACActorRelationships_Call) Run(run func(ctx context.Context, batch client.DACActorRelationshipBatch)) *DB_AddDACActorRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DACActorRelationshipBatch))
+	})
+	return _c
+}
+
+func (_c *DB_AddDACActorRelationships_Call) Return(actorRelationshipBatchResult client.ActorRelationshipBatchResult, err error) *DB_AddDACActorRelationships_Call {
+	_c.Call.Return(actorRelationshipBatchResult, err)
+	return _c
+}
+
+func (_c *DB_AddDACActorRelationships_Call) RunAndReturn(run func(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)) *DB_AddDACActorRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddDACPolicy provides a mock function for the type DB
 func (_mock *DB) AddDACPolicy(ctx context.Context, policy string) (client.AddPolicyResult, error) {
 	ret := _mock.Called(ctx, policy)
@@ -421,6 +476,61 @@ func (_c *DB_DeleteDACActorRelationship_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// DeleteDACActorRelationships provides a mock function for the type DB
+func (_mock *DB) DeleteDACActorRelationships(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDACActorRelationships")
+	}
+
+	var r0 client.ActorRelationshipBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) client.ActorRelationshipBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		r0 = ret.Get(0).(client.ActorRelationshipBatchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, client.DACActorRelationshipBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DB_DeleteDACActorRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDACActorRelationships'
+type DB_DeleteDACActorRelationships_Call struct {
+	*mock.Call
+}
+
+// DeleteDACActorRelationships is a helper method to define mock.On call
+//   - ctx
+//   - batch
+func (_e *DB_Expecter) DeleteDACActorRelationships(ctx interface{}, batch interface{}) *DB_DeleteDACActorRelationships_Call {
+	return &DB_DeleteDACActorRelationships_Call{Call: _e.mock.On("DeleteDACActorRelationships", ctx, batch)}
+}
+
+func (_c *DB_DeleteDACActorRelationships_Call) Run(run func(ctx context.Context, batch client.DACActorRelationshipBatch)) *DB_DeleteDACActorRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DACActorRelationshipBatch))
+	})
+	return _c
+}
+
+func (_c *DB_DeleteDACActorRelationships_Call) Return(actorRelationshipBatchResult client.ActorRelationshipBatchResult, err error) *DB_DeleteDACActorRelationships_Call {
+	_c.Call.Return(actorRelationshipBatchResult, err)
+	return _c
+}
+
+func (_c *DB_DeleteDACActorRelationships_Call) RunAndReturn(run func(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)) *DB_DeleteDACActorRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecRequest provides a mock function for the type DB
 func (_mock *DB) ExecRequest(ctx context.Context, request string, opts ...client.RequestOption) *client.RequestResult {
 	var tmpRet mock.Arguments
@@ -478,6 +588,63 @@ func (_c *DB_ExecRequest_Call) RunAndReturn(run func(ctx context.Context, reques
 	return _c
 }
 
+// GetACPAuditLog provides a mock function for the type DB
+func (_mock *DB) GetACPAuditLog(ctx context.Context, query client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetACPAuditLog")
+	}
+
+	var r0 []client.ACPAuditRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.ACPAuditLogQuery) []client.ACPAuditRecord); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ACPAuditRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, client.ACPAuditLogQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DB_GetACPAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACPAuditLog'
+type DB_GetACPAuditLog_Call struct {
+	*mock.Call
+}
+
+// GetACPAuditLog is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *DB_Expecter) GetACPAuditLog(ctx interface{}, query interface{}) *DB_GetACPAuditLog_Call {
+	return &DB_GetACPAuditLog_Call{Call: _e.mock.On("GetACPAuditLog", ctx, query)}
+}
+
+func (_c *DB_GetACPAuditLog_Call) Run(run func(ctx context.Context, query client.ACPAuditLogQuery)) *DB_GetACPAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.ACPAuditLogQuery))
+	})
+	return _c
+}
+
+func (_c *DB_GetACPAuditLog_Call) Return(aCPAuditRecords []client.ACPAuditRecord, err error) *DB_GetACPAuditLog_Call {
+	_c.Call.Return(aCPAuditRecords, err)
+	return _c
+}
+
+func (_c *DB_GetACPAuditLog_Call) RunAndReturn(run func(ctx context.Context, query client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error)) *DB_GetACPAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllIndexes provides a mock function for the type DB
 func (_mock *DB) GetAllIndexes(ctx context.Context) (map[client.CollectionName][]client.IndexDescription, error) {
 	ret := _mock.Called(ctx)
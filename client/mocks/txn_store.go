@@ -100,6 +100,61 @@ func (_c *TxnStore_AddDACActorRelationship_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// AddDACActorRelationships provides a mock function for the type TxnStore
+func (_mock *TxnStore) AddDACActorRelationships(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDACActorRelationships")
+	}
+
+	var r0 client.ActorRelationshipBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) client.ActorRelationshipBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		r0 = ret.Get(0).(client.ActorRelationshipBatchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, client.DACActorRelationshipBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TxnStore_AddDACActorRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDACActorRelationships'
+type TxnStore_AddDACActorRelationships_Call struct {
+	*mock.Call
+}
+
+// AddDACActorRelationships is a helper method to define mock.On call
+//   - ctx
+//   - batch
+func (_e *TxnStore_Expecter) AddDACActorRelationships(ctx interface{}, batch interface{}) *TxnStore_AddDACActorRelationships_Call {
+	return &TxnStore_AddDACActorRelationships_Call{Call: _e.mock.On("AddDACActorRelationships", ctx, batch)}
+}
+
+func (_c *TxnStore_AddDACActorRelationships_Call) Run(run func(ctx context.Context, batch client.DACActorRelationshipBatch)) *TxnStore_AddDACActorRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DACActorRelationshipBatch))
+	})
+	return _c
+}
+
+func (_c *TxnStore_AddDACActorRelationships_Call) Return(actorRelationshipBatchResult client.ActorRelationshipBatchResult, err error) *TxnStore_AddDACActorRelationships_Call {
+	_c.Call.Return(actorRelationshipBatchResult, err)
+	return _c
+}
+
+func (_c *TxnStore_AddDACActorRelationships_Call) RunAndReturn(run func(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)) *TxnStore_AddDACActorRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddDACPolicy provides a mock function for the type TxnStore
 func (_mock *TxnStore) AddDACPolicy(ctx context.Context, policy string) (client.AddPolicyResult, error) {
 	ret := _mock.Called(ctx, policy)
@@ -477,6 +532,61 @@ func (_c *TxnStore_DeleteDACActorRelationship_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// DeleteDACActorRelationships provides a mock function for the type TxnStore
+func (_mock *TxnStore) DeleteDACActorRelationships(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDACActorRelationships")
+	}
+
+	var r0 client.ActorRelationshipBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.DACActorRelationshipBatch) client.ActorRelationshipBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		r0 = ret.Get(0).(client.ActorRelationshipBatchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, client.DACActorRelationshipBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TxnStore_DeleteDACActorRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDACActorRelationships'
+type TxnStore_DeleteDACActorRelationships_Call struct {
+	*mock.Call
+}
+
+// DeleteDACActorRelationships is a helper method to define mock.On call
+//   - ctx
+//   - batch
+func (_e *TxnStore_Expecter) DeleteDACActorRelationships(ctx interface{}, batch interface{}) *TxnStore_DeleteDACActorRelationships_Call {
+	return &TxnStore_DeleteDACActorRelationships_Call{Call: _e.mock.On("DeleteDACActorRelationships", ctx, batch)}
+}
+
+func (_c *TxnStore_DeleteDACActorRelationships_Call) Run(run func(ctx context.Context, batch client.DACActorRelationshipBatch)) *TxnStore_DeleteDACActorRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DACActorRelationshipBatch))
+	})
+	return _c
+}
+
+func (_c *TxnStore_DeleteDACActorRelationships_Call) Return(actorRelationshipBatchResult client.ActorRelationshipBatchResult, err error) *TxnStore_DeleteDACActorRelationships_Call {
+	_c.Call.Return(actorRelationshipBatchResult, err)
+	return _c
+}
+
+func (_c *TxnStore_DeleteDACActorRelationships_Call) RunAndReturn(run func(ctx context.Context, batch client.DACActorRelationshipBatch) (client.ActorRelationshipBatchResult, error)) *TxnStore_DeleteDACActorRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteNACActorRelationship provides a mock function for the type TxnStore
 func (_mock *TxnStore) DeleteNACActorRelationship(ctx context.Context, relation string, targetActor string) (client.DeleteActorRelationshipResult, error) {
 	ret := _mock.Called(ctx, relation, targetActor)
@@ -635,6 +745,63 @@ func (_c *TxnStore_ExecRequest_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// GetACPAuditLog provides a mock function for the type TxnStore
+func (_mock *TxnStore) GetACPAuditLog(ctx context.Context, query client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetACPAuditLog")
+	}
+
+	var r0 []client.ACPAuditRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, client.ACPAuditLogQuery) []client.ACPAuditRecord); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ACPAuditRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, client.ACPAuditLogQuery) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TxnStore_GetACPAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACPAuditLog'
+type TxnStore_GetACPAuditLog_Call struct {
+	*mock.Call
+}
+
+// GetACPAuditLog is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *TxnStore_Expecter) GetACPAuditLog(ctx interface{}, query interface{}) *TxnStore_GetACPAuditLog_Call {
+	return &TxnStore_GetACPAuditLog_Call{Call: _e.mock.On("GetACPAuditLog", ctx, query)}
+}
+
+func (_c *TxnStore_GetACPAuditLog_Call) Run(run func(ctx context.Context, query client.ACPAuditLogQuery)) *TxnStore_GetACPAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.ACPAuditLogQuery))
+	})
+	return _c
+}
+
+func (_c *TxnStore_GetACPAuditLog_Call) Return(aCPAuditRecords []client.ACPAuditRecord, err error) *TxnStore_GetACPAuditLog_Call {
+	_c.Call.Return(aCPAuditRecords, err)
+	return _c
+}
+
+func (_c *TxnStore_GetACPAuditLog_Call) RunAndReturn(run func(ctx context.Context, query client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error)) *TxnStore_GetACPAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllIndexes provides a mock function for the type TxnStore
 func (_mock *TxnStore) GetAllIndexes(ctx context.Context) (map[client.CollectionName][]client.IndexDescription, error) {
 	ret := _mock.Called(ctx)
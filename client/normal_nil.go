@@ -21,6 +21,9 @@ func NewNormalNil(kind FieldKind) (NormalValue, error) {
 	if kind.IsObject() {
 		return NewNormalNillableDocument(immutable.None[*Document]()), nil
 	}
+	if _, ok := kind.(*EnumKind); ok {
+		return NewNormalNillableInt(immutable.None[int64]()), nil
+	}
 	switch kind {
 	case FieldKind_NILLABLE_BOOL:
 		return NewNormalNillableBool(immutable.None[bool]()), nil
@@ -12,6 +12,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -64,4 +65,27 @@ type P2P interface {
 	// to the documents or their collection for future updates.
 	// context.WithTimeout can be used to set a timeout for the operation.
 	SyncDocuments(ctx context.Context, collectionName string, docIDs []string) error
+
+	// ExportBundle writes the DAG blocks of the selected collections to a CAR file
+	// so that they can be transferred to a disconnected node.
+	ExportBundle(ctx context.Context, config BundleExportConfig) (BundleExportResult, error)
+
+	// ImportBundle reads the DAG blocks of a CAR file written by ExportBundle,
+	// verifies their signatures and merges them into the local documents.
+	//
+	// Documents whose encryption keys are not available locally are not merged.
+	// context.WithTimeout can be used to set a timeout for the operation.
+	ImportBundle(ctx context.Context, filepath string) (BundleImportResult, error)
+
+	// GetBannedPeers returns the list of peers that are temporarily banned
+	// because of their misbehaviour.
+	GetBannedPeers(ctx context.Context) ([]BannedPeer, error)
+}
+
+// BannedPeer is a peer that is temporarily banned.
+type BannedPeer struct {
+	// ID is the ID of the banned peer.
+	ID string `json:"id"`
+	// Until is the time at which the ban expires.
+	Until time.Time `json:"until"`
 }
@@ -23,6 +23,8 @@ const (
 	Input              = "input"
 	CreateInput        = "create"
 	UpdateInput        = "update"
+	LinkInput          = "link"
+	UnlinkInput        = "unlink"
 	FieldName          = "field"
 	FieldIDName        = "fieldId"
 	FieldNameName      = "fieldName"
@@ -100,11 +100,27 @@ type SelfKind struct {
 	Array bool
 }
 
+// EnumKind represents a field holding one of a fixed, named, set of values.
+//
+// Values are stored as the (zero-based) position of the value within [Values], documents
+// are therefore ordered by the declaration order of their values, not by their names.
+type EnumKind struct {
+	// The name of the enum type.
+	Name string
+
+	// The names of the values that fields of this kind may hold, in declaration order.
+	//
+	// Values may only be appended by schema updates, as the values of existing documents refer
+	// to their positions.
+	Values []string
+}
+
 var _ FieldKind = ScalarKind(0)
 var _ FieldKind = ScalarArrayKind(0)
 var _ FieldKind = (*SchemaKind)(nil)
 var _ FieldKind = (*SelfKind)(nil)
 var _ FieldKind = (*NamedKind)(nil)
+var _ FieldKind = (*EnumKind)(nil)
 
 func (k ScalarKind) String() string {
 	switch k {
@@ -294,6 +310,51 @@ func (k *NamedKind) IsArray() bool {
 	return k.Array
 }
 
+func NewEnumKind(name string, values []string) *EnumKind {
+	return &EnumKind{
+		Name:   name,
+		Values: values,
+	}
+}
+
+func (k *EnumKind) String() string {
+	return k.Name
+}
+
+func (k *EnumKind) IsNillable() bool {
+	return true
+}
+
+func (k *EnumKind) IsObject() bool {
+	return false
+}
+
+func (k *EnumKind) IsArray() bool {
+	return false
+}
+
+// Ordinal returns the stored representation of the value with the given name.
+//
+// Will return false if the enum has no value of the given name.
+func (k *EnumKind) Ordinal(name string) (int64, bool) {
+	for i, value := range k.Values {
+		if value == name {
+			return int64(i), true
+		}
+	}
+	return 0, false
+}
+
+// ValueName returns the name of the value represented by the given ordinal.
+//
+// Will return false if the ordinal is out of range.
+func (k *EnumKind) ValueName(ordinal int64) (string, bool) {
+	if ordinal < 0 || ordinal >= int64(len(k.Values)) {
+		return "", false
+	}
+	return k.Values[ordinal], true
+}
+
 // Note: These values are serialized and persisted in the database, avoid modifying existing values.
 const (
 	FieldKind_None                   ScalarKind      = 0
@@ -393,6 +454,8 @@ type objectKind struct {
 	Array      bool
 	Root       string
 	RelativeID string
+	Name       string
+	Values     []string
 }
 
 func parseFieldKind(bytes json.RawMessage) (FieldKind, error) {
@@ -407,6 +470,10 @@ func parseFieldKind(bytes json.RawMessage) (FieldKind, error) {
 			return nil, err
 		}
 
+		if objKind.Values != nil {
+			return NewEnumKind(objKind.Name, objKind.Values), nil
+		}
+
 		if objKind.Root == "" {
 			return NewSelfKind(objKind.RelativeID, objKind.Array), nil
 		}
@@ -11,9 +11,11 @@
 package client
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestField_ScalarArray_HasSubKind(t *testing.T) {
@@ -80,3 +82,38 @@ func TestField_ScalarArray_HasSubKind(t *testing.T) {
 		})
 	}
 }
+
+func TestField_EnumKind_RoundTripsThroughJSON(t *testing.T) {
+	field := SchemaFieldDescription{
+		Name: "status",
+		Kind: NewEnumKind("Status", []string{"OPEN", "CLOSED"}),
+		Typ:  LWW_REGISTER,
+	}
+
+	bytes, err := json.Marshal(field)
+	require.NoError(t, err)
+
+	var result SchemaFieldDescription
+	err = json.Unmarshal(bytes, &result)
+	require.NoError(t, err)
+
+	assert.Equal(t, field, result)
+}
+
+func TestField_EnumKind_ConvertsBetweenNamesAndOrdinals(t *testing.T) {
+	kind := NewEnumKind("Status", []string{"OPEN", "CLOSED"})
+
+	ordinal, ok := kind.Ordinal("CLOSED")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), ordinal)
+
+	_, ok = kind.Ordinal("REOPENED")
+	assert.False(t, ok)
+
+	name, ok := kind.ValueName(0)
+	assert.True(t, ok)
+	assert.Equal(t, "OPEN", name)
+
+	_, ok = kind.ValueName(2)
+	assert.False(t, ok)
+}
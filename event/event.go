@@ -11,6 +11,8 @@
 package event
 
 import (
+	"time"
+
 	"github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -58,8 +60,16 @@ const (
 	ReplicatorFailureName = Name("replicator-failure")
 	// ReplicatorCompletedName is the name of the replicator completed event.
 	ReplicatorCompletedName = Name("replicator-completed")
+	// PeerBannedName is the name of the network peer banned event.
+	PeerBannedName = Name("peer-banned")
 	// PurgeName is the name of the purge event.
 	PurgeName = Name("purge")
+	// ACPAuditName is the name of the acp audit event.
+	ACPAuditName = Name("acp-audit")
+	// ACPAuditDroppedName is the name of the acp audit dropped event.
+	ACPAuditDroppedName = Name("acp-audit-dropped")
+	// ViewRefreshName is the name of the view refresh event.
+	ViewRefreshName = Name("view-refresh")
 )
 
 // PubSub is an event that is published when
@@ -155,3 +165,57 @@ type ReplicatorFailure struct {
 	// DocID is the unique immutable identifier of the document that failed to replicate.
 	DocID string
 }
+
+// PeerBanned is an event that is published when a misbehaving peer is temporarily banned.
+type PeerBanned struct {
+	// Peer is the id of the banned peer.
+	Peer peer.ID
+	// Score is the misbehaviour score that caused the ban.
+	Score int
+	// Until is the time at which the ban expires.
+	Until time.Time
+}
+
+// ACPAudit is an event that is published when the document acp system made an authorization
+// decision, and the acp audit log is enabled.
+type ACPAudit struct {
+	// Timestamp is the time at which the decision was made.
+	Timestamp time.Time
+	// Identity is the DID of the actor that requested access, empty if there was no identity.
+	Identity string
+	// Operation is the operation access was requested for.
+	Operation string
+	// CollectionName is the name of the collection of the document.
+	CollectionName string
+	// DocID is the ID of the document, empty if the decision was made for the collection itself.
+	DocID string
+	// Permission is the permission that was checked.
+	Permission string
+	// Allowed is true if access was granted.
+	Allowed bool
+}
+
+// ACPAuditDropped is an event that is published when acp audit records were dropped because
+// the buffer of the records waiting to be written to the acp audit log was full.
+type ACPAuditDropped struct {
+	// Count is the number of records dropped since the last event.
+	Count uint64
+	// Total is the number of records dropped since the database was opened.
+	Total uint64
+}
+
+// ViewRefresh is an event that is published when the cache of an auto refreshed view has been
+// updated, or has failed to be updated, following a change to the documents it is sourced from.
+type ViewRefresh struct {
+	// CollectionID is the root identifier of the view that was refreshed.
+	CollectionID string
+
+	// DocID is the unique immutable identifier of the source document whose change triggered the refresh.
+	DocID string
+
+	// Err is the error that failed the refresh, if any.
+	//
+	// The cache of a view that failed to be refreshed is marked as stale, and is rebuilt in full on its
+	// next refresh.
+	Err error
+}
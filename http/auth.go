@@ -26,30 +26,64 @@ const (
 	// authSchemaPrefix is the prefix added to the
 	// authorization header value.
 	authSchemaPrefix = "Bearer "
+	// capabilityHeaderName is the name of the capability header.
+	// This header should contain a capability token delegating document
+	// permissions to the actor.
+	capabilityHeaderName = "Capability"
 )
 
 // AuthMiddleware authenticates an actor and sets their identity for all subsequent actions.
-func AuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		token := strings.TrimPrefix(req.Header.Get(authHeaderName), authSchemaPrefix)
-		if token == "" {
-			next.ServeHTTP(rw, req)
-			return
-		}
-
-		ident, err := acpIdentity.FromToken([]byte(token))
-		if err != nil {
-			http.Error(rw, "forbidden", http.StatusForbidden)
-			return
-		}
-
-		err = acpIdentity.VerifyAuthToken(ident, strings.ToLower(req.Host))
-		if err != nil {
-			http.Error(rw, "forbidden", http.StatusForbidden)
-			return
-		}
-
-		ctx := acpIdentity.WithContext(req.Context(), immutable.Some[acpIdentity.Identity](ident))
-		next.ServeHTTP(rw, req.WithContext(ctx))
-	})
+//
+// Bearer tokens that are not valid DefraDB identity tokens are authenticated with the given
+// authenticator, if any.
+//
+// If the request contains a capability token with a valid signature, it is also set for all
+// subsequent actions. The capabilities are only granted to the audience of the token, which is
+// checked along with the document permissions.
+func AuthMiddleware(authenticator immutable.Option[Authenticator]) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+
+			token := strings.TrimPrefix(req.Header.Get(authHeaderName), authSchemaPrefix)
+			if token != "" {
+				ident, err := authenticate(req, []byte(token))
+				if err != nil && authenticator.HasValue() {
+					ident, err = authenticator.Value().Authenticate(ctx, []byte(token))
+				}
+				if err != nil {
+					http.Error(rw, "forbidden", http.StatusForbidden)
+					return
+				}
+
+				ctx = acpIdentity.WithContext(ctx, immutable.Some(ident))
+			}
+
+			capability := req.Header.Get(capabilityHeaderName)
+			if capability != "" {
+				capabilityToken, err := acpIdentity.ParseCapabilityToken([]byte(capability))
+				if err != nil {
+					http.Error(rw, "forbidden", http.StatusForbidden)
+					return
+				}
+
+				ctx = acpIdentity.WithCapability(ctx, immutable.Some(capabilityToken))
+			}
+
+			next.ServeHTTP(rw, req.WithContext(ctx))
+		})
+	}
+}
+
+// authenticate verifies the given DefraDB identity token and returns its identity.
+func authenticate(req *http.Request, token []byte) (acpIdentity.Identity, error) {
+	ident, err := acpIdentity.FromToken(token)
+	if err != nil {
+		return nil, err
+	}
+	err = acpIdentity.VerifyAuthToken(ident, strings.ToLower(req.Host))
+	if err != nil {
+		return nil, err
+	}
+	return ident, nil
 }
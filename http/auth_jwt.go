@@ -0,0 +1,211 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/crypto"
+)
+
+// DefaultJWTIdentityClaim is the default claim of a third-party token that is mapped to an identity.
+const DefaultJWTIdentityClaim = "sub"
+
+// Authenticator authenticates the actors of requests using bearer tokens issued by a third-party,
+// such as an OIDC provider, instead of DefraDB identity tokens.
+type Authenticator interface {
+	// Authenticate verifies the given bearer token and returns the identity of its actor.
+	Authenticate(ctx context.Context, token []byte) (acpIdentity.Identity, error)
+}
+
+// JWTAuthenticatorOptions contains the options of a [JWTAuthenticator].
+type JWTAuthenticatorOptions struct {
+	// Issuer is the required issuer of the tokens, it must be set.
+	Issuer string
+	// Audience is the required audience of the tokens, it must be set so that the tokens
+	// issued for other applications of the issuer are rejected.
+	Audience string
+	// IdentityClaim is the claim holding the value that is mapped to an identity.
+	IdentityClaim string
+	// Identities are the DIDs of the identities registered for the identity claim values.
+	Identities map[string]string
+	// IdentitySecret is the secret used to derive the key of the identities of unregistered
+	// identity claim values.
+	//
+	// If empty only the registered identities can be authenticated.
+	IdentitySecret []byte
+}
+
+// DefaultJWTAuthenticatorOptions returns the default options for the jwt authenticator.
+func DefaultJWTAuthenticatorOptions() *JWTAuthenticatorOptions {
+	return &JWTAuthenticatorOptions{
+		IdentityClaim: DefaultJWTIdentityClaim,
+	}
+}
+
+// JWTAuthenticatorOpt is a function that configures jwt authenticator options.
+type JWTAuthenticatorOpt func(*JWTAuthenticatorOptions)
+
+// WithJWTIssuer sets the required issuer of the tokens.
+func WithJWTIssuer(issuer string) JWTAuthenticatorOpt {
+	return func(opts *JWTAuthenticatorOptions) {
+		opts.Issuer = issuer
+	}
+}
+
+// WithJWTAudience sets the required audience of the tokens.
+func WithJWTAudience(audience string) JWTAuthenticatorOpt {
+	return func(opts *JWTAuthenticatorOptions) {
+		opts.Audience = audience
+	}
+}
+
+// WithJWTIdentityClaim sets the claim holding the value that is mapped to an identity.
+func WithJWTIdentityClaim(claim string) JWTAuthenticatorOpt {
+	return func(opts *JWTAuthenticatorOptions) {
+		opts.IdentityClaim = claim
+	}
+}
+
+// WithJWTIdentities sets the DIDs of the identities registered for the identity claim values.
+func WithJWTIdentities(identities map[string]string) JWTAuthenticatorOpt {
+	return func(opts *JWTAuthenticatorOptions) {
+		opts.Identities = identities
+	}
+}
+
+// WithJWTIdentitySecret sets the secret used to derive the key of the identities of
+// unregistered identity claim values.
+func WithJWTIdentitySecret(secret []byte) JWTAuthenticatorOpt {
+	return func(opts *JWTAuthenticatorOptions) {
+		opts.IdentitySecret = secret
+	}
+}
+
+// JWTAuthenticator authenticates actors using JWTs signed by the keys of a JWKS, such as the
+// ID tokens of an OIDC provider.
+//
+// The value of the identity claim of a token is mapped to a registered identity if there is one,
+// otherwise the key of the identity is derived from the identity secret, the issuer and the value.
+// The same actor is thus always mapped to the same identity.
+type JWTAuthenticator struct {
+	options *JWTAuthenticatorOptions
+	keySet  jwk.Set
+}
+
+var _ Authenticator = (*JWTAuthenticator)(nil)
+
+// NewJWTAuthenticator returns a new authenticator of the tokens signed by the keys of the given JWKS.
+//
+// The key set is either the path of a local JWKS file, or the http(s) URL of a JWKS. Keys fetched from
+// a URL are refreshed periodically, until the given context is done.
+//
+// The issuer and the audience of the tokens must be given, and only the tokens with an expiration
+// time are accepted.
+func NewJWTAuthenticator(
+	ctx context.Context,
+	keySet string,
+	opts ...JWTAuthenticatorOpt,
+) (*JWTAuthenticator, error) {
+	options := DefaultJWTAuthenticatorOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.Issuer == "" {
+		return nil, ErrJWTIssuerRequired
+	}
+	if options.Audience == "" {
+		return nil, ErrJWTAudienceRequired
+	}
+
+	set, err := loadJWKS(ctx, keySet)
+	if err != nil {
+		return nil, NewErrFailedToLoadJWKS(err, keySet)
+	}
+
+	return &JWTAuthenticator{
+		options: options,
+		keySet:  set,
+	}, nil
+}
+
+func loadJWKS(ctx context.Context, keySet string) (jwk.Set, error) {
+	if !strings.HasPrefix(keySet, "http://") && !strings.HasPrefix(keySet, "https://") {
+		return jwk.ReadFile(keySet)
+	}
+
+	cache := jwk.NewCache(ctx)
+	err := cache.Register(keySet)
+	if err != nil {
+		return nil, err
+	}
+	// Fetch the keys now so that an unreachable key set is reported on startup.
+	_, err = cache.Refresh(ctx, keySet)
+	if err != nil {
+		return nil, err
+	}
+	return jwk.NewCachedSet(cache, keySet), nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, data []byte) (acpIdentity.Identity, error) {
+	parseOptions := []jwt.ParseOption{
+		jwt.WithContext(ctx),
+		jwt.WithKeySet(a.keySet, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(a.options.Issuer),
+		jwt.WithAudience(a.options.Audience),
+		// Tokens without an expiration time would be valid forever.
+		jwt.WithRequiredClaim(jwt.ExpirationKey),
+	}
+
+	token, err := jwt.Parse(data, parseOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, ok := token.Get(a.options.IdentityClaim)
+	if !ok {
+		return nil, NewErrJWTIdentityClaimNotFound(a.options.IdentityClaim)
+	}
+	value, ok := claim.(string)
+	if !ok || value == "" {
+		return nil, NewErrJWTIdentityClaimNotFound(a.options.IdentityClaim)
+	}
+
+	did, ok := a.options.Identities[value]
+	if ok {
+		return acpIdentity.FromDID(did), nil
+	}
+
+	if len(a.options.IdentitySecret) == 0 {
+		return nil, NewErrJWTIdentityNotMapped(token.Issuer(), a.options.IdentityClaim, value)
+	}
+
+	// The issuer is part of the derived key so that the same value from different issuers
+	// is mapped to different identities.
+	mac := hmac.New(sha256.New, a.options.IdentitySecret)
+	mac.Write([]byte(token.Issuer()))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	privateKey := secp256k1.PrivKeyFromBytes(mac.Sum(nil))
+
+	return acpIdentity.FromPublicKey(crypto.NewPublicKey(privateKey.PubKey()))
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+)
+
+const (
+	testJWTIssuer   = "https://issuer.example.com"
+	testJWTAudience = "defradb"
+)
+
+// newTestJWTAuthenticator returns a new authenticator of the tokens of the test issuer and audience.
+func newTestJWTAuthenticator(
+	ctx context.Context,
+	t *testing.T,
+	keySet string,
+	opts ...JWTAuthenticatorOpt,
+) *JWTAuthenticator {
+	opts = append([]JWTAuthenticatorOpt{WithJWTIssuer(testJWTIssuer), WithJWTAudience(testJWTAudience)}, opts...)
+	authenticator, err := NewJWTAuthenticator(ctx, keySet, opts...)
+	require.NoError(t, err)
+	return authenticator
+}
+
+// newTestJWKS returns a new signing key, and the JWKS holding its public key.
+func newTestJWKS(t *testing.T) (jwk.Key, jwk.Set) {
+	rawKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := jwk.FromRaw(rawKey)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, "test-key"))
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.RS256))
+
+	publicKey, err := key.PublicKey()
+	require.NoError(t, err)
+
+	set := jwk.NewSet()
+	require.NoError(t, set.AddKey(publicKey))
+	return key, set
+}
+
+// writeTestJWKS writes the given JWKS to a file and returns its path.
+func writeTestJWKS(t *testing.T, set jwk.Set) string {
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func newTestJWT(t *testing.T, key jwk.Key, issuer string, subject string) []byte {
+	token, err := jwt.NewBuilder().
+		Issuer(issuer).
+		Subject(subject).
+		Audience([]string{testJWTAudience}).
+		Expiration(time.Now().Add(time.Hour)).
+		Build()
+	require.NoError(t, err)
+	return signTestJWT(t, key, token)
+}
+
+func signTestJWT(t *testing.T, key jwk.Key, token jwt.Token) []byte {
+	data, err := jwt.Sign(token, jwt.WithKey(jwa.RS256, key))
+	require.NoError(t, err)
+	return data
+}
+
+func TestJWTAuthenticator_WithRegisteredIdentity(t *testing.T) {
+	ctx := context.Background()
+	key, set := newTestJWKS(t)
+
+	did := "did:key:z7r8os2G88XXBNBTLj3kFR5rzUJ4VAesbX7PgsA68ak9B5RYcXF5EZEmjRzzinZndPSSwujXb4XKHG6vmKEFG6ZfsfcQn"
+	authenticator := newTestJWTAuthenticator(
+		ctx,
+		t,
+		writeTestJWKS(t, set),
+		WithJWTIdentities(map[string]string{"alice": did}),
+	)
+
+	ident, err := authenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "alice"))
+	require.NoError(t, err)
+	require.Equal(t, did, ident.DID())
+
+	_, err = authenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "bob"))
+	require.ErrorIs(t, err, ErrJWTIdentityNotMapped)
+}
+
+func TestJWTAuthenticator_WithIdentitySecret_DerivesIdentity(t *testing.T) {
+	ctx := context.Background()
+	key, set := newTestJWKS(t)
+	path := writeTestJWKS(t, set)
+
+	authenticator := newTestJWTAuthenticator(ctx, t, path, WithJWTIdentitySecret([]byte("secret")))
+
+	alice, err := authenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "alice"))
+	require.NoError(t, err)
+	aliceAgain, err := authenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "alice"))
+	require.NoError(t, err)
+	bob, err := authenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "bob"))
+	require.NoError(t, err)
+
+	require.Equal(t, alice.DID(), aliceAgain.DID())
+	require.NotEqual(t, alice.DID(), bob.DID())
+
+	otherIssuer := "https://other.example.com"
+	otherIssuerAuthenticator, err := NewJWTAuthenticator(
+		ctx,
+		path,
+		WithJWTIssuer(otherIssuer),
+		WithJWTAudience(testJWTAudience),
+		WithJWTIdentitySecret([]byte("secret")),
+	)
+	require.NoError(t, err)
+
+	aliceOfOtherIssuer, err := otherIssuerAuthenticator.Authenticate(ctx, newTestJWT(t, key, otherIssuer, "alice"))
+	require.NoError(t, err)
+	require.NotEqual(t, alice.DID(), aliceOfOtherIssuer.DID())
+
+	otherAuthenticator := newTestJWTAuthenticator(ctx, t, path, WithJWTIdentitySecret([]byte("other secret")))
+
+	aliceOfOtherSecret, err := otherAuthenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "alice"))
+	require.NoError(t, err)
+	require.NotEqual(t, alice.DID(), aliceOfOtherSecret.DID())
+}
+
+func TestJWTAuthenticator_WithOtherIssuer_Error(t *testing.T) {
+	ctx := context.Background()
+	key, set := newTestJWKS(t)
+
+	authenticator := newTestJWTAuthenticator(ctx, t, writeTestJWKS(t, set), WithJWTIdentitySecret([]byte("secret")))
+
+	_, err := authenticator.Authenticate(ctx, newTestJWT(t, key, "https://other.example.com", "alice"))
+	require.Error(t, err)
+}
+
+func TestJWTAuthenticator_WithOtherAudience_Error(t *testing.T) {
+	ctx := context.Background()
+	key, set := newTestJWKS(t)
+
+	authenticator := newTestJWTAuthenticator(ctx, t, writeTestJWKS(t, set), WithJWTIdentitySecret([]byte("secret")))
+
+	token, err := jwt.NewBuilder().
+		Issuer(testJWTIssuer).
+		Subject("alice").
+		Audience([]string{"other-app"}).
+		Expiration(time.Now().Add(time.Hour)).
+		Build()
+	require.NoError(t, err)
+
+	_, err = authenticator.Authenticate(ctx, signTestJWT(t, key, token))
+	require.Error(t, err)
+}
+
+func TestJWTAuthenticator_WithoutExpiration_Error(t *testing.T) {
+	ctx := context.Background()
+	key, set := newTestJWKS(t)
+
+	authenticator := newTestJWTAuthenticator(ctx, t, writeTestJWKS(t, set), WithJWTIdentitySecret([]byte("secret")))
+
+	token, err := jwt.NewBuilder().
+		Issuer(testJWTIssuer).
+		Subject("alice").
+		Audience([]string{testJWTAudience}).
+		Build()
+	require.NoError(t, err)
+
+	_, err = authenticator.Authenticate(ctx, signTestJWT(t, key, token))
+	require.Error(t, err)
+}
+
+func TestNewJWTAuthenticator_WithoutIssuer_Error(t *testing.T) {
+	_, set := newTestJWKS(t)
+
+	_, err := NewJWTAuthenticator(context.Background(), writeTestJWKS(t, set), WithJWTAudience(testJWTAudience))
+	require.ErrorIs(t, err, ErrJWTIssuerRequired)
+}
+
+func TestNewJWTAuthenticator_WithoutAudience_Error(t *testing.T) {
+	_, set := newTestJWKS(t)
+
+	_, err := NewJWTAuthenticator(context.Background(), writeTestJWKS(t, set), WithJWTIssuer(testJWTIssuer))
+	require.ErrorIs(t, err, ErrJWTAudienceRequired)
+}
+
+func TestJWTAuthenticator_WithUnknownKey_Error(t *testing.T) {
+	ctx := context.Background()
+	_, set := newTestJWKS(t)
+	otherKey, _ := newTestJWKS(t)
+
+	authenticator := newTestJWTAuthenticator(ctx, t, writeTestJWKS(t, set), WithJWTIdentitySecret([]byte("secret")))
+
+	_, err := authenticator.Authenticate(ctx, newTestJWT(t, otherKey, testJWTIssuer, "alice"))
+	require.Error(t, err)
+}
+
+func TestJWTAuthenticator_WithKeySetURL(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	key, set := newTestJWKS(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		responseJSON(rw, http.StatusOK, set)
+	}))
+	defer server.Close()
+
+	authenticator := newTestJWTAuthenticator(ctx, t, server.URL, WithJWTIdentitySecret([]byte("secret")))
+
+	_, err := authenticator.Authenticate(ctx, newTestJWT(t, key, testJWTIssuer, "alice"))
+	require.NoError(t, err)
+}
+
+func TestJWTAuthenticator_WithMissingKeySet_Error(t *testing.T) {
+	_, err := NewJWTAuthenticator(
+		context.Background(),
+		filepath.Join(t.TempDir(), "jwks.json"),
+		WithJWTIssuer(testJWTIssuer),
+		WithJWTAudience(testJWTAudience),
+	)
+	require.ErrorIs(t, err, ErrFailedToLoadJWKS)
+}
+
+func TestAuthMiddleware_WithAuthenticator_ShouldSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	key, set := newTestJWKS(t)
+
+	authenticator := newTestJWTAuthenticator(ctx, t, writeTestJWKS(t, set), WithJWTIdentitySecret([]byte("secret")))
+
+	token := newTestJWT(t, key, testJWTIssuer, "alice")
+	expected, err := authenticator.Authenticate(ctx, token)
+	require.NoError(t, err)
+
+	var ident immutable.Option[acpIdentity.Identity]
+	handler := AuthMiddleware(immutable.Some[Authenticator](authenticator))(
+		http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			ident = acpIdentity.FromContext(req.Context())
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181", nil)
+	req.Header.Set(authHeaderName, authSchemaPrefix+string(token))
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	require.Equal(t, http.StatusOK, res.Code)
+	require.True(t, ident.HasValue())
+	require.Equal(t, expected.DID(), ident.Value().DID())
+}
+
+func TestAuthMiddleware_WithoutAuthenticator_ShouldRejectThirdPartyToken(t *testing.T) {
+	key, _ := newTestJWKS(t)
+
+	handler := AuthMiddleware(immutable.None[Authenticator]())(
+		http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181", nil)
+	req.Header.Set(authHeaderName, authSchemaPrefix+string(newTestJWT(t, key, testJWTIssuer, "alice")))
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
@@ -11,6 +11,8 @@
 package http
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -115,3 +117,42 @@ func TestAuth_WithEd25519AndExpiredToken_ShouldError(t *testing.T) {
 	err = acpIdentity.VerifyAuthToken(identity, audience)
 	assert.Error(t, err, "failed to verify auth token")
 }
+
+func TestAuthMiddleware_WithCapabilityOfAnyAudience_ShouldSetCapability(t *testing.T) {
+	issuer, err := acpIdentity.Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	capability, err := acpIdentity.NewCapabilityToken(
+		issuer,
+		acpIdentity.AnyAudience,
+		[]acpIdentity.Capability{{CollectionName: "Users", Permission: "read"}},
+		time.Hour,
+		immutable.None[string](),
+	)
+	require.NoError(t, err)
+
+	var capabilityToken immutable.Option[*acpIdentity.CapabilityToken]
+	handler := AuthMiddleware(immutable.None[Authenticator]())(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		capabilityToken = acpIdentity.CapabilityFromContext(req.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181", nil)
+	req.Header.Set(capabilityHeaderName, string(capability))
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	require.Equal(t, http.StatusOK, res.Code)
+	require.True(t, capabilityToken.HasValue())
+	require.Equal(t, issuer.DID(), capabilityToken.Value().Issuer)
+}
+
+func TestAuthMiddleware_WithInvalidCapability_ShouldError(t *testing.T) {
+	handler := AuthMiddleware(immutable.None[Authenticator]())(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181", nil)
+	req.Header.Set(capabilityHeaderName, "invalid")
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
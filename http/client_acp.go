@@ -141,6 +141,79 @@ func (c *Client) DeleteDACActorRelationship(
 	return deleteDocActorRelResult, nil
 }
 
+func (c *Client) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	return c.applyDACActorRelationshipBatch(ctx, http.MethodPost, batch)
+}
+
+func (c *Client) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	return c.applyDACActorRelationshipBatch(ctx, http.MethodDelete, batch)
+}
+
+func (c *Client) applyDACActorRelationshipBatch(
+	ctx context.Context,
+	method string,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	methodURL := c.http.apiURL.JoinPath("acp", "document", "relationship", "batch")
+
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		methodURL.String(),
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	var batchResult client.ActorRelationshipBatchResult
+	if err := c.http.requestJson(req, &batchResult); err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	return batchResult, nil
+}
+
+func (c *Client) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	methodURL := c.http.apiURL.JoinPath("acp", "document", "audit")
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		methodURL.String(),
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []client.ACPAuditRecord
+	if err := c.http.requestJson(req, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
 type addNACActorRelationshipRequest struct {
 	Relation    string
 	TargetActor string
@@ -195,6 +195,57 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) RotateEncryptionKey(
+	ctx context.Context,
+	docID client.DocID,
+	opts ...client.EncryptionKeyRotationOption,
+) error {
+	methodURL := c.http.apiURL.JoinPath("collections", c.Version().Name, docID.String(), "encryption", "rotate")
+
+	options := client.EncryptionKeyRotationOptions{}
+	options.Apply(opts)
+
+	body, err := json.Marshal(CollectionRotateEncryptionKeyRequest{
+		Fields:    options.Fields,
+		ReEncrypt: options.ReEncrypt,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Collection) MigrateDocuments(
+	ctx context.Context,
+	batchSize int,
+) (client.DocumentMigrationProgress, error) {
+	methodURL := c.http.apiURL.JoinPath("collections", c.Version().Name, "migrate")
+
+	body, err := json.Marshal(CollectionMigrateDocumentsRequest{
+		VersionID: c.Version().VersionID,
+		BatchSize: batchSize,
+	})
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+
+	var progress client.DocumentMigrationProgress
+	if err := c.http.requestJson(req, &progress); err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	return progress, nil
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
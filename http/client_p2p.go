@@ -213,3 +213,63 @@ func (c *Client) SyncDocuments(
 	_, err = c.http.request(httpReq)
 	return err
 }
+
+func (c *Client) ExportBundle(
+	ctx context.Context,
+	config client.BundleExportConfig,
+) (client.BundleExportResult, error) {
+	methodURL := c.http.apiURL.JoinPath("p2p", "bundle", "export")
+
+	body, err := json.Marshal(config)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	var res client.BundleExportResult
+	if err := c.http.requestJson(req, &res); err != nil {
+		return client.BundleExportResult{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) ImportBundle(ctx context.Context, filepath string) (client.BundleImportResult, error) {
+	methodURL := c.http.apiURL.JoinPath("p2p", "bundle", "import")
+
+	reqBody := map[string]any{
+		"filepath": filepath,
+	}
+	deadline, hasDeadline := ctx.Deadline()
+	if hasDeadline {
+		reqBody["timeout"] = time.Until(deadline).String()
+	}
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	var res client.BundleImportResult
+	if err := c.http.requestJson(req, &res); err != nil {
+		return client.BundleImportResult{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetBannedPeers(ctx context.Context) ([]client.BannedPeer, error) {
+	methodURL := c.http.apiURL.JoinPath("p2p", "banned")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var peers []client.BannedPeer
+	if err := c.http.requestJson(req, &peers); err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
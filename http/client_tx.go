@@ -97,6 +97,30 @@ func (txn *Transaction) DeleteDACActorRelationship(
 	return txn.Client.DeleteDACActorRelationship(ctx, collectionName, docID, relation, targetActor)
 }
 
+func (txn *Transaction) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
+	return txn.Client.AddDACActorRelationships(ctx, batch)
+}
+
+func (txn *Transaction) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
+	return txn.Client.DeleteDACActorRelationships(ctx, batch)
+}
+
+func (txn *Transaction) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
+	return txn.Client.GetACPAuditLog(ctx, query)
+}
+
 func (txn *Transaction) GetNodeIdentity(ctx context.Context) (immutable.Option[identity.PublicRawIdentity], error) {
 	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
 	return txn.Client.GetNodeIdentity(ctx)
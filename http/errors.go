@@ -25,6 +25,11 @@ const (
 	errPurgeRequestNonDeveloperMode string = "cannot purge database when development mode is disabled"
 	errMissingRequiredParameter     string = "required parameter %s is missing"
 	errCollectionNotFound           string = "collection not found"
+	errFailedToLoadJWKS             string = "failed to load jwks"
+	errJWTIdentityClaimNotFound     string = "jwt identity claim not found"
+	errJWTIdentityNotMapped         string = "jwt identity is not mapped to an identity"
+	errJWTIssuerRequired            string = "jwt issuer is required to authenticate third-party tokens"
+	errJWTAudienceRequired          string = "jwt audience is required to authenticate third-party tokens"
 )
 
 // Errors returnable from this package.
@@ -42,6 +47,12 @@ var (
 	ErrP2PDisabled            = errors.New("p2p network is disabled")
 	ErrMethodIsNotImplemented = errors.New(errMethodIsNotImplemented)
 	ErrMissingIdentity        = errors.New("required identity is missing")
+
+	ErrFailedToLoadJWKS         = errors.New(errFailedToLoadJWKS)
+	ErrJWTIdentityClaimNotFound = errors.New(errJWTIdentityClaimNotFound)
+	ErrJWTIdentityNotMapped     = errors.New(errJWTIdentityNotMapped)
+	ErrJWTIssuerRequired        = errors.New(errJWTIssuerRequired)
+	ErrJWTAudienceRequired      = errors.New(errJWTAudienceRequired)
 )
 
 type errorResponse struct {
@@ -92,3 +103,20 @@ func NewErrCollectionNotFound(collectionName string) error {
 		errors.NewKV("CollectionName", collectionName),
 	)
 }
+
+func NewErrFailedToLoadJWKS(inner error, keySet string) error {
+	return errors.Wrap(errFailedToLoadJWKS, inner, errors.NewKV("KeySet", keySet))
+}
+
+func NewErrJWTIdentityClaimNotFound(claim string) error {
+	return errors.New(errJWTIdentityClaimNotFound, errors.NewKV("Claim", claim))
+}
+
+func NewErrJWTIdentityNotMapped(issuer, claim, value string) error {
+	return errors.New(
+		errJWTIdentityNotMapped,
+		errors.NewKV("Issuer", issuer),
+		errors.NewKV("Claim", claim),
+		errors.NewKV("Value", value),
+	)
+}
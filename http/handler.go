@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/sourcenetwork/immutable"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/event"
 
@@ -79,12 +81,32 @@ type DB interface {
 	Events() event.Bus
 }
 
+// HandlerOptions contains the options of a [Handler].
+type HandlerOptions struct {
+	// Authenticator authenticates the actors of requests with bearer tokens issued by a third-party.
+	Authenticator immutable.Option[Authenticator]
+}
+
+// HandlerOpt is a function that configures handler options.
+type HandlerOpt func(*HandlerOptions)
+
+// WithAuthenticator sets the authenticator of the bearer tokens issued by a third-party.
+func WithAuthenticator(authenticator Authenticator) HandlerOpt {
+	return func(opts *HandlerOptions) {
+		opts.Authenticator = immutable.Some(authenticator)
+	}
+}
+
 type Handler struct {
 	mux *chi.Mux
 	txs *sync.Map
 }
 
-func NewHandler(db DB, p2p client.P2P) (*Handler, error) {
+func NewHandler(db DB, p2p client.P2P, opts ...HandlerOpt) (*Handler, error) {
+	options := &HandlerOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 	router, err := NewApiRouter()
 	if err != nil {
 		return nil, err
@@ -95,7 +117,7 @@ func NewHandler(db DB, p2p client.P2P) (*Handler, error) {
 		r.Use(
 			ApiMiddleware(db, p2p, txs),
 			TransactionMiddleware,
-			AuthMiddleware,
+			AuthMiddleware(options.Authenticator),
 		)
 		r.Handle("/*", router)
 	})
@@ -15,6 +15,8 @@ import (
 	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 type acpHandler struct{}
@@ -90,6 +92,63 @@ func (s *acpHandler) DeleteDACActorRelationship(rw http.ResponseWriter, req *htt
 	responseJSON(rw, http.StatusOK, deleteDocActorRelResult)
 }
 
+func (s *acpHandler) AddDACActorRelationships(rw http.ResponseWriter, req *http.Request) {
+	db := mustGetContextClientDB(req)
+
+	var batch client.DACActorRelationshipBatch
+	err := requestJSON(req, &batch)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	batchResult, err := db.AddDACActorRelationships(req.Context(), batch)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	responseJSON(rw, http.StatusOK, batchResult)
+}
+
+func (s *acpHandler) DeleteDACActorRelationships(rw http.ResponseWriter, req *http.Request) {
+	db := mustGetContextClientDB(req)
+
+	var batch client.DACActorRelationshipBatch
+	err := requestJSON(req, &batch)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	batchResult, err := db.DeleteDACActorRelationships(req.Context(), batch)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	responseJSON(rw, http.StatusOK, batchResult)
+}
+
+func (s *acpHandler) GetACPAuditLog(rw http.ResponseWriter, req *http.Request) {
+	db := mustGetContextClientDB(req)
+
+	var query client.ACPAuditLogQuery
+	err := requestJSON(req, &query)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	records, err := db.GetACPAuditLog(req.Context(), query)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	responseJSON(rw, http.StatusOK, records)
+}
+
 func (s *acpHandler) AddNACActorRelationship(rw http.ResponseWriter, req *http.Request) {
 	db := mustGetContextClientDB(req)
 
@@ -200,6 +259,20 @@ func (h *acpHandler) bindRoutes(router *Router) {
 		Ref: "#/components/schemas/acp_document_relationship_delete_request",
 	}
 
+	relationshipBatchDACRequestSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/acp_document_relationship_batch_request",
+	}
+	relationshipBatchResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/acp_relationship_batch_result",
+	}
+
+	auditLogQuerySchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/acp_audit_log_query",
+	}
+	auditRecordSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/acp_audit_record",
+	}
+
 	addRelationshipNACRequestSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/acp_node_relationship_add_request",
 	}
@@ -258,6 +331,54 @@ func (h *acpHandler) bindRoutes(router *Router) {
 		Value: deleteActorRelationshipDACRequest,
 	}
 
+	relationshipBatchDACRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(relationshipBatchDACRequestSchema))
+	relationshipBatchDACResult := openapi3.NewResponse().
+		WithDescription("Document acp relationship batch result").
+		WithJSONSchemaRef(relationshipBatchResultSchema)
+
+	addActorRelationshipsDAC := openapi3.NewOperation()
+	addActorRelationshipsDAC.OperationID = "add dac relationship batch"
+	addActorRelationshipsDAC.Description = "Add an actor relationship to a batch of documents using document acp system"
+	addActorRelationshipsDAC.Tags = []string{"acp_document_relationship"}
+	addActorRelationshipsDAC.Responses = openapi3.NewResponses()
+	addActorRelationshipsDAC.AddResponse(200, relationshipBatchDACResult)
+	addActorRelationshipsDAC.Responses.Set("400", errorResponse)
+	addActorRelationshipsDAC.RequestBody = &openapi3.RequestBodyRef{
+		Value: relationshipBatchDACRequest,
+	}
+
+	deleteActorRelationshipsDAC := openapi3.NewOperation()
+	deleteActorRelationshipsDAC.OperationID = "delete dac relationship batch"
+	deleteActorRelationshipsDAC.Description = "Delete an actor relationship from a batch of documents using document acp system"
+	deleteActorRelationshipsDAC.Tags = []string{"acp_document_relationship"}
+	deleteActorRelationshipsDAC.Responses = openapi3.NewResponses()
+	deleteActorRelationshipsDAC.AddResponse(200, relationshipBatchDACResult)
+	deleteActorRelationshipsDAC.Responses.Set("400", errorResponse)
+	deleteActorRelationshipsDAC.RequestBody = &openapi3.RequestBodyRef{
+		Value: relationshipBatchDACRequest,
+	}
+
+	auditLogRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(auditLogQuerySchema))
+	auditRecordArraySchema := openapi3.NewArraySchema()
+	auditRecordArraySchema.Items = auditRecordSchema
+	auditLogResult := openapi3.NewResponse().
+		WithDescription("Document acp audit log records").
+		WithContent(openapi3.NewContentWithJSONSchema(auditRecordArraySchema))
+	auditLog := openapi3.NewOperation()
+	auditLog.OperationID = "dac audit log"
+	auditLog.Description = "Get the records of the document acp audit log matching the query"
+	auditLog.Tags = []string{"acp_document_audit"}
+	auditLog.Responses = openapi3.NewResponses()
+	auditLog.AddResponse(200, auditLogResult)
+	auditLog.Responses.Set("400", errorResponse)
+	auditLog.RequestBody = &openapi3.RequestBodyRef{
+		Value: auditLogRequest,
+	}
+
 	addActorRelationshipNACRequest := openapi3.NewRequestBody().
 		WithRequired(true).
 		WithContent(openapi3.NewContentWithJSONSchemaRef(addRelationshipNACRequestSchema))
@@ -333,6 +454,26 @@ func (h *acpHandler) bindRoutes(router *Router) {
 		h.DeleteDACActorRelationship,
 	)
 
+	router.AddRoute(
+		"/acp/document/relationship/batch",
+		http.MethodPost,
+		addActorRelationshipsDAC,
+		h.AddDACActorRelationships,
+	)
+	router.AddRoute(
+		"/acp/document/relationship/batch",
+		http.MethodDelete,
+		deleteActorRelationshipsDAC,
+		h.DeleteDACActorRelationships,
+	)
+
+	router.AddRoute(
+		"/acp/document/audit",
+		http.MethodPost,
+		auditLog,
+		h.GetACPAuditLog,
+	)
+
 	router.AddRoute(
 		"/acp/node/relationship",
 		http.MethodPost,
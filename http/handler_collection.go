@@ -20,6 +20,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/encryption"
@@ -39,6 +40,16 @@ type CollectionUpdateRequest struct {
 	Updater string `json:"updater"`
 }
 
+type CollectionRotateEncryptionKeyRequest struct {
+	Fields    []string `json:"fields"`
+	ReEncrypt bool     `json:"reEncrypt"`
+}
+
+type CollectionMigrateDocumentsRequest struct {
+	VersionID string `json:"versionID"`
+	BatchSize int    `json:"batchSize"`
+}
+
 func (s *collectionHandler) Create(rw http.ResponseWriter, req *http.Request) {
 	col := mustGetContextClientCollection(req)
 
@@ -178,6 +189,72 @@ func (s *collectionHandler) Delete(rw http.ResponseWriter, req *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *collectionHandler) RotateEncryptionKey(rw http.ResponseWriter, req *http.Request) {
+	col := mustGetContextClientCollection(req)
+
+	docID, err := client.NewDocIDFromString(chi.URLParam(req, "docID"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	var request CollectionRotateEncryptionKeyRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	err = col.RotateEncryptionKey(
+		req.Context(),
+		docID,
+		client.RotateKeyOfFields(request.Fields),
+		client.RotateKeyWithReEncryption(request.ReEncrypt),
+	)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *collectionHandler) MigrateDocuments(rw http.ResponseWriter, req *http.Request) {
+	col := mustGetContextClientCollection(req)
+
+	var request CollectionMigrateDocumentsRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	if request.VersionID != "" && request.VersionID != col.Version().VersionID {
+		db := mustGetContextClientDB(req)
+		cols, err := db.GetCollections(req.Context(), client.CollectionFetchOptions{
+			VersionID:       immutable.Some(request.VersionID),
+			IncludeInactive: immutable.Some(true),
+		})
+		if err != nil {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+			return
+		}
+		if len(cols) != 1 {
+			responseJSON(
+				rw,
+				http.StatusBadRequest,
+				errorResponse{client.NewErrCollectionNotFoundForCollectionVersion(request.VersionID)},
+			)
+			return
+		}
+		col = cols[0]
+	}
+
+	progress, err := col.MigrateDocuments(req.Context(), request.BatchSize)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, progress)
+}
+
 func (s *collectionHandler) Get(rw http.ResponseWriter, req *http.Request) {
 	col := mustGetContextClientCollection(req)
 	showDeleted, _ := strconv.ParseBool(req.URL.Query().Get("show_deleted"))
@@ -265,6 +342,7 @@ func (s *collectionHandler) CreateIndex(rw http.ResponseWriter, req *http.Reques
 		Name:   indexDesc.Name,
 		Fields: indexDesc.Fields,
 		Unique: indexDesc.Unique,
+		Blind:  indexDesc.Blind,
 	}
 	index, err := col.CreateIndex(req.Context(), descWithoutID)
 	if err != nil {
@@ -329,6 +407,15 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	indexCreateRequestSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/index_create_request",
 	}
+	rotateEncryptionKeySchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_rotate_encryption_key",
+	}
+	migrateDocumentsSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_migrate_documents",
+	}
+	migrateDocumentsProgressSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_migrate_documents_progress",
+	}
 
 	collectionNamePathParam := openapi3.NewPathParameter("name").
 		WithDescription("Collection name").
@@ -482,6 +569,42 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionDelete.Responses.Set("200", successResponse)
 	collectionDelete.Responses.Set("400", errorResponse)
 
+	rotateEncryptionKeyRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(rotateEncryptionKeySchema))
+
+	rotateEncryptionKey := openapi3.NewOperation()
+	rotateEncryptionKey.Description = "Rotate the encryption key of a document by docID"
+	rotateEncryptionKey.OperationID = "collection_rotate_encryption_key"
+	rotateEncryptionKey.Tags = []string{"collection"}
+	rotateEncryptionKey.AddParameter(collectionNamePathParam)
+	rotateEncryptionKey.AddParameter(documentIDPathParam)
+	rotateEncryptionKey.RequestBody = &openapi3.RequestBodyRef{
+		Value: rotateEncryptionKeyRequest,
+	}
+	rotateEncryptionKey.Responses = openapi3.NewResponses()
+	rotateEncryptionKey.Responses.Set("200", successResponse)
+	rotateEncryptionKey.Responses.Set("400", errorResponse)
+
+	migrateDocumentsRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(migrateDocumentsSchema))
+
+	migrateDocumentsResponse := openapi3.NewResponse().
+		WithDescription("Document migration progress").
+		WithJSONSchemaRef(migrateDocumentsProgressSchema)
+
+	migrateDocuments := openapi3.NewOperation()
+	migrateDocuments.Description = "Migrate the next batch of stored documents to a collection version"
+	migrateDocuments.OperationID = "collection_migrate_documents"
+	migrateDocuments.Tags = []string{"collection"}
+	migrateDocuments.AddParameter(collectionNamePathParam)
+	migrateDocuments.RequestBody = &openapi3.RequestBodyRef{
+		Value: migrateDocumentsRequest,
+	}
+	migrateDocuments.AddResponse(200, migrateDocumentsResponse)
+	migrateDocuments.Responses.Set("400", errorResponse)
+
 	collectionKeys := openapi3.NewOperation()
 	collectionKeys.AddParameter(collectionNamePathParam)
 	collectionKeys.Description = "Get all document IDs"
@@ -498,7 +621,14 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	router.AddRoute("/collections/{name}/indexes", http.MethodPost, createIndex, h.CreateIndex)
 	router.AddRoute("/collections/{name}/indexes", http.MethodGet, getIndexes, h.GetIndexes)
 	router.AddRoute("/collections/{name}/indexes/{index}", http.MethodDelete, dropIndex, h.DropIndex)
+	router.AddRoute("/collections/{name}/migrate", http.MethodPost, migrateDocuments, h.MigrateDocuments)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodGet, collectionGet, h.Get)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodPatch, collectionUpdate, h.Update)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodDelete, collectionDelete, h.Delete)
+	router.AddRoute(
+		"/collections/{name}/{docID}/encryption/rotate",
+		http.MethodPost,
+		rotateEncryptionKey,
+		h.RotateEncryptionKey,
+	)
 }
@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 type p2pHandler struct{}
@@ -84,6 +86,21 @@ func (s *p2pHandler) GetAllReplicators(rw http.ResponseWriter, req *http.Request
 	responseJSON(rw, http.StatusOK, reps)
 }
 
+func (s *p2pHandler) GetBannedPeers(rw http.ResponseWriter, req *http.Request) {
+	p2p, ok := tryGetContextClientP2P(req)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{ErrP2PDisabled})
+		return
+	}
+
+	peers, err := p2p.GetBannedPeers(req.Context())
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, peers)
+}
+
 func (s *p2pHandler) AddP2PCollections(rw http.ResponseWriter, req *http.Request) {
 	p2p, ok := tryGetContextClientP2P(req)
 	if !ok {
@@ -233,6 +250,62 @@ func (s *p2pHandler) SyncDocuments(rw http.ResponseWriter, req *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *p2pHandler) ExportBundle(rw http.ResponseWriter, req *http.Request) {
+	p2p, ok := tryGetContextClientP2P(req)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{ErrP2PDisabled})
+		return
+	}
+
+	var config client.BundleExportConfig
+	if err := requestJSON(req, &config); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	res, err := p2p.ExportBundle(req.Context(), config)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, res)
+}
+
+func (s *p2pHandler) ImportBundle(rw http.ResponseWriter, req *http.Request) {
+	p2p, ok := tryGetContextClientP2P(req)
+	if !ok {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{ErrP2PDisabled})
+		return
+	}
+
+	var reqBody struct {
+		Filepath string `json:"filepath"`
+		Timeout  string `json:"timeout"`
+	}
+	if err := requestJSON(req, &reqBody); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	ctx := req.Context()
+	if reqBody.Timeout != "" {
+		timeout, err := time.ParseDuration(reqBody.Timeout)
+		if err != nil {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	res, err := p2p.ImportBundle(ctx, reqBody.Filepath)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, res)
+}
+
 func (h *p2pHandler) bindRoutes(router *Router) {
 	successResponse := &openapi3.ResponseRef{
 		Ref: "#/components/responses/success",
@@ -249,6 +322,18 @@ func (h *p2pHandler) bindRoutes(router *Router) {
 	replicatorParamsSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/replicator_params",
 	}
+	bannedPeerSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/banned_peer",
+	}
+	bundleExportConfigSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/bundle_export_config",
+	}
+	bundleExportResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/bundle_export_result",
+	}
+	bundleImportResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/bundle_import_result",
+	}
 
 	peerInfoResponse := openapi3.NewResponse().
 		WithDescription("Peer network info").
@@ -299,6 +384,19 @@ func (h *p2pHandler) bindRoutes(router *Router) {
 	deleteReplicator.Responses.Set("200", successResponse)
 	deleteReplicator.Responses.Set("400", errorResponse)
 
+	getBannedPeersSchema := openapi3.NewArraySchema()
+	getBannedPeersSchema.Items = bannedPeerSchema
+	getBannedPeersResponse := openapi3.NewResponse().
+		WithDescription("Banned peers").
+		WithContent(openapi3.NewContentWithJSONSchema(getBannedPeersSchema))
+
+	getBannedPeers := openapi3.NewOperation()
+	getBannedPeers.Description = "List temporarily banned peers"
+	getBannedPeers.OperationID = "peer_banned_list"
+	getBannedPeers.Tags = []string{"p2p"}
+	getBannedPeers.AddResponse(200, getBannedPeersResponse)
+	getBannedPeers.Responses.Set("400", errorResponse)
+
 	peerCollectionsSchema := openapi3.NewArraySchema().
 		WithItems(openapi3.NewStringSchema())
 
@@ -403,10 +501,51 @@ func (h *p2pHandler) bindRoutes(router *Router) {
 	syncDocuments.Responses.Set("400", errorResponse)
 	syncDocuments.Responses.Set("500", errorResponse)
 
+	exportBundleRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(bundleExportConfigSchema))
+
+	exportBundleResponse := openapi3.NewResponse().
+		WithDescription("Bundle export result").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(bundleExportResultSchema))
+
+	exportBundle := openapi3.NewOperation()
+	exportBundle.Description = "Export document DAGs to an offline bundle"
+	exportBundle.OperationID = "peer_bundle_export"
+	exportBundle.Tags = []string{"p2p"}
+	exportBundle.RequestBody = &openapi3.RequestBodyRef{
+		Value: exportBundleRequest,
+	}
+	exportBundle.AddResponse(200, exportBundleResponse)
+	exportBundle.Responses.Set("400", errorResponse)
+
+	importBundleRequestSchema := openapi3.NewObjectSchema().
+		WithProperty("filepath", openapi3.NewStringSchema()).
+		WithProperty("timeout", openapi3.NewStringSchema())
+
+	importBundleRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchema(importBundleRequestSchema))
+
+	importBundleResponse := openapi3.NewResponse().
+		WithDescription("Bundle import result").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(bundleImportResultSchema))
+
+	importBundle := openapi3.NewOperation()
+	importBundle.Description = "Import document DAGs from an offline bundle"
+	importBundle.OperationID = "peer_bundle_import"
+	importBundle.Tags = []string{"p2p"}
+	importBundle.RequestBody = &openapi3.RequestBodyRef{
+		Value: importBundleRequest,
+	}
+	importBundle.AddResponse(200, importBundleResponse)
+	importBundle.Responses.Set("400", errorResponse)
+
 	router.AddRoute("/p2p/info", http.MethodGet, peerInfo, h.PeerInfo)
 	router.AddRoute("/p2p/replicators", http.MethodGet, getReplicators, h.GetAllReplicators)
 	router.AddRoute("/p2p/replicators", http.MethodPost, setReplicator, h.SetReplicator)
 	router.AddRoute("/p2p/replicators", http.MethodDelete, deleteReplicator, h.DeleteReplicator)
+	router.AddRoute("/p2p/banned", http.MethodGet, getBannedPeers, h.GetBannedPeers)
 	router.AddRoute("/p2p/collections", http.MethodGet, getPeerCollections, h.GetAllP2PCollections)
 	router.AddRoute("/p2p/collections", http.MethodPost, addPeerCollections, h.AddP2PCollections)
 	router.AddRoute("/p2p/collections", http.MethodDelete, removePeerCollections, h.RemoveP2PCollections)
@@ -414,4 +553,6 @@ func (h *p2pHandler) bindRoutes(router *Router) {
 	router.AddRoute("/p2p/documents", http.MethodPost, addPeerDocuments, h.AddP2PDocuments)
 	router.AddRoute("/p2p/documents", http.MethodDelete, removePeerDocuments, h.RemoveP2PDocuments)
 	router.AddRoute("/p2p/documents/sync", http.MethodPost, syncDocuments, h.SyncDocuments)
+	router.AddRoute("/p2p/bundle/export", http.MethodPost, exportBundle, h.ExportBundle)
+	router.AddRoute("/p2p/bundle/import", http.MethodPost, importBundle, h.ImportBundle)
 }
@@ -51,6 +51,10 @@ func (c *httpClient) setDefaultHeaders(req *http.Request) error {
 	if ok {
 		req.Header.Set(txHeaderName, fmt.Sprintf("%d", txn.ID()))
 	}
+	capability := identity.CapabilityFromContext(req.Context())
+	if capability.HasValue() {
+		req.Header.Set(capabilityHeaderName, capability.Value().Token())
+	}
 	id := identity.FromContext(req.Context())
 	if !id.HasValue() {
 		return nil
@@ -35,7 +35,7 @@ func CorsMiddleware(allowedOrigins []string) func(http.Handler) http.Handler {
 			return slices.Contains(allowedOrigins, strings.ToLower(origin))
 		},
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PATCH", "DELETE"},
-		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		AllowedHeaders: []string{"Content-Type", "Authorization", capabilityHeaderName},
 		MaxAge:         300,
 	})
 }
@@ -25,9 +25,16 @@ var openApiSchemas = map[string]any{
 	"create_tx":                                &CreateTxResponse{},
 	"collection_update":                        &CollectionUpdateRequest{},
 	"collection_delete":                        &CollectionDeleteRequest{},
+	"collection_rotate_encryption_key":         &CollectionRotateEncryptionKeyRequest{},
+	"collection_migrate_documents":             &CollectionMigrateDocumentsRequest{},
+	"collection_migrate_documents_progress":    &client.DocumentMigrationProgress{},
 	"peer_info":                                &peer.AddrInfo{},
 	"graphql_request":                          &GraphQLRequest{},
 	"backup_config":                            &client.BackupConfig{},
+	"banned_peer":                              &client.BannedPeer{},
+	"bundle_export_config":                     &client.BundleExportConfig{},
+	"bundle_export_result":                     &client.BundleExportResult{},
+	"bundle_import_result":                     &client.BundleImportResult{},
 	"collection":                               &client.CollectionVersion{},
 	"schema":                                   &client.SchemaDescription{},
 	"collection_definition":                    &client.CollectionDefinition{},
@@ -52,6 +59,10 @@ var openApiSchemas = map[string]any{
 	"acp_node_relationship_delete_request":     &deleteNACActorRelationshipRequest{},
 	"acp_document_relationship_add_request":    &addDACActorRelationshipRequest{},
 	"acp_document_relationship_delete_request": &deleteDACActorRelationshipRequest{},
+	"acp_document_relationship_batch_request":  &client.DACActorRelationshipBatch{},
+	"acp_relationship_batch_result":            &client.ActorRelationshipBatchResult{},
+	"acp_audit_log_query":                      &client.ACPAuditLogQuery{},
+	"acp_audit_record":                         &client.ACPAuditRecord{},
 	"identity":                                 &identity.PublicRawIdentity{},
 }
 
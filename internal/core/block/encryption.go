@@ -25,6 +25,12 @@ type Encryption struct {
 	FieldName *string
 	// Encryption key.
 	Key []byte
+	// Epoch is the number of times the encryption key of the document (or field) has been rotated
+	// when this key was generated.
+	//
+	// It is nil for the initial key. It needs to be a pointer so that it can be translated from and
+	// to `optional` in the IPLD schema, which keeps the CIDs of the initial keys unchanged.
+	Epoch *uint64
 }
 
 // IPLDSchemaBytes returns the IPLD schema representation for the encryption block.
@@ -36,10 +42,19 @@ func (enc *Encryption) IPLDSchemaBytes() []byte {
 			docID     Bytes
 			fieldName optional String
 			key       Bytes
+			epoch     optional Int
 		}
 	`)
 }
 
+// GetEpoch returns the key epoch of the encryption block, 0 being the epoch of the initial key.
+func (enc *Encryption) GetEpoch() uint64 {
+	if enc.Epoch == nil {
+		return 0
+	}
+	return *enc.Epoch
+}
+
 // GetFromBytes returns a block from encoded bytes.
 func GetEncryptionBlockFromBytes(b []byte) (*Encryption, error) {
 	enc := &Encryption{}
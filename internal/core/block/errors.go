@@ -14,6 +14,7 @@ import (
 	"fmt"
 
 	"github.com/ipfs/go-cid"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/defradb/errors"
@@ -40,6 +41,7 @@ const (
 	errFailedToGetNextQResult             = "failed to get next query result"
 	errCouldNotGetEncKey                  = "could not get encryption key"
 	errUnsupportedKeyForSigning           = "unsupported key type for signing"
+	errNotEncrypted                       = "document or field is not encrypted"
 )
 
 // Errors returnable from this package.
@@ -66,6 +68,7 @@ var (
 	ErrFailedToGetNextQResult      = errors.New(errFailedToGetNextQResult)
 	ErrDecodingHeight              = errors.New("error decoding height")
 	ErrCouldNotGetEncKey           = errors.New(errCouldNotGetEncKey)
+	ErrNotEncrypted                = errors.New(errNotEncrypted)
 )
 
 // NewErrFailedToGetPriority returns an error indicating that the priority could not be retrieved.
@@ -153,3 +156,12 @@ func NewErrFailedToGetNextQResult(inner error) error {
 func NewErrUnsupportedKeyForSigning(keyType crypto.KeyType) error {
 	return errors.New(errUnsupportedKeyForSigning, errors.NewKV("KeyType", keyType))
 }
+
+// NewErrNotEncrypted returns an error indicating that the document, or the field of the document
+// if given, is not encrypted with its own key.
+func NewErrNotEncrypted(docID string, fieldName immutable.Option[string]) error {
+	if fieldName.HasValue() {
+		return errors.New(errNotEncrypted, errors.NewKV("DocID", docID), errors.NewKV("Field", fieldName.Value()))
+	}
+	return errors.New(errNotEncrypted, errors.NewKV("DocID", docID))
+}
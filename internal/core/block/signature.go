@@ -97,6 +97,11 @@ func (sig *Signature) GenerateNode() ipld.Node {
 	return bindnode.Wrap(sig, SignatureSchema).Representation()
 }
 
+// PublicKey returns the public key of the signer.
+func (sig *Signature) PublicKey() (crypto.PublicKey, error) {
+	return getPublicKeyFromSignature(sig)
+}
+
 // verifySignature performs the cryptographic verification
 func verifySignature(pubKey crypto.PublicKey, signedBytes, sigValue []byte) error {
 	valid, err := pubKey.Verify(signedBytes, sigValue)
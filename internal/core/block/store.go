@@ -21,12 +21,15 @@ import (
 	"github.com/ipld/go-ipld-prime/linking"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 
+	"github.com/sourcenetwork/corekv"
 	"github.com/sourcenetwork/corelog"
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/datastore"
 	"github.com/sourcenetwork/defradb/internal/encryption"
+	"github.com/sourcenetwork/defradb/internal/keys"
 )
 
 func putBlock(
@@ -118,7 +121,28 @@ func determineBlockEncryption(
 ) (*Encryption, cidlink.Link, error) {
 	txn := datastore.CtxMustGetTxn(ctx)
 
-	// if new encryption was requested by the user
+	// if the previous block is encrypted we use the same encryption
+	prevEncBlock, prevEncLink, err := getHeadsEncryption(ctx, heads)
+	if err != nil {
+		return nil, cidlink.Link{}, err
+	}
+	if prevEncBlock != nil {
+		// unless its key has been rotated since
+		encFieldName := immutable.None[string]()
+		if prevEncBlock.FieldName != nil {
+			encFieldName = immutable.Some(*prevEncBlock.FieldName)
+		}
+		rotatedEncBlock, rotatedEncLink, err := getRotatedEncryption(ctx, docID, encFieldName)
+		if err != nil {
+			return nil, cidlink.Link{}, err
+		}
+		if rotatedEncBlock != nil && rotatedEncBlock.GetEpoch() > prevEncBlock.GetEpoch() {
+			return rotatedEncBlock, rotatedEncLink, nil
+		}
+		return prevEncBlock, prevEncLink, nil
+	}
+
+	// otherwise new encryption is used if it was requested by the user, or declared on the collection
 	if encryption.ShouldEncryptDocField(ctx, fieldName) {
 		encBlock := &Encryption{DocID: []byte(docID)}
 		if encryption.ShouldEncryptIndividualField(ctx, fieldName) {
@@ -143,7 +167,21 @@ func determineBlockEncryption(
 		}
 	}
 
-	// otherwise we use the same encryption as the previous block
+	return nil, cidlink.Link{}, nil
+}
+
+// getHeadsEncryption returns the encryption of the given heads.
+//
+// If the heads have been encrypted with keys of different epochs, which can happen if the key has
+// been rotated concurrently by different peers, the encryption of the latest epoch is returned.
+func getHeadsEncryption(
+	ctx context.Context,
+	heads []cid.Cid,
+) (*Encryption, cidlink.Link, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	var encBlock *Encryption
+	var encLink cidlink.Link
 	for _, headCid := range heads {
 		prevBlockBytes, err := txn.Blockstore().AsIPLDStorage().Get(ctx, headCid.KeyString())
 		if err != nil {
@@ -153,24 +191,115 @@ func determineBlockEncryption(
 		if err != nil {
 			return nil, cidlink.Link{}, err
 		}
-		if prevBlock.Encryption != nil {
-			prevBlockEncBytes, err := txn.Encstore().AsIPLDStorage().Get(ctx, prevBlock.Encryption.Cid.KeyString())
-			if err != nil {
-				return nil, cidlink.Link{}, NewErrCouldNotFindBlock(headCid, err)
-			}
-			prevEncBlock, err := GetEncryptionBlockFromBytes(prevBlockEncBytes)
-			if err != nil {
-				return nil, cidlink.Link{}, err
-			}
-			return &Encryption{
-				DocID:     prevEncBlock.DocID,
-				FieldName: prevEncBlock.FieldName,
-				Key:       prevEncBlock.Key,
-			}, *prevBlock.Encryption, nil
+		if prevBlock.Encryption == nil {
+			continue
+		}
+		prevBlockEncBytes, err := txn.Encstore().AsIPLDStorage().Get(ctx, prevBlock.Encryption.Cid.KeyString())
+		if err != nil {
+			return nil, cidlink.Link{}, NewErrCouldNotFindBlock(headCid, err)
+		}
+		prevEncBlock, err := GetEncryptionBlockFromBytes(prevBlockEncBytes)
+		if err != nil {
+			return nil, cidlink.Link{}, err
+		}
+		if encBlock == nil || prevEncBlock.GetEpoch() > encBlock.GetEpoch() {
+			encBlock = prevEncBlock
+			encLink = *prevBlock.Encryption
 		}
 	}
 
-	return nil, cidlink.Link{}, nil
+	return encBlock, encLink, nil
+}
+
+// getRotatedEncryption returns the encryption holding the latest rotated key of the document, or of the
+// given field of the document.
+//
+// Nil is returned if the key has never been rotated.
+func getRotatedEncryption(
+	ctx context.Context,
+	docID string,
+	fieldName immutable.Option[string],
+) (*Encryption, cidlink.Link, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	linkBytes, err := txn.Systemstore().Get(ctx, keys.NewEncryptionEpochKey(docID, fieldName).Bytes())
+	if errors.Is(err, corekv.ErrNotFound) {
+		return nil, cidlink.Link{}, nil
+	}
+	if err != nil {
+		return nil, cidlink.Link{}, err
+	}
+	_, encCid, err := cid.CidFromBytes(linkBytes)
+	if err != nil {
+		return nil, cidlink.Link{}, err
+	}
+	encBytes, err := txn.Encstore().AsIPLDStorage().Get(ctx, encCid.KeyString())
+	if err != nil {
+		return nil, cidlink.Link{}, NewErrCouldNotFindBlock(encCid, err)
+	}
+	encBlock, err := GetEncryptionBlockFromBytes(encBytes)
+	if err != nil {
+		return nil, cidlink.Link{}, err
+	}
+	return encBlock, cidlink.Link{Cid: encCid}, nil
+}
+
+// RotateEncryption generates a new encryption key for the document, or for the given field of the document
+// if it is encrypted individually.
+//
+// The current key is found from the encryption of the given heads and from the previous rotations, the new
+// key being of the following epoch. The new key is stored in the encryption store, and all the blocks added
+// to the DAGs of the document (or field) from then on are encrypted with it. The blocks that have been
+// added before remain encrypted with the key of their own epoch.
+func RotateEncryption(
+	ctx context.Context,
+	docID string,
+	fieldName immutable.Option[string],
+	heads []cid.Cid,
+) (*Encryption, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	encBlock, _, err := getHeadsEncryption(ctx, heads)
+	if err != nil {
+		return nil, err
+	}
+	rotatedEncBlock, _, err := getRotatedEncryption(ctx, docID, fieldName)
+	if err != nil {
+		return nil, err
+	}
+	if encBlock == nil || (rotatedEncBlock != nil && rotatedEncBlock.GetEpoch() > encBlock.GetEpoch()) {
+		encBlock = rotatedEncBlock
+	}
+
+	if encBlock == nil {
+		return nil, NewErrNotEncrypted(docID, fieldName)
+	}
+	if fieldName.HasValue() != (encBlock.FieldName != nil) {
+		// The key of a field that is not encrypted individually is the key of the document.
+		return nil, NewErrNotEncrypted(docID, fieldName)
+	}
+
+	epoch := encBlock.GetEpoch() + 1
+	key, err := encryption.GenerateRotatedEncryptionKey(docID, fieldName, epoch)
+	if err != nil {
+		return nil, err
+	}
+	newEncBlock := &Encryption{
+		DocID:     []byte(docID),
+		FieldName: encBlock.FieldName,
+		Key:       key,
+		Epoch:     &epoch,
+	}
+
+	link, err := putBlock(ctx, txn.Encstore(), newEncBlock)
+	if err != nil {
+		return nil, err
+	}
+	err = txn.Systemstore().Set(ctx, keys.NewEncryptionEpochKey(docID, fieldName).Bytes(), link.Cid.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return newEncBlock, nil
 }
 
 func encryptBlock(
@@ -100,6 +100,12 @@ type DocumentMapping struct {
 	// The type information for the object, if provided.
 	typeInfo immutable.Option[mappingTypeInfo]
 
+	// The names of the values of enum fields, by field index.
+	//
+	// Enum field values are held as ordinals, and are rendered as the names
+	// of the values that they represent.
+	enumValues map[int][]string
+
 	// The set of fields that should be rendered.
 	//
 	// Fields not in this collection will not be rendered to the consumer.
@@ -138,6 +144,7 @@ func NewDocumentMapping() *DocumentMapping {
 func (source *DocumentMapping) CloneWithoutRender() *DocumentMapping {
 	result := DocumentMapping{
 		typeInfo:      source.typeInfo,
+		enumValues:    source.enumValues,
 		IndexesByName: make(map[string][]int, len(source.IndexesByName)),
 		nextIndex:     source.nextIndex,
 		ChildMappings: make([]*DocumentMapping, len(source.ChildMappings)),
@@ -236,6 +243,8 @@ func (mapping *DocumentMapping) ToMap(doc Doc) map[string]any {
 		default:
 			if mapping.typeInfo.HasValue() && renderKey.Index == mapping.typeInfo.Value().Index {
 				renderValue = mapping.typeInfo.Value().Name
+			} else if values, isEnum := mapping.enumValues[renderKey.Index]; isEnum {
+				renderValue = renderEnumValue(values, innerV)
 			} else {
 				renderValue = innerV
 			}
@@ -245,6 +254,17 @@ func (mapping *DocumentMapping) ToMap(doc Doc) map[string]any {
 	return mappedDoc
 }
 
+// renderEnumValue returns the name of the enum value represented by the given ordinal.
+//
+// Values that are not valid ordinals, such as nil, are returned as is.
+func renderEnumValue(values []string, value any) any {
+	ordinal, ok := value.(int64)
+	if !ok || ordinal < 0 || ordinal >= int64(len(values)) {
+		return value
+	}
+	return values[ordinal]
+}
+
 // Add appends the given index and name to the mapping.
 func (mapping *DocumentMapping) Add(index int, name string) {
 	inner := mapping.IndexesByName[name]
@@ -266,6 +286,15 @@ func (mapping *DocumentMapping) SetTypeName(typeName string) {
 	})
 }
 
+// SetEnumValues declares the field at the given index as an enum field holding
+// ordinals of the given values.
+func (mapping *DocumentMapping) SetEnumValues(index int, values []string) {
+	if mapping.enumValues == nil {
+		mapping.enumValues = map[int][]string{}
+	}
+	mapping.enumValues[index] = values
+}
+
 // SetChildAt sets the given child mapping at the given index.
 //
 // If the index is greater than the ChildMappings length the collection will
@@ -116,6 +116,11 @@ func NormalizeFieldValue(fieldDesc client.FieldDefinition, val any) (any, error)
 			return convertToJSON(fieldDesc.Name, val)
 		}
 	} else { // CBOR often encodes values typed as floats as ints
+		if _, ok := fieldDesc.Kind.(*client.EnumKind); ok {
+			// Enum values are stored as their ordinals
+			return convertToInt(fieldDesc.Name, val)
+		}
+
 		switch fieldDesc.Kind {
 		case client.FieldKind_NILLABLE_FLOAT64:
 			switch v := val.(type) {
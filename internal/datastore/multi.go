@@ -24,6 +24,7 @@ var (
 	blockStoreKey  = rootStoreKey.ChildString("blocks")
 	peerStoreKey   = rootStoreKey.ChildString("ps")
 	encStoreKey    = rootStoreKey.ChildString("enc")
+	auditStoreKey  = rootStoreKey.ChildString("audit")
 )
 
 type Multistore struct {
@@ -99,3 +100,12 @@ func SystemstoreFrom(rootstore corekv.Store) corekv.ReaderWriter {
 func PeerstoreFrom(rootstore corekv.Store) corekv.ReaderWriter {
 	return prefix(rootstore, peerStoreKey.Bytes())
 }
+
+// AuditstoreFrom returns the prefixed store for the acp audit log.
+//
+// The audit log is written outside of the transaction of the audited request, so that the records
+// of operations that failed (for example because access was denied) are kept. The given store may
+// be a transaction of its own, in which the records are written in batches.
+func AuditstoreFrom(rootstore corekv.ReaderWriter) corekv.ReaderWriter {
+	return prefix(rootstore, auditStoreKey.Bytes())
+}
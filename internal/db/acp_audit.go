@@ -0,0 +1,378 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sourcenetwork/corekv"
+	"github.com/sourcenetwork/corelog"
+
+	"github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+const (
+	// acpAuditPruneInterval is the interval at which the records older than the retention period
+	// are removed from the acp audit log.
+	acpAuditPruneInterval = 10 * time.Minute
+
+	// acpAuditFlushInterval is the interval at which the buffered records are written to the acp
+	// audit log.
+	acpAuditFlushInterval = time.Second
+
+	// acpAuditBatchSize is the number of buffered records that triggers a write before the flush
+	// interval has elapsed.
+	acpAuditBatchSize = 256
+
+	// acpAuditMaxPending is the maximum number of buffered records. Records are dropped once it
+	// is reached, for example when the store keeps failing, so that the buffer can not grow
+	// without bound.
+	acpAuditMaxPending = 64 * acpAuditBatchSize
+)
+
+// acpAuditLog is the append-only log of the authorization decisions made by the document acp
+// system.
+//
+// The records are buffered, so that recording a decision does not write to the store within the
+// access check, and written in batches to their own prefix of the rootstore. They are written
+// outside of the transaction of the checked request, so that the records of the requests that
+// failed are kept. The records are published on the event bus once written.
+type acpAuditLog struct {
+	rootstore corekv.TxnStore
+	events    event.Bus
+
+	// The records older than the retention period are pruned, zero keeps the records forever.
+	retention time.Duration
+
+	// flushSignal is signalled when the buffer reaches the batch size.
+	flushSignal chan struct{}
+
+	// mu guards the buffered records, the sequence number and the dropped record counters.
+	mu      sync.Mutex
+	pending []client.ACPAuditRecord
+	// The sequence number of the last written record, ordering the records made at the same time.
+	seq uint64
+	// The number of records dropped since the last acp audit dropped event.
+	dropped uint64
+	// The number of records dropped since the log was opened.
+	droppedTotal uint64
+
+	// flushMu serializes the writes of the buffered records, so that the records are written
+	// in the order they were recorded.
+	flushMu sync.Mutex
+}
+
+var _ permission.AuditLog = (*acpAuditLog)(nil)
+
+func newACPAuditLog(rootstore corekv.TxnStore, events event.Bus, retention time.Duration) *acpAuditLog {
+	return &acpAuditLog{
+		rootstore:   rootstore,
+		events:      events,
+		retention:   retention,
+		flushSignal: make(chan struct{}, 1),
+	}
+}
+
+func (l *acpAuditLog) Record(ctx context.Context, record client.ACPAuditRecord) {
+	l.mu.Lock()
+	if len(l.pending) >= acpAuditMaxPending {
+		l.dropLocked(1)
+	} else {
+		l.pending = append(l.pending, record)
+	}
+	isFull := len(l.pending) >= acpAuditBatchSize
+	l.mu.Unlock()
+
+	if isFull {
+		select {
+		case l.flushSignal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// droppedRecords returns the number of records dropped since the log was opened.
+func (l *acpAuditLog) droppedRecords() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.droppedTotal
+}
+
+func (l *acpAuditLog) dropLocked(count uint64) {
+	l.dropped += count
+	l.droppedTotal += count
+}
+
+// flush writes the buffered records to the store within a single transaction, and publishes them
+// on the event bus.
+//
+// If the records can not be written, they are put back in front of the buffer so that they are
+// written, in order, by the next flush.
+func (l *acpAuditLog) flush(ctx context.Context) error {
+	l.flushMu.Lock()
+	defer l.flushMu.Unlock()
+
+	l.publishDropped()
+
+	l.mu.Lock()
+	records := l.pending
+	l.pending = nil
+	firstSeq := l.seq + 1
+	l.mu.Unlock()
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	if err := l.write(ctx, records, firstSeq); err != nil {
+		l.mu.Lock()
+		l.pending = append(records, l.pending...)
+		if len(l.pending) > acpAuditMaxPending {
+			l.dropLocked(uint64(len(l.pending) - acpAuditMaxPending))
+			l.pending = l.pending[:acpAuditMaxPending]
+		}
+		l.mu.Unlock()
+		return err
+	}
+
+	l.mu.Lock()
+	l.seq += uint64(len(records))
+	l.mu.Unlock()
+
+	for _, record := range records {
+		l.events.Publish(event.NewMessage(event.ACPAuditName, event.ACPAudit{
+			Timestamp:      record.Timestamp,
+			Identity:       record.Identity,
+			Operation:      record.Operation,
+			CollectionName: record.CollectionName,
+			DocID:          record.DocID,
+			Permission:     record.Permission,
+			Allowed:        record.Allowed,
+		}))
+	}
+	return nil
+}
+
+// write writes the given records to the store within a single transaction, numbering them from
+// the given sequence number.
+func (l *acpAuditLog) write(ctx context.Context, records []client.ACPAuditRecord, firstSeq uint64) error {
+	txn := l.rootstore.NewTxn(false)
+	defer txn.Discard()
+
+	store := datastore.AuditstoreFrom(txn)
+	for i, record := range records {
+		value, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		key := keys.NewACPAuditRecordKey(record.Timestamp, firstSeq+uint64(i))
+		if err := store.Set(ctx, key.Bytes(), value); err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+// publishDropped logs and publishes the number of records dropped since the last call, if any.
+func (l *acpAuditLog) publishDropped() {
+	l.mu.Lock()
+	dropped := l.dropped
+	total := l.droppedTotal
+	l.dropped = 0
+	l.mu.Unlock()
+
+	if dropped == 0 {
+		return
+	}
+	log.Error("Dropped acp audit records as the buffer is full",
+		corelog.Uint64("Count", dropped),
+		corelog.Uint64("Total", total))
+	l.events.Publish(event.NewMessage(event.ACPAuditDroppedName, event.ACPAuditDropped{
+		Count: dropped,
+		Total: total,
+	}))
+}
+
+// flushBuffered writes the buffered records at a regular interval, or as soon as the buffer is
+// full, until the given context is done.
+//
+// The records remaining in the buffer once the context is done are written when the database
+// is closed.
+func (l *acpAuditLog) flushBuffered(ctx context.Context) {
+	ticker := time.NewTicker(acpAuditFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-l.flushSignal:
+		}
+
+		if err := l.flush(ctx); err != nil {
+			log.ErrorContextE(ctx, "Failed to write the acp audit records", err)
+		}
+	}
+}
+
+// query returns the records matching the given query, from the oldest to the newest.
+//
+// The buffered records are written first, so that all the decisions recorded so far are returned.
+func (l *acpAuditLog) query(ctx context.Context, query client.ACPAuditLogQuery) ([]client.ACPAuditRecord, error) {
+	if err := l.flush(ctx); err != nil {
+		return nil, err
+	}
+
+	store := datastore.AuditstoreFrom(l.rootstore)
+	opts := corekv.IterOptions{}
+	if !query.Since.IsZero() {
+		opts.Start = keys.NewACPAuditRecordKey(query.Since, 0).Bytes()
+	}
+	if !query.Until.IsZero() {
+		opts.End = keys.NewACPAuditRecordKey(query.Until, 0).Bytes()
+	}
+
+	iter, err := store.Iterator(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []client.ACPAuditRecord{}
+	for query.Limit <= 0 || len(records) < query.Limit {
+		hasNext, err := iter.Next()
+		if err != nil {
+			return nil, errors.Join(err, iter.Close())
+		}
+		if !hasNext {
+			break
+		}
+
+		value, err := iter.Value()
+		if err != nil {
+			return nil, errors.Join(err, iter.Close())
+		}
+
+		var record client.ACPAuditRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, errors.Join(err, iter.Close())
+		}
+
+		if matchesACPAuditLogQuery(record, query) {
+			records = append(records, record)
+		}
+	}
+
+	return records, iter.Close()
+}
+
+// prune removes the records made before the given time.
+func (l *acpAuditLog) prune(ctx context.Context, before time.Time) error {
+	if err := l.flush(ctx); err != nil {
+		return err
+	}
+
+	store := datastore.AuditstoreFrom(l.rootstore)
+	iter, err := store.Iterator(ctx, corekv.IterOptions{
+		End: keys.NewACPAuditRecordKey(before, 0).Bytes(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var expired [][]byte
+	for {
+		hasNext, err := iter.Next()
+		if err != nil {
+			return errors.Join(err, iter.Close())
+		}
+		if !hasNext {
+			break
+		}
+		// The key is copied as the iterator may reuse it.
+		expired = append(expired, slices.Clone(iter.Key()))
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneExpired removes the records older than the retention period, at a regular interval,
+// until the given context is done.
+func (l *acpAuditLog) pruneExpired(ctx context.Context) {
+	ticker := time.NewTicker(acpAuditPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.prune(ctx, time.Now().Add(-l.retention)); err != nil {
+			log.ErrorContextE(ctx, "Failed to prune the acp audit log", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func matchesACPAuditLogQuery(record client.ACPAuditRecord, query client.ACPAuditLogQuery) bool {
+	switch {
+	case query.Identity != "" && record.Identity != query.Identity:
+		return false
+	case query.CollectionName != "" && record.CollectionName != query.CollectionName:
+		return false
+	case query.DocID != "" && record.DocID != query.DocID:
+		return false
+	case query.OnlyDenied && record.Allowed:
+		return false
+	default:
+		return true
+	}
+}
+
+func (db *DB) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	if !db.acpAuditLog.HasValue() {
+		return nil, ErrACPAuditLogNotEnabled
+	}
+
+	// The log holds the access patterns of all actors, so only the node itself may read it.
+	ident := identity.FromContext(ctx)
+	if !ident.HasValue() || !db.nodeIdentity.HasValue() || ident.Value().DID() != db.nodeIdentity.Value().DID() {
+		return nil, ErrACPAuditLogRequiresNodeIdentity
+	}
+
+	return db.acpAuditLog.Value().query(ctx, query)
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	badgerds "github.com/dgraph-io/badger/v4"
+	"github.com/sourcenetwork/corekv"
+	"github.com/sourcenetwork/corekv/badger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+)
+
+func newTestACPAuditLog(t *testing.T) *acpAuditLog {
+	rootstore, err := badger.NewDatastore("", badgerds.DefaultOptions("").WithInMemory(true))
+	require.NoError(t, err)
+
+	events := event.NewChannelBus(commandBufferSize, eventBufferSize)
+	t.Cleanup(events.Close)
+
+	return newACPAuditLog(rootstore, events, 0)
+}
+
+func TestACPAuditLog_QueryWithTimeRange(t *testing.T) {
+	ctx := context.Background()
+	auditLog := newTestACPAuditLog(t)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		auditLog.Record(ctx, client.ACPAuditRecord{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Operation: "query",
+			Allowed:   i%2 == 0,
+		})
+	}
+
+	records, err := auditLog.query(ctx, client.ACPAuditLogQuery{
+		Since: start.Add(time.Second),
+		Until: start.Add(2 * time.Second),
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.True(t, records[0].Timestamp.Equal(start.Add(time.Second)))
+	require.False(t, records[0].Allowed)
+}
+
+func TestACPAuditLog_Prune(t *testing.T) {
+	ctx := context.Background()
+	auditLog := newTestACPAuditLog(t)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		auditLog.Record(ctx, client.ACPAuditRecord{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Operation: "query",
+		})
+	}
+
+	err := auditLog.prune(ctx, start.Add(2*time.Second))
+	require.NoError(t, err)
+
+	records, err := auditLog.query(ctx, client.ACPAuditLogQuery{})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.True(t, records[0].Timestamp.Equal(start.Add(2*time.Second)))
+}
+
+func TestACPAuditLog_RecordIsBufferedUntilFlushed(t *testing.T) {
+	ctx := context.Background()
+	auditLog := newTestACPAuditLog(t)
+
+	auditLog.Record(ctx, client.ACPAuditRecord{
+		Timestamp: time.Now(),
+		Operation: "query",
+	})
+
+	store := datastore.AuditstoreFrom(auditLog.rootstore)
+	iter, err := store.Iterator(ctx, corekv.IterOptions{})
+	require.NoError(t, err)
+	hasNext, err := iter.Next()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+	require.NoError(t, iter.Close())
+
+	err = auditLog.flush(ctx)
+	require.NoError(t, err)
+
+	iter, err = store.Iterator(ctx, corekv.IterOptions{})
+	require.NoError(t, err)
+	hasNext, err = iter.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.NoError(t, iter.Close())
+}
+
+// failingCommitStore is a store whose transactions fail to commit while fail is true.
+type failingCommitStore struct {
+	corekv.TxnStore
+	fail bool
+}
+
+type failingCommitTxn struct {
+	corekv.Txn
+	store *failingCommitStore
+}
+
+func (s *failingCommitStore) NewTxn(readonly bool) corekv.Txn {
+	return &failingCommitTxn{Txn: s.TxnStore.NewTxn(readonly), store: s}
+}
+
+func (t *failingCommitTxn) Commit() error {
+	if t.store.fail {
+		return errors.New("commit failed")
+	}
+	return t.Txn.Commit()
+}
+
+func TestACPAuditLog_FlushWithFailingWrite_ShouldKeepRecordsInOrder(t *testing.T) {
+	ctx := context.Background()
+	rootstore, err := badger.NewDatastore("", badgerds.DefaultOptions("").WithInMemory(true))
+	require.NoError(t, err)
+	store := &failingCommitStore{TxnStore: rootstore, fail: true}
+
+	events := event.NewChannelBus(commandBufferSize, eventBufferSize)
+	t.Cleanup(events.Close)
+	auditLog := newACPAuditLog(store, events, 0)
+
+	start := time.Now()
+	auditLog.Record(ctx, client.ACPAuditRecord{Timestamp: start, Operation: "query"})
+	auditLog.Record(ctx, client.ACPAuditRecord{Timestamp: start, Operation: "create"})
+
+	err = auditLog.flush(ctx)
+	require.Error(t, err)
+
+	auditLog.Record(ctx, client.ACPAuditRecord{Timestamp: start, Operation: "update"})
+	store.fail = false
+
+	records, err := auditLog.query(ctx, client.ACPAuditLogQuery{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "query", records[0].Operation)
+	require.Equal(t, "create", records[1].Operation)
+	require.Equal(t, "update", records[2].Operation)
+}
+
+func TestACPAuditLog_RecordWithFullBuffer_ShouldDropRecords(t *testing.T) {
+	ctx := context.Background()
+	auditLog := newTestACPAuditLog(t)
+
+	sub, err := auditLog.events.Subscribe(event.ACPAuditDroppedName)
+	require.NoError(t, err)
+
+	for i := 0; i < acpAuditMaxPending+2; i++ {
+		auditLog.Record(ctx, client.ACPAuditRecord{Timestamp: time.Now(), Operation: "query"})
+	}
+	require.Equal(t, uint64(2), auditLog.droppedRecords())
+
+	err = auditLog.flush(ctx)
+	require.NoError(t, err)
+
+	select {
+	case msg := <-sub.Message():
+		dropped := msg.Data.(event.ACPAuditDropped)
+		require.Equal(t, uint64(2), dropped.Count)
+		require.Equal(t, uint64(2), dropped.Total)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the acp audit dropped event")
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/sourcenetwork/defradb/internal/db/description"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
 	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
 	"github.com/sourcenetwork/defradb/internal/encryption"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/lens"
@@ -77,14 +78,16 @@ func (db *DB) newCollection(desc client.CollectionVersion, schema client.SchemaD
 // It's a very simple factory, but it allows us to inject a mock fetcher
 // for testing.
 func (c *collection) newFetcher() fetcher.Fetcher {
-	var innerFetcher fetcher.Fetcher
+	return lens.NewFetcher(c.newUnmigratedFetcher(), c.db.LensRegistry())
+}
+
+// newUnmigratedFetcher returns a fetcher yielding documents as they are stored, without
+// migrating them to the version of this collection.
+func (c *collection) newUnmigratedFetcher() fetcher.Fetcher {
 	if c.fetcherFactory != nil {
-		innerFetcher = c.fetcherFactory()
-	} else {
-		innerFetcher = fetcher.NewDocumentFetcher()
+		return c.fetcherFactory()
 	}
-
-	return lens.NewFetcher(innerFetcher, c.db.LensRegistry())
+	return fetcher.NewDocumentFetcher()
 }
 
 func (db *DB) getCollectionByID(ctx context.Context, id string) (client.Collection, error) {
@@ -453,16 +456,29 @@ func (c *collection) create(
 	doc *client.Document,
 	opts []client.DocCreateOption,
 ) error {
-	err := c.setEmbedding(ctx, doc, true)
+	ctx = permission.WithOperation(ctx, permission.OperationCreate)
+	err := c.checkCreateAccessWithACP(ctx)
 	if err != nil {
 		return err
 	}
 
+	// The document ID is derived from the values given for the document only, the computed values
+	// and the generated embeddings are derived from them and are thus not accounted for.
 	docID, primaryKey, err := c.getDocIDAndPrimaryKeyFromDoc(ctx, doc)
 	if err != nil {
 		return err
 	}
 
+	err = c.setComputedFields(ctx, doc, true)
+	if err != nil {
+		return err
+	}
+
+	err = c.setEmbedding(ctx, doc, true)
+	if err != nil {
+		return err
+	}
+
 	// check if doc already exists
 	exists, isDeleted, err := c.exists(ctx, primaryKey)
 	if err != nil {
@@ -509,7 +525,15 @@ func (c *collection) create(
 		return err
 	}
 
-	return c.registerDocWithACP(ctx, doc.ID().String())
+	err = c.registerDocWithACP(ctx, doc.ID().String())
+	if err != nil {
+		return err
+	}
+
+	if c.hasFieldRelationsWithACP(doc) {
+		return c.syncFieldRelationshipsWithACP(ctx, doc.ID().String(), nil, doc)
+	}
+	return nil
 }
 
 func setContextDocEncryption(ctx context.Context, opts []client.DocCreateOption) context.Context {
@@ -571,6 +595,8 @@ func (c *collection) update(
 	ctx context.Context,
 	doc *client.Document,
 ) error {
+	ctx = permission.WithOperation(ctx, permission.OperationUpdate)
+
 	// Stop the update if the correct permissions aren't there.
 	canUpdate, err := c.checkAccessOfDocWithACP(
 		ctx,
@@ -584,6 +610,31 @@ func (c *collection) update(
 		return client.ErrDocumentNotFoundOrNotAuthorized
 	}
 
+	err = c.checkFieldAccessOfDocWithACP(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	// The relations derived from fields are synced against the stored actors, which must
+	// be fetched before they are overwritten.
+	hasFieldRelations := c.hasFieldRelationsWithACP(doc)
+	var fieldRelationsDoc *client.Document
+	if hasFieldRelations {
+		primaryKey, err := c.getPrimaryKeyFromDocID(ctx, doc.ID())
+		if err != nil {
+			return err
+		}
+		fieldRelationsDoc, err = c.getFieldRelationsDoc(ctx, primaryKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.setComputedFields(ctx, doc, false)
+	if err != nil {
+		return err
+	}
+
 	err = c.setEmbedding(ctx, doc, false)
 	if err != nil {
 		return err
@@ -593,6 +644,10 @@ func (c *collection) update(
 	if err != nil {
 		return err
 	}
+
+	if hasFieldRelations {
+		return c.syncFieldRelationshipsWithACP(ctx, doc.ID().String(), fieldRelationsDoc, doc)
+	}
 	return nil
 }
 
@@ -612,6 +667,9 @@ func (c *collection) Save(
 	}
 	defer txn.Discard(ctx)
 
+	// The existence check is made on behalf of the update, the create sets its own operation.
+	ctx = permission.WithOperation(ctx, permission.OperationUpdate)
+
 	// Check if document already exists with primary DS key.
 	primaryKey, err := c.getPrimaryKeyFromDocID(ctx, doc.ID())
 	if err != nil {
@@ -668,18 +726,23 @@ func (c *collection) validateEncryptedFields(ctx context.Context) error {
 	return nil
 }
 
-// save saves the document state. save MUST not be called outside the `c.create`
-// and `c.update` methods as we wrap the acp logic within those methods. Calling
-// save elsewhere could cause the omission of acp checks.
+// save saves the document state. save MUST not be called outside the `c.create`,
+// `c.update` and `c.reEncrypt` methods as we wrap the acp logic within those methods.
+// Calling save elsewhere could cause the omission of acp checks.
 func (c *collection) save(
 	ctx context.Context,
 	doc *client.Document,
 	isCreate bool,
 ) error {
+	ctx = c.setContextSchemaEncryption(ctx)
 	if err := c.validateEncryptedFields(ctx); err != nil {
 		return err
 	}
 
+	if err := c.validateFieldConstraints(doc); err != nil {
+		return err
+	}
+
 	if !isCreate {
 		err := c.updateIndexedDoc(ctx, doc)
 		if err != nil {
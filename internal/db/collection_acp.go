@@ -12,10 +12,16 @@ package db
 
 import (
 	"context"
+	"slices"
+
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/acp/identity"
 	acpTypes "github.com/sourcenetwork/defradb/acp/types"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/db/permission"
+	"github.com/sourcenetwork/defradb/internal/keys"
 )
 
 // registerDocWithACP handles the registration of the document with acp.
@@ -46,6 +52,55 @@ func (c *collection) registerDocWithACP(
 	)
 }
 
+// registerCollectionWithACP registers the collection with acp, if the policy of the collection
+// declares a collection scoped permission.
+//
+// The requesting identity becomes the owner of the collection object, if there is no requesting
+// identity then the node identity is used instead.
+func (c *collection) registerCollectionWithACP(ctx context.Context) error {
+	if !c.db.documentACP.HasValue() {
+		return nil
+	}
+	ident := identity.FromContext(ctx)
+	if !ident.HasValue() {
+		ident = c.db.nodeIdentity
+	}
+	return permission.RegisterCollectionWithDocumentACP(
+		ctx,
+		ident,
+		c.db.documentACP.Value(),
+		c,
+	)
+}
+
+// checkCreateAccessWithACP returns an error if the identity does not have the collection scoped
+// create permission, when one is declared on the collection policy.
+func (c *collection) checkCreateAccessWithACP(ctx context.Context) error {
+	// If document acp is not available, then we have unrestricted access.
+	if !c.db.documentACP.HasValue() {
+		return nil
+	}
+	ident := identity.FromContext(ctx)
+	if ident.HasValue() && c.db.nodeIdentity.HasValue() && ident.Value().DID() == c.db.nodeIdentity.Value().DID() {
+		return nil
+	}
+	canCreate, err := permission.CheckCollectionAccessWithACP(
+		ctx,
+		ident,
+		c.db.documentACP.Value(),
+		c,
+		acpTypes.CollectionCreatePerm,
+		permission.OperationCreate,
+	)
+	if err != nil {
+		return err
+	}
+	if !canCreate {
+		return NewErrCollectionCreateNotAuthorized(c.Name())
+	}
+	return nil
+}
+
 func (c *collection) checkAccessOfDocWithACP(
 	ctx context.Context,
 	resourcePermission acpTypes.ResourceInterfacePermission,
@@ -65,6 +120,317 @@ func (c *collection) checkAccessOfDocWithACP(
 		c.db.documentACP.Value(),
 		c,
 		resourcePermission,
+		permission.OperationFromContext(ctx),
 		docID,
 	)
 }
+
+// checkViewRefreshAccessWithACP returns true if the identity may refresh the cache of the given view.
+//
+// The cache of a permissioned view may only be refreshed by identities that have the update
+// permission on the view, as the view is registered with acp as an object.
+func (db *DB) checkViewRefreshAccessWithACP(
+	ctx context.Context,
+	view client.CollectionDefinition,
+) (bool, error) {
+	col, err := db.newCollection(view.Version, view.Schema)
+	if err != nil {
+		return false, err
+	}
+	return col.checkAccessOfDocWithACP(ctx, acpTypes.DocumentUpdatePerm, view.Version.CollectionID)
+}
+
+// checkFieldAccessOfDocWithACP returns an error if the identity attempts to update fields
+// of the document that it does not have the field scoped update permission of.
+//
+// Only the dirty fields of the document are checked.
+func (c *collection) checkFieldAccessOfDocWithACP(
+	ctx context.Context,
+	doc *client.Document,
+) error {
+	// If document acp is not available, then we have unrestricted access.
+	if !c.db.documentACP.HasValue() {
+		return nil
+	}
+	ident := identity.FromContext(ctx)
+	if ident.HasValue() && c.db.nodeIdentity.HasValue() && ident.Value().DID() == c.db.nodeIdentity.Value().DID() {
+		return nil
+	}
+	fieldPermissions, err := permission.FieldPermissionsOnCollectionWithACP(ctx, c.db.documentACP.Value(), c)
+	if err != nil {
+		return err
+	}
+	deniedFields, err := permission.DeniedFieldsOfDocOnCollectionWithACP(
+		ctx,
+		ident,
+		c.db.documentACP.Value(),
+		c,
+		acpTypes.DocumentUpdatePerm,
+		permission.OperationUpdate,
+		fieldPermissions,
+		doc.ID().String(),
+	)
+	if err != nil {
+		return err
+	}
+	for fieldName, value := range doc.Values() {
+		if value.IsDirty() && permission.IsFieldDenied(deniedFields, fieldName.Name()) {
+			return NewErrFieldUpdateNotAuthorized(doc.ID().String(), fieldName.Name())
+		}
+	}
+	return nil
+}
+
+// fieldsWithPermissions returns the names of the fields restricted by a field scoped permission
+// on the collection policy.
+func (c *collection) fieldsWithPermissions(ctx context.Context) (map[string]struct{}, error) {
+	if !c.db.documentACP.HasValue() {
+		return nil, nil
+	}
+	fieldPermissions, err := permission.FieldPermissionsOnCollectionWithACP(ctx, c.db.documentACP.Value(), c)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]struct{}, len(fieldPermissions))
+	for _, fieldPermission := range fieldPermissions {
+		fields[fieldPermission.FieldName] = struct{}{}
+	}
+	return fields, nil
+}
+
+// hasFieldRelationsWithACP returns true if the collection policy derives relations from fields,
+// and the given document (if not nil) has a dirty field that a relation is derived from.
+func (c *collection) hasFieldRelationsWithACP(doc *client.Document) bool {
+	if !c.db.documentACP.HasValue() {
+		return false
+	}
+	policy := c.Version().Policy
+	if !policy.HasValue() || len(policy.Value().FieldRelations) == 0 {
+		return false
+	}
+	if doc == nil {
+		return true
+	}
+	for field, value := range doc.Values() {
+		if !value.IsDirty() {
+			continue
+		}
+		for _, fieldRelation := range policy.Value().FieldRelations {
+			if fieldRelation.FieldName == field.Name() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getFieldRelationsDoc returns the stored document with only the fields that relations are
+// derived from.
+func (c *collection) getFieldRelationsDoc(
+	ctx context.Context,
+	primaryKey keys.PrimaryDataStoreKey,
+) (*client.Document, error) {
+	definition := c.Definition()
+	fields := []client.FieldDefinition{}
+	for _, fieldRelation := range c.Version().Policy.Value().FieldRelations {
+		if field, ok := definition.GetFieldByName(fieldRelation.FieldName); ok {
+			fields = append(fields, field)
+		}
+	}
+	return c.get(ctx, primaryKey, fields, false)
+}
+
+// syncFieldRelationshipsWithACP keeps the relationships of the relations derived from fields in
+// sync with the actors held in those fields.
+//
+// The relationships of the actors only held by the old document are deleted, and relationships with
+// the actors only held by the new document are added. The old document is nil if the document was
+// just created, and the new document is nil if the document was deleted.
+//
+// The relationships are managed with the authority of the document owner, as the fields may be
+// changed by any identity allowed to update the document, or by a peer.
+//
+// Public documents (not registered with acp) have no relationships.
+func (c *collection) syncFieldRelationshipsWithACP(
+	ctx context.Context,
+	docID string,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	policyID, resourceName, hasPolicy := permission.IsPermissioned(c)
+	if !hasPolicy {
+		return nil
+	}
+	documentACP := c.db.documentACP.Value()
+
+	owner, err := documentACP.DocOwner(ctx, policyID, resourceName, docID)
+	if err != nil || !owner.HasValue() {
+		return err
+	}
+
+	// The requesting identity is used when it is the owner, as some acp systems require
+	// its bearer token.
+	requestActor := identity.FromDID(owner.Value())
+	if ident := identity.FromContext(ctx); ident.HasValue() && ident.Value().DID() == owner.Value() {
+		requestActor = ident.Value()
+	}
+
+	for _, fieldRelation := range c.Version().Policy.Value().FieldRelations {
+		if newDoc != nil {
+			_, err := newDoc.GetValue(fieldRelation.FieldName)
+			if err != nil {
+				// The field is not part of the document, so the relationships are still in sync.
+				continue
+			}
+		}
+
+		oldActors := permission.FieldRelationActors(oldDoc, fieldRelation.FieldName)
+		newActors := permission.FieldRelationActors(newDoc, fieldRelation.FieldName)
+
+		for _, actor := range oldActors {
+			if slices.Contains(newActors, actor) {
+				continue
+			}
+			_, err := documentACP.DeleteDocActorRelationship(
+				ctx,
+				policyID,
+				resourceName,
+				docID,
+				fieldRelation.Relation,
+				requestActor,
+				actor,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, actor := range newActors {
+			if slices.Contains(oldActors, actor) {
+				continue
+			}
+			_, err := documentACP.AddDocActorRelationship(
+				ctx,
+				policyID,
+				resourceName,
+				docID,
+				fieldRelation.Relation,
+				requestActor,
+				actor,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// syncMergedFieldRelationshipsWithACP keeps the relationships of the relations derived from fields in
+// sync with a document merged from a peer.
+//
+// Merges have no requesting identity, so the document is read with the identity of its owner, before
+// the merge from the committed state, and after the merge from the transaction of the context.
+func (c *collection) syncMergedFieldRelationshipsWithACP(ctx context.Context, docID client.DocID) error {
+	if !c.hasFieldRelationsWithACP(nil) {
+		return nil
+	}
+	policyID, resourceName, hasPolicy := permission.IsPermissioned(c)
+	if !hasPolicy {
+		return nil
+	}
+	owner, err := c.db.documentACP.Value().DocOwner(ctx, policyID, resourceName, docID.String())
+	if err != nil || !owner.HasValue() {
+		return err
+	}
+	ctx = identity.WithContext(ctx, immutable.Some(identity.FromDID(owner.Value())))
+
+	primaryKey, err := c.getPrimaryKeyFromDocID(ctx, docID)
+	if err != nil {
+		return err
+	}
+
+	oldCtx, oldTxn, err := ensureContextTxn(InitContext(ctx, nil), c.db, true)
+	if err != nil {
+		return err
+	}
+	defer oldTxn.Discard(oldCtx)
+
+	oldDoc, err := c.getFieldRelationsDoc(oldCtx, primaryKey)
+	if err != nil {
+		return err
+	}
+	newDoc, err := c.getFieldRelationsDoc(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+	err = c.syncFieldRelationshipsWithACP(ctx, docID.String(), oldDoc, newDoc)
+	if errors.Is(err, identity.ErrMustBeTokenIdentity) {
+		// Acp systems that require the bearer token of the owner are shared between nodes, so the
+		// relationships were already synced by the node that changed the document.
+		return nil
+	}
+	return err
+}
+
+// docIDsOfRelationshipBatch returns the unique docIDs of the batch, the given docIDs followed by the
+// docIDs of the documents matching the filter of the batch.
+func (c *collection) docIDsOfRelationshipBatch(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) ([]string, error) {
+	if len(batch.DocIDs) == 0 && batch.Filter == nil {
+		return nil, ErrRelationshipBatchHasNoDocuments
+	}
+
+	docIDs := make([]string, 0, len(batch.DocIDs))
+	seen := make(map[string]struct{}, len(batch.DocIDs))
+	for _, docID := range batch.DocIDs {
+		if docID == "" {
+			return nil, ErrRelationshipBatchHasEmptyDocID
+		}
+		if _, ok := seen[docID]; !ok {
+			seen[docID] = struct{}{}
+			docIDs = append(docIDs, docID)
+		}
+	}
+
+	if batch.Filter == nil {
+		return docIDs, nil
+	}
+
+	selectionPlan, err := c.makeSelectionPlan(ctx, batch.Filter)
+	if err != nil {
+		return nil, err
+	}
+	err = selectionPlan.Init()
+	if err != nil {
+		return nil, err
+	}
+	if err := selectionPlan.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := selectionPlan.Close(); err != nil {
+			log.ErrorContextE(ctx, "Failed to close the request plan, after relationship batch", err)
+		}
+	}()
+
+	for {
+		next, err := selectionPlan.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !next {
+			break
+		}
+		doc := selectionPlan.Value()
+		docID := doc.GetID()
+		if _, ok := seen[docID]; !ok {
+			seen[docID] = struct{}{}
+			docIDs = append(docIDs, docID)
+		}
+	}
+
+	return docIDs, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	badgerds "github.com/dgraph-io/badger/v4"
+	"github.com/sourcenetwork/corekv/badger"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/acp/identity"
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
+	"github.com/sourcenetwork/defradb/crypto"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
+)
+
+const testPolicyWithCreatePermission = `
+name: Test Policy
+
+actor:
+  name: actor
+
+resources:
+  invoices:
+    permissions:
+      read:
+        expr: owner
+      update:
+        expr: owner
+      delete:
+        expr: owner
+      create:
+        expr: owner
+
+    relations:
+      owner:
+        types:
+          - actor
+`
+
+func newTestDBWithDocumentACP(ctx context.Context, t *testing.T) *DB {
+	rootstore, err := badger.NewDatastore("", badgerds.DefaultOptions("").WithInMemory(true))
+	require.NoError(t, err)
+
+	adminInfo, err := NewNACInfo(ctx, "", false)
+	require.NoError(t, err)
+
+	documentACP, err := dac.NewLocalDocumentACP("")
+	require.NoError(t, err)
+
+	db, err := newDB(ctx, rootstore, adminInfo, immutable.Some(documentACP), nil)
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	return db
+}
+
+func TestCheckCollectionAccessWithACP_WithUnregisteredCollection_ShouldDeny(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDBWithDocumentACP(ctx, t)
+
+	owner, err := identity.Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	ctx = identity.WithContext(ctx, immutable.Some[identity.Identity](owner))
+
+	policy, err := db.AddDACPolicy(ctx, testPolicyWithCreatePermission)
+	require.NoError(t, err)
+	_, err = db.AddSchema(ctx, `type Invoices @policy(id: "`+policy.PolicyID+`", resource: "invoices") {
+		number: Int
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "Invoices")
+	require.NoError(t, err)
+
+	hasAccess, err := permission.CheckCollectionAccessWithACP(
+		ctx,
+		immutable.Some[identity.Identity](owner),
+		db.documentACP.Value(),
+		col,
+		acpTypes.CollectionCreatePerm,
+		permission.OperationCreate,
+	)
+	require.NoError(t, err)
+	require.True(t, hasAccess)
+
+	// A collection that was never registered has no owner that could be granted the permission.
+	version := col.Version()
+	version.CollectionID = "unregistered"
+	unregistered, err := db.newCollection(version, col.Schema())
+	require.NoError(t, err)
+
+	hasAccess, err = permission.CheckCollectionAccessWithACP(
+		ctx,
+		immutable.Some[identity.Identity](owner),
+		db.documentACP.Value(),
+		unregistered,
+		acpTypes.CollectionCreatePerm,
+		permission.OperationCreate,
+	)
+	require.NoError(t, err)
+	require.False(t, hasAccess)
+}
@@ -0,0 +1,404 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/expr"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+// computedExpressionType returns the expression type matching the given field kind, and false if
+// fields of this kind may neither be computed nor referenced by a computed field expression.
+func computedExpressionType(kind client.FieldKind) (expr.Type, bool) {
+	switch kind {
+	case client.FieldKind_NILLABLE_INT:
+		return expr.TypeInt, true
+	case client.FieldKind_NILLABLE_FLOAT64, client.FieldKind_NILLABLE_FLOAT32:
+		return expr.TypeFloat, true
+	case client.FieldKind_NILLABLE_STRING:
+		return expr.TypeString, true
+	case client.FieldKind_NILLABLE_BOOL:
+		return expr.TypeBool, true
+	default:
+		return 0, false
+	}
+}
+
+// checkComputedField returns the errors caused by the computed field description of the given field.
+//
+// The permissioned fields are the fields of the collection that have field permissions, computed
+// fields may not reference them, nor encrypted fields, as their values would otherwise be exposed.
+func checkComputedField(
+	definition client.CollectionDefinition,
+	localField client.CollectionFieldDescription,
+	permissionedFields map[string]struct{},
+) []error {
+	collectionName := definition.GetName()
+	computed := localField.Computed.Value()
+
+	field, ok := definition.GetFieldByName(localField.Name)
+	if !ok {
+		return nil
+	}
+	fieldType, ok := computedExpressionType(field.Kind)
+	if !ok || field.IsRelation() {
+		return []error{NewErrComputedFieldKindNotSupported(collectionName, field.Name, field.Kind)}
+	}
+	if localField.DefaultValue != nil {
+		return []error{NewErrComputedFieldWithDefaultValue(collectionName, field.Name)}
+	}
+
+	expression, err := expr.Parse(computed.Expression)
+	if err != nil {
+		return []error{NewErrInvalidComputedExpression(collectionName, field.Name, computed.Expression, err)}
+	}
+
+	var errs []error
+	fieldTypes := map[string]expr.Type{}
+	for _, name := range expression.Fields() {
+		referencedField, ok := definition.GetFieldByName(name)
+		if !ok || referencedField.IsComputed || referencedField.IsRelation() {
+			errs = append(errs, NewErrInvalidComputedFieldReference(collectionName, field.Name, name))
+			continue
+		}
+		referencedType, ok := computedExpressionType(referencedField.Kind)
+		if !ok {
+			errs = append(errs, NewErrInvalidComputedFieldReference(collectionName, field.Name, name))
+			continue
+		}
+		if _, isPermissioned := permissionedFields[name]; isPermissioned || referencedField.IsEncrypted {
+			errs = append(errs, NewErrRestrictedComputedFieldReference(collectionName, field.Name, name))
+			continue
+		}
+		fieldTypes[name] = referencedType
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	expressionType, err := expression.Type(fieldTypes)
+	if err != nil {
+		return []error{NewErrInvalidComputedExpression(collectionName, field.Name, computed.Expression, err)}
+	}
+	// Integer values may be held by float fields, all other types must match exactly.
+	if expressionType != fieldType && !(fieldType == expr.TypeFloat && expressionType == expr.TypeInt) {
+		return []error{
+			NewErrComputedExpressionKindMismatch(collectionName, field.Name, expressionType.String(), field.Kind),
+		}
+	}
+
+	return nil
+}
+
+// checkIndexedFieldsStored returns an error if any of the given fields is a computed field that is
+// not stored, as there would be no value to index.
+func checkIndexedFieldsStored(
+	colVersion client.CollectionVersion,
+	fields []client.IndexedFieldDescription,
+) error {
+	for _, indexedField := range fields {
+		field, ok := colVersion.GetFieldByName(indexedField.Name)
+		if ok && field.Computed.HasValue() && !field.Computed.Value().IsStored {
+			return NewErrCanNotIndexNotStoredComputedField(colVersion.Name, indexedField.Name)
+		}
+	}
+	return nil
+}
+
+// parseComputedExpressions parses the expressions of the computed fields of the given definition, by
+// field name.
+func parseComputedExpressions(def client.CollectionDefinition) map[string]*expr.Expression {
+	expressions := map[string]*expr.Expression{}
+	for _, field := range def.Version.Fields {
+		if !field.Computed.HasValue() {
+			continue
+		}
+
+		// The expression is validated when the collection is defined.
+		expression, err := expr.Parse(field.Computed.Value().Expression)
+		if err != nil {
+			continue
+		}
+		expressions[field.Name] = expression
+	}
+	return expressions
+}
+
+// getComputedExpression returns the given expression of the computed field with the given name, of
+// the collection version with the given ID, parsed.
+//
+// The expression is only parsed if it has not been parsed when the definitions were loaded.
+func (db *DB) getComputedExpression(versionID string, fieldName string, source string) (*expr.Expression, error) {
+	if entry, ok := db.getDefinitionCacheEntry(versionID); ok {
+		// The expression of a field may be patched without changing the ID of the collection version,
+		// so the parsed expression is only used if it still matches.
+		if expression, ok := entry.computedExpressions[fieldName]; ok && expression.String() == source {
+			return expression, nil
+		}
+	}
+	return expr.Parse(source)
+}
+
+// ComputedExpression returns the parsed expression of the given computed field of this collection.
+//
+// It allows fetchers to reuse the expressions parsed when the definitions are loaded.
+func (c *collection) ComputedExpression(field client.CollectionFieldDescription) (*expr.Expression, error) {
+	return c.db.getComputedExpression(c.Version().VersionID, field.Name, field.Computed.Value().Expression)
+}
+
+// setComputedFields sets the values of the stored computed fields of the document.
+//
+// On create every stored computed field is set, on update only those referencing a dirty field
+// are recomputed. Computed fields may not be set directly.
+//
+// The values computed by a previous call are not saved until the transaction is committed, and
+// thus remain dirty if the document is written again before, or if the previous call failed. A
+// dirty value of a stored computed field is only rejected if it matches neither the value computed
+// now, nor the stored value, so that such values are not mistaken for values set by the caller.
+func (c *collection) setComputedFields(ctx context.Context, doc *client.Document, isCreate bool) error {
+	computedFields := []client.CollectionFieldDescription{}
+	dirtyValues := map[string]client.NormalValue{}
+	for _, field := range c.Version().Fields {
+		if !field.Computed.HasValue() {
+			continue
+		}
+		value, err := doc.TryGetValue(field.Name)
+		if err != nil {
+			return err
+		}
+		if value != nil && value.IsDirty() {
+			if !field.Computed.Value().IsStored {
+				return NewErrCanNotSetComputedField(field.Name)
+			}
+			dirtyValues[field.Name] = value.NormalValue()
+		}
+		if field.Computed.Value().IsStored {
+			computedFields = append(computedFields, field)
+		}
+	}
+
+	var oldDoc *client.Document
+	oldDocFetched := false
+	getOldDoc := func() (*client.Document, error) {
+		if isCreate || oldDocFetched {
+			return oldDoc, nil
+		}
+		var err error
+		oldDoc, err = c.get(
+			ctx,
+			keys.DataStoreKeyFromDocID(doc.ID()).ToPrimaryDataStoreKey(),
+			nil,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		oldDocFetched = true
+		return oldDoc, nil
+	}
+
+	for _, field := range computedFields {
+		expression, err := c.ComputedExpression(field)
+		if err != nil {
+			return NewErrInvalidComputedExpression(c.Name(), field.Name, field.Computed.Value().Expression, err)
+		}
+
+		dirtyValue, isDirty := dirtyValues[field.Name]
+		needsComputation := isCreate || isDirty
+		values := make(map[string]any, len(expression.Fields()))
+		missingFields := []string{}
+		for _, name := range expression.Fields() {
+			value, err := doc.TryGetValue(name)
+			if err != nil {
+				return err
+			}
+			if value == nil {
+				missingFields = append(missingFields, name)
+				continue
+			}
+			needsComputation = needsComputation || value.IsDirty()
+			values[name] = value.NormalValue().Unwrap()
+		}
+
+		if !needsComputation {
+			continue
+		}
+
+		// When updating a document without some of the referenced fields, their previous values
+		// are used to compute the field.
+		if len(missingFields) > 0 {
+			oldDoc, err := getOldDoc()
+			if err != nil {
+				return err
+			}
+			for _, missingField := range missingFields {
+				if oldDoc == nil {
+					break
+				}
+				value, err := oldDoc.TryGetValue(missingField)
+				if err != nil {
+					return err
+				}
+				if value != nil {
+					values[missingField] = value.NormalValue().Unwrap()
+				}
+			}
+		}
+
+		value, err := expression.Eval(values)
+		if err != nil {
+			return NewErrFailedToComputeField(doc.ID().String(), field.Name, err)
+		}
+		if isCreate && value == nil && !isDirty {
+			continue
+		}
+		err = doc.Set(field.Name, value)
+		if err != nil {
+			return NewErrFailedToComputeField(doc.ID().String(), field.Name, err)
+		}
+
+		if isDirty {
+			isComputed, err := c.isComputedValue(doc, field.Name, dirtyValue, getOldDoc)
+			if err != nil {
+				return err
+			}
+			if !isComputed {
+				return NewErrCanNotSetComputedField(field.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isComputedValue returns true if the given dirty value of the computed field with the given name
+// matches either the value that has just been computed for the document, or the stored value.
+func (c *collection) isComputedValue(
+	doc *client.Document,
+	fieldName string,
+	dirtyValue client.NormalValue,
+	getOldDoc func() (*client.Document, error),
+) (bool, error) {
+	computed, err := doc.TryGetValue(fieldName)
+	if err != nil {
+		return false, err
+	}
+	if computed != nil && computed.NormalValue().Equal(dirtyValue) {
+		return true, nil
+	}
+
+	oldDoc, err := getOldDoc()
+	if err != nil || oldDoc == nil {
+		return false, err
+	}
+	stored, err := oldDoc.TryGetValue(fieldName)
+	if err != nil {
+		return false, err
+	}
+	return stored != nil && stored.NormalValue().Equal(dirtyValue), nil
+}
+
+// getRecomputedFields returns the stored computed fields of the new collection version that were
+// not stored computed fields of the old collection version, or whose expression has changed.
+func getRecomputedFields(
+	oldCol client.CollectionVersion,
+	newCol client.CollectionVersion,
+) []client.CollectionFieldDescription {
+	fields := []client.CollectionFieldDescription{}
+	for _, field := range newCol.Fields {
+		if !field.Computed.HasValue() || !field.Computed.Value().IsStored {
+			continue
+		}
+		oldField, ok := oldCol.GetFieldByName(field.Name)
+		if ok && oldField.Computed.HasValue() && oldField.Computed.Value().IsStored &&
+			oldField.Computed.Value().Expression == field.Computed.Value().Expression {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// backfillComputedFields computes, and stores, the values of the given stored computed fields of
+// all the existing documents of the collection.
+//
+// The values of stored computed fields are otherwise only set when the fields they reference are
+// written, existing documents must thus be backfilled when stored computed fields are added to
+// a collection version by a patch.
+func (c *collection) backfillComputedFields(
+	ctx context.Context,
+	fields []client.CollectionFieldDescription,
+) error {
+	// All the documents are backfilled, regardless of the permissions of the patching identity.
+	// They are written once they have all been read, so as not to write within the iterated range.
+	docs := []*client.Document{}
+	err := c.iterateAllDocs(ctx, immutable.None[dac.DocumentACP](), nil, func(doc *client.Document) error {
+		docs = append(docs, doc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		hasComputedValue := false
+		for _, field := range fields {
+			expression, err := c.ComputedExpression(field)
+			if err != nil {
+				return NewErrInvalidComputedExpression(c.Name(), field.Name, field.Computed.Value().Expression, err)
+			}
+
+			values := make(map[string]any, len(expression.Fields()))
+			for _, name := range expression.Fields() {
+				value, err := doc.TryGetValue(name)
+				if err != nil {
+					return err
+				}
+				if value != nil {
+					values[name] = value.NormalValue().Unwrap()
+				}
+			}
+
+			value, err := expression.Eval(values)
+			if err != nil {
+				return NewErrFailedToComputeField(doc.ID().String(), field.Name, err)
+			}
+			existingValue, err := doc.TryGetValue(field.Name)
+			if err != nil {
+				return err
+			}
+			if value == nil && existingValue == nil {
+				continue
+			}
+			err = doc.Set(field.Name, value)
+			if err != nil {
+				return NewErrFailedToComputeField(doc.ID().String(), field.Name, err)
+			}
+			hasComputedValue = true
+		}
+
+		if !hasComputedValue {
+			continue
+		}
+		err = c.save(ctx, doc, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestBackfillComputedFields_SetsValuesOfExistingDocs(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newBadgerDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type User {
+			first: String
+			last: String
+			fullName: String
+		}
+	`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"first": "John", "last": "Doe"}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	fullName := client.CollectionFieldDescription{
+		Name: "fullName",
+		Computed: immutable.Some(client.ComputedFieldDescription{
+			Expression: "concat(first, ' ', last)",
+			IsStored:   true,
+		}),
+	}
+
+	txnCtx, txn, err := ensureContextTxn(ctx, db, false)
+	require.NoError(t, err)
+	err = col.(*collection).backfillComputedFields(txnCtx, []client.CollectionFieldDescription{fullName})
+	require.NoError(t, err)
+	err = txn.Commit(txnCtx)
+	require.NoError(t, err)
+
+	doc, err = col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+	value, err := doc.Get("fullName")
+	require.NoError(t, err)
+	require.Equal(t, "John Doe", value)
+}
+
+func TestGetRecomputedFields_ReturnsAddedAndChangedStoredComputedFields(t *testing.T) {
+	computed := func(expression string, isStored bool) immutable.Option[client.ComputedFieldDescription] {
+		return immutable.Some(client.ComputedFieldDescription{Expression: expression, IsStored: isStored})
+	}
+
+	oldCol := client.CollectionVersion{
+		Fields: []client.CollectionFieldDescription{
+			{Name: "unchanged", Computed: computed("a + b", true)},
+			{Name: "changed", Computed: computed("a + b", true)},
+			{Name: "added"},
+			{Name: "notStored"},
+		},
+	}
+	newCol := client.CollectionVersion{
+		Fields: []client.CollectionFieldDescription{
+			{Name: "unchanged", Computed: computed("a + b", true)},
+			{Name: "changed", Computed: computed("a * b", true)},
+			{Name: "added", Computed: computed("a + b", true)},
+			{Name: "notStored", Computed: computed("a + b", false)},
+		},
+	}
+
+	fields := getRecomputedFields(oldCol, newCol)
+
+	require.Equal(t, []client.CollectionFieldDescription{newCol.Fields[1], newCol.Fields[2]}, fields)
+}
+
+func newComputedTestCollection(t *testing.T, ctx context.Context) (*DB, client.Collection) {
+	db, err := newBadgerDB(ctx)
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	_, err = db.AddSchema(ctx, `
+		type User {
+			first: String
+			last: String
+			fullName: String @computed(expr: "concat(first, ' ', last)")
+		}
+	`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	return db, col
+}
+
+func TestCollectionCreate_WithComputedFieldThenUpdateWithinTxn_ShouldRecomputeField(t *testing.T) {
+	ctx := context.Background()
+	db, col := newComputedTestCollection(t, ctx)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"first": "John", "last": "Doe"}`), col.Definition())
+	require.NoError(t, err)
+
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	txnCtx := InitContext(ctx, txn)
+
+	err = col.Create(txnCtx, doc)
+	require.NoError(t, err)
+
+	err = doc.Set("first", "Jane")
+	require.NoError(t, err)
+	err = col.Update(txnCtx, doc)
+	require.NoError(t, err)
+
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	doc, err = col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+	value, err := doc.Get("fullName")
+	require.NoError(t, err)
+	require.Equal(t, "Jane Doe", value)
+}
+
+func TestCollectionCreate_WithComputedFieldAfterDiscardedCreate_ShouldSucceed(t *testing.T) {
+	ctx := context.Background()
+	db, col := newComputedTestCollection(t, ctx)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"first": "John", "last": "Doe"}`), col.Definition())
+	require.NoError(t, err)
+
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = col.Create(InitContext(ctx, txn), doc)
+	require.NoError(t, err)
+	txn.Discard(ctx)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	doc, err = col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+	value, err := doc.Get("fullName")
+	require.NoError(t, err)
+	require.Equal(t, "John Doe", value)
+}
+
+func TestCollectionCreate_WithComputedFieldSetByCaller_ShouldError(t *testing.T) {
+	ctx := context.Background()
+	_, col := newComputedTestCollection(t, ctx)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"first": "John", "last": "Doe"}`), col.Definition())
+	require.NoError(t, err)
+	err = doc.Set("fullName", "Jane Doe")
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.ErrorIs(t, err, ErrCanNotSetComputedField)
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// The names of the field constraints, as declared in the @constraints directive.
+const (
+	constraintsName         = "constraints"
+	constraintMinName       = "min"
+	constraintMaxName       = "max"
+	constraintMinLengthName = "minLength"
+	constraintMaxLengthName = "maxLength"
+	constraintPatternName   = "pattern"
+	constraintOneOfName     = "oneOf"
+	constraintNotNullName   = "notNull"
+)
+
+// validateFieldConstraints returns an error listing every field constraint violated by the values
+// of the given document.
+func (c *collection) validateFieldConstraints(doc *client.Document) error {
+	docID := doc.ID().String()
+
+	var errs []error
+	for _, field := range c.Version().Fields {
+		if field.Constraints.IsEmpty() {
+			continue
+		}
+
+		fieldValue, err := doc.TryGetValue(field.Name)
+		if err != nil {
+			return err
+		}
+
+		if fieldValue == nil || fieldValue.NormalValue().IsNil() {
+			if field.Constraints.NotNull {
+				errs = append(errs, NewErrFieldConstraintViolated(docID, field.Name, constraintNotNullName, nil))
+			}
+			continue
+		}
+
+		fieldDef, ok := c.Definition().GetFieldByName(field.Name)
+		if !ok {
+			continue
+		}
+
+		var pattern *regexp.Regexp
+		if field.Constraints.Pattern.HasValue() {
+			pattern, err = c.db.getFieldPattern(c.Version().VersionID, field.Name, field.Constraints.Pattern.Value())
+			if err != nil {
+				return err
+			}
+		}
+
+		errs = append(
+			errs,
+			checkFieldValueConstraints(docID, fieldDef, field.Constraints, pattern, fieldValue.NormalValue())...,
+		)
+	}
+
+	return errors.Join(errs...)
+}
+
+// compileFieldPatterns compiles the pattern constraints of the fields of the given definition, by
+// field name.
+func compileFieldPatterns(def client.CollectionDefinition) map[string]*regexp.Regexp {
+	patterns := map[string]*regexp.Regexp{}
+	for _, field := range def.Version.Fields {
+		if !field.Constraints.Pattern.HasValue() {
+			continue
+		}
+
+		// The pattern is validated when the collection is defined.
+		pattern, err := regexp.Compile(field.Constraints.Pattern.Value())
+		if err != nil {
+			continue
+		}
+		patterns[field.Name] = pattern
+	}
+	return patterns
+}
+
+// getFieldPattern returns the given pattern constraint of the field with the given name, of the
+// collection version with the given ID, compiled.
+//
+// The pattern is only compiled if the collection version is not active, as the patterns of the
+// active collection versions are compiled when their definitions are loaded.
+func (db *DB) getFieldPattern(versionID string, fieldName string, pattern string) (*regexp.Regexp, error) {
+	if entry, ok := db.getDefinitionCacheEntry(versionID); ok {
+		// The pattern of a field may be patched without changing the ID of the collection version,
+		// so the compiled pattern is only used if it still matches.
+		if compiled, ok := entry.fieldPatterns[fieldName]; ok && compiled.String() == pattern {
+			return compiled, nil
+		}
+	}
+	return regexp.Compile(pattern)
+}
+
+// checkFieldValueConstraints returns an error for each constraint of the given field violated by
+// the given, non-nil, value.
+//
+// The pattern is the compiled pattern constraint of the field, if any.
+func checkFieldValueConstraints(
+	docID string,
+	field client.FieldDefinition,
+	constraints client.FieldConstraints,
+	pattern *regexp.Regexp,
+	value client.NormalValue,
+) []error {
+	var errs []error
+	violated := func(constraint string, value any) {
+		errs = append(errs, NewErrFieldConstraintViolated(docID, field.Name, constraint, value))
+	}
+
+	if number, ok := normalValueAsFloat(value); ok {
+		if constraints.Min.HasValue() && number < constraints.Min.Value() {
+			violated(constraintMinName, number)
+		}
+		if constraints.Max.HasValue() && number > constraints.Max.Value() {
+			violated(constraintMaxName, number)
+		}
+	}
+
+	if str, ok := normalValueAsString(value); ok {
+		length := utf8.RuneCountInString(str)
+		if constraints.MinLength.HasValue() && length < constraints.MinLength.Value() {
+			violated(constraintMinLengthName, str)
+		}
+		if constraints.MaxLength.HasValue() && length > constraints.MaxLength.Value() {
+			violated(constraintMaxLengthName, str)
+		}
+		if pattern != nil && !pattern.MatchString(str) {
+			violated(constraintPatternName, str)
+		}
+	}
+
+	if len(constraints.OneOf) > 0 {
+		var fieldValue any
+		if str, ok := normalValueAsString(value); ok {
+			fieldValue = str
+		} else if i, ok := normalValueAsInt(value); ok {
+			fieldValue = i
+		}
+
+		isAllowed := slices.ContainsFunc(constraints.OneOf, func(allowed any) bool {
+			allowedValue, ok := constraintValueFromAny(field.Kind, allowed)
+			return ok && allowedValue == fieldValue
+		})
+		if !isAllowed {
+			violated(constraintOneOfName, fieldValue)
+		}
+	}
+
+	return errs
+}
+
+// constraintValueFromAny returns the given constraint value as a value comparable to the values of
+// fields of the given kind.
+//
+// Integer values may have been decoded from json as floats, and are converted back to integers.
+func constraintValueFromAny(kind client.FieldKind, value any) (any, bool) {
+	switch kind {
+	case client.FieldKind_NILLABLE_STRING:
+		str, ok := value.(string)
+		return str, ok
+
+	case client.FieldKind_NILLABLE_INT:
+		switch typedValue := value.(type) {
+		case int64:
+			return typedValue, true
+		case int:
+			return int64(typedValue), true
+		case float64:
+			if typedValue != math.Trunc(typedValue) {
+				return nil, false
+			}
+			return int64(typedValue), true
+		}
+	}
+
+	return nil, false
+}
+
+func normalValueAsFloat(value client.NormalValue) (float64, bool) {
+	if i, ok := normalValueAsInt(value); ok {
+		return float64(i), true
+	}
+	if f, ok := value.Float64(); ok {
+		return f, true
+	}
+	if f, ok := value.NillableFloat64(); ok && f.HasValue() {
+		return f.Value(), true
+	}
+	if f, ok := value.Float32(); ok {
+		return float64(f), true
+	}
+	if f, ok := value.NillableFloat32(); ok && f.HasValue() {
+		return float64(f.Value()), true
+	}
+	return 0, false
+}
+
+func normalValueAsInt(value client.NormalValue) (int64, bool) {
+	if i, ok := value.Int(); ok {
+		return i, true
+	}
+	if i, ok := value.NillableInt(); ok && i.HasValue() {
+		return i.Value(), true
+	}
+	return 0, false
+}
+
+func normalValueAsString(value client.NormalValue) (string, bool) {
+	if str, ok := value.String(); ok {
+		return str, true
+	}
+	if str, ok := value.NillableString(); ok && str.HasValue() {
+		return str.Value(), true
+	}
+	return "", false
+}
@@ -83,7 +83,7 @@ func (db *DB) createCollections(
 
 		def.Definition.Version.Indexes = make([]client.IndexDescription, 0, len(def.CreateIndexes))
 		for _, createIndex := range def.CreateIndexes {
-			desc, err := processCreateIndexRequest(ctx, def.Definition, createIndex)
+			desc, err := processCreateIndexRequest(ctx, db.blindIndexSecret, def.Definition, createIndex)
 			if err != nil {
 				return nil, err
 			}
@@ -106,6 +106,11 @@ func (db *DB) createCollections(
 			}
 		}
 
+		err = col.registerCollectionWithACP(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		result, err := db.getCollectionByID(ctx, def.Definition.Version.VersionID)
 		if err != nil {
 			return nil, err
@@ -268,7 +273,35 @@ func (db *DB) patchCollection(
 		}
 	}
 
-	return db.loadSchema(ctx)
+	err = db.loadSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The existing documents must hold the values of the stored computed fields added by the patch,
+	// documents are read through the active collection versions.
+	for _, col := range newColsByID {
+		existingCol, ok := existingColsByID[col.VersionID]
+		if !ok || !col.IsActive {
+			continue
+		}
+
+		recomputedFields := getRecomputedFields(existingCol, col)
+		if len(recomputedFields) == 0 {
+			continue
+		}
+
+		c, err := db.getCollectionByID(ctx, col.VersionID)
+		if err != nil {
+			return err
+		}
+		err = c.(*collection).backfillComputedFields(ctx, recomputedFields)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // SetActiveSchemaVersion activates all collection versions with the given schema version, and deactivates all
@@ -13,16 +13,24 @@ package db
 import (
 	"context"
 
+	"github.com/sourcenetwork/corekv"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
 	"github.com/sourcenetwork/defradb/acp/identity"
 	acpTypes "github.com/sourcenetwork/defradb/acp/types"
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 	"github.com/sourcenetwork/defradb/internal/core/crdt"
 	"github.com/sourcenetwork/defradb/internal/datastore"
 	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
 	"github.com/sourcenetwork/defradb/internal/keys"
+	"github.com/sourcenetwork/defradb/internal/planner"
 )
 
 // DeleteWithFilter deletes using a filter to target documents for delete.
@@ -52,6 +60,8 @@ func (c *collection) deleteWithFilter(
 	filter any,
 	_ client.DocumentStatus,
 ) (*client.DeleteResult, error) {
+	ctx = permission.WithOperation(ctx, permission.OperationDelete)
+
 	// Make a selection plan that will scan through only the documents with matching filter.
 	selectionPlan, err := c.makeSelectionPlan(ctx, filter)
 	if err != nil {
@@ -124,6 +134,8 @@ func (c *collection) applyDelete(
 	ctx context.Context,
 	primaryKey keys.PrimaryDataStoreKey,
 ) error {
+	ctx = permission.WithOperation(ctx, permission.OperationDelete)
+
 	// Must also have read permission to delete, inorder to check if document exists.
 	found, isDeleted, err := c.exists(ctx, primaryKey)
 	if err != nil {
@@ -150,6 +162,29 @@ func (c *collection) applyDelete(
 		return client.ErrDocumentNotFoundOrNotAuthorized
 	}
 
+	references, err := c.getRelationReferences(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkRelationDeleteRestrictions(ctx, primaryKey.DocID, references)
+	if err != nil {
+		return err
+	}
+
+	// The relationships derived from fields are deleted with the document, so the actors
+	// must be fetched before the document is deleted.
+	// The request context is kept as the identity of the context may be replaced for signing.
+	hasFieldRelations := c.hasFieldRelationsWithACP(nil)
+	var fieldRelationsDoc *client.Document
+	requestCtx := ctx
+	if hasFieldRelations {
+		fieldRelationsDoc, err = c.getFieldRelationsDoc(ctx, primaryKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	txn := datastore.CtxMustGetTxn(ctx)
 
 	ident := identity.FromContext(ctx)
@@ -215,5 +250,253 @@ func (c *collection) applyDelete(
 		})
 	}
 
+	if hasFieldRelations {
+		err = c.syncFieldRelationshipsWithACP(requestCtx, primaryKey.DocID, fieldRelationsDoc, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The actions are applied after the document has been deleted, so that documents referencing
+	// each other may be deleted without looping forever.
+	return c.applyRelationDeleteActions(requestCtx, primaryKey.DocID, references)
+}
+
+// relationReference is a relation field through which the documents of a collection reference the
+// documents of another collection, and that has a delete action.
+type relationReference struct {
+	col   *collection
+	field client.FieldDefinition
+}
+
+// relationReferenceField is a relation field with a delete action, through which the documents of
+// the collection with the given name reference the documents of another collection.
+type relationReferenceField struct {
+	collectionName string
+	fieldName      string
+}
+
+// getRelationReferences returns the relation fields with a delete action that reference the
+// documents of this collection.
+func (c *collection) getRelationReferences(ctx context.Context) ([]relationReference, error) {
+	entry, ok := c.db.getDefinitionCacheEntry(c.Version().VersionID)
+	if !ok {
+		return nil, nil
+	}
+
+	references := []relationReference{}
+	for _, referenceField := range entry.relationReferences {
+		col, err := c.db.getCollectionByName(ctx, referenceField.collectionName)
+		if errors.Is(err, corekv.ErrNotFound) {
+			// The definitions the references were derived from may not have been committed.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field, ok := col.Definition().GetFieldByName(referenceField.fieldName)
+		if !ok {
+			continue
+		}
+
+		references = append(references, relationReference{
+			col:   col.(*collection),
+			field: field,
+		})
+	}
+
+	return references, nil
+}
+
+// getReferencingDocIDs returns the IDs of the documents referencing the document with the given ID
+// through this reference.
+//
+// All the referencing documents are returned, regardless of whether the requesting identity may read
+// them, so that the delete action applies to all of them.
+func (r relationReference) getReferencingDocIDs(ctx context.Context, docID string) ([]string, error) {
+	slct, err := r.col.makeSelectLocal(immutable.Some(request.Filter{
+		Conditions: map[string]any{
+			r.field.Name + request.RelatedObjectID: map[string]any{
+				"_eq": docID,
+			},
+		},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	selectionPlan, err := planner.New(
+		ctx,
+		identity.FromContext(ctx),
+		immutable.None[dac.DocumentACP](),
+		r.col.db,
+	).MakeSelectionPlan(slct)
+	if err != nil {
+		return nil, err
+	}
+
+	err = selectionPlan.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := selectionPlan.Start(); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := selectionPlan.Close(); err != nil {
+			log.ErrorContextE(ctx, "Failed to close the request plan, after referencing documents select", err)
+		}
+	}()
+
+	docIDs := []string{}
+	for {
+		next, err := selectionPlan.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !next {
+			break
+		}
+		doc := selectionPlan.Value()
+		docIDs = append(docIDs, doc.GetID())
+	}
+
+	return docIDs, nil
+}
+
+// checkRelationDeleteRestrictions returns an error if the document with the given ID is referenced
+// through a relation with a RESTRICT delete action.
+//
+// The document may not be deleted even if the requesting identity can not read the referencing
+// documents, in which case their IDs are not disclosed.
+func (c *collection) checkRelationDeleteRestrictions(
+	ctx context.Context,
+	docID string,
+	references []relationReference,
+) error {
+	for _, reference := range references {
+		if reference.field.OnDelete != client.RelationDeleteRestrict {
+			continue
+		}
+
+		referencingDocIDs, err := reference.getReferencingDocIDs(ctx, docID)
+		if err != nil {
+			return err
+		}
+		if len(referencingDocIDs) == 0 {
+			continue
+		}
+
+		for _, referencingDocID := range referencingDocIDs {
+			canRead, err := reference.col.checkAccessOfDocWithACP(ctx, acpTypes.DocumentReadPerm, referencingDocID)
+			if err != nil {
+				return err
+			}
+			if canRead {
+				return NewErrDeleteRestricted(docID, reference.col.Name(), reference.field.Name, referencingDocID)
+			}
+		}
+		return NewErrDeleteRestricted(docID, reference.col.Name(), reference.field.Name, "")
+	}
+	return nil
+}
+
+// applyRelationDeleteActions applies the CASCADE and SET_NULL delete actions of the given references
+// to the documents referencing the deleted document with the given ID.
+//
+// The actions are applied on behalf of the identity requesting the delete, and will fail if it is
+// not allowed to delete, or update, any of the referencing documents, including those it can not read.
+func (c *collection) applyRelationDeleteActions(
+	ctx context.Context,
+	docID string,
+	references []relationReference,
+) error {
+	for _, reference := range references {
+		if reference.field.OnDelete == client.RelationDeleteRestrict {
+			continue
+		}
+
+		requiredPermission := acpTypes.DocumentUpdatePerm
+		if reference.field.OnDelete == client.RelationDeleteCascade {
+			requiredPermission = acpTypes.DocumentDeletePerm
+		}
+
+		referencingDocIDs, err := reference.getReferencingDocIDs(ctx, docID)
+		if err != nil {
+			return err
+		}
+
+		for _, referencingDocID := range referencingDocIDs {
+			canApply, err := reference.col.checkAccessOfDocWithACP(ctx, requiredPermission, referencingDocID)
+			if err != nil {
+				return err
+			}
+			if !canApply {
+				return NewErrDeleteActionNotAuthorized(docID, reference.col.Name(), reference.field.Name)
+			}
+
+			switch reference.field.OnDelete {
+			case client.RelationDeleteCascade:
+				err = reference.col.deleteReferencingDoc(ctx, referencingDocID)
+			case client.RelationDeleteSetNull:
+				err = reference.col.clearReference(ctx, referencingDocID, reference.field)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
+
+// deleteReferencingDoc deletes the document with the given ID as part of a CASCADE delete action.
+func (c *collection) deleteReferencingDoc(ctx context.Context, docID string) error {
+	typedDocID, err := client.NewDocIDFromString(docID)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteIndexedDocWithID(ctx, typedDocID)
+	if err != nil {
+		return err
+	}
+
+	primaryKey, err := c.getPrimaryKeyFromDocID(ctx, typedDocID)
+	if err != nil {
+		return err
+	}
+
+	return c.applyDelete(ctx, primaryKey)
+}
+
+// clearReference clears the reference held by the given field of the document with the given ID as
+// part of a SET_NULL delete action.
+func (c *collection) clearReference(ctx context.Context, docID string, field client.FieldDefinition) error {
+	typedDocID, err := client.NewDocIDFromString(docID)
+	if err != nil {
+		return err
+	}
+
+	primaryKey, err := c.getPrimaryKeyFromDocID(ctx, typedDocID)
+	if err != nil {
+		return err
+	}
+
+	doc, err := c.get(ctx, primaryKey, nil, false)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+
+	err = doc.Set(field.Name+request.RelatedObjectID, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.update(ctx, doc)
+}
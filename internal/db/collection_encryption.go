@@ -0,0 +1,204 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/sourcenetwork/immutable"
+
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
+	"github.com/sourcenetwork/defradb/internal/encryption"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+// RotateEncryptionKey generates a new encryption key for the document with the given docID, or for
+// its given individually encrypted fields.
+func (c *collection) RotateEncryptionKey(
+	ctx context.Context,
+	docID client.DocID,
+	opts ...client.EncryptionKeyRotationOption,
+) error {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	ctx, txn, err := ensureContextTxn(ctx, c.db, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	ctx = permission.WithOperation(ctx, permission.OperationUpdate)
+
+	options := client.EncryptionKeyRotationOptions{}
+	options.Apply(opts)
+
+	primaryKey, err := c.getPrimaryKeyFromDocID(ctx, docID)
+	if err != nil {
+		return err
+	}
+
+	exists, isDeleted, err := c.exists(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+	if isDeleted {
+		return NewErrDocumentDeleted(primaryKey.DocID)
+	}
+
+	// Rotating the key changes how the document is written, so it requires the update permission.
+	canUpdate, err := c.checkAccessOfDocWithACP(ctx, acpTypes.DocumentUpdatePerm, primaryKey.DocID)
+	if err != nil {
+		return err
+	}
+	if !canUpdate {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+
+	err = c.rotateEncryptionKey(ctx, primaryKey.DocID, options.Fields)
+	if err != nil {
+		return err
+	}
+
+	if options.ReEncrypt {
+		err = c.reEncrypt(ctx, primaryKey, options.Fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	return txn.Commit(ctx)
+}
+
+// rotateEncryptionKey rotates the key of the document, or the keys of the given fields if any.
+func (c *collection) rotateEncryptionKey(ctx context.Context, docID string, fields []string) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	headstoreKey := keys.HeadstoreDocKey{DocID: docID}
+	if len(fields) == 0 {
+		// The key of the document is linked from the blocks of the composite DAG.
+		heads, _, err := coreblock.NewHeadSet(
+			txn.Headstore(),
+			headstoreKey.WithFieldID(core.COMPOSITE_NAMESPACE),
+		).List(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = coreblock.RotateEncryption(ctx, docID, immutable.None[string](), heads)
+		return err
+	}
+
+	shortID, err := id.GetShortCollectionID(ctx, c.Version().CollectionID)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if _, ok := c.Definition().GetFieldByName(field); !ok {
+			return client.NewErrFieldNotExist(field)
+		}
+		fieldID, err := id.GetShortFieldID(ctx, shortID, field)
+		if err != nil {
+			return err
+		}
+		heads, _, err := coreblock.NewHeadSet(
+			txn.Headstore(),
+			headstoreKey.WithFieldID(strconv.FormatUint(uint64(fieldID), 10)),
+		).List(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = coreblock.RotateEncryption(ctx, docID, immutable.Some(field), heads)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reEncrypt writes the current values of the document again, or the values of the given fields if any,
+// so that they are encrypted with the rotated keys.
+func (c *collection) reEncrypt(
+	ctx context.Context,
+	primaryKey keys.PrimaryDataStoreKey,
+	fields []string,
+) error {
+	doc, err := c.get(ctx, primaryKey, nil, false)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+
+	for name, field := range doc.Fields() {
+		if len(fields) > 0 && !slices.Contains(fields, name) {
+			continue
+		}
+		val, err := doc.GetValueWithField(field)
+		if err != nil {
+			return err
+		}
+		// Setting the value marks the field as dirty, so that it is written again.
+		err = doc.Set(name, val.Value())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.checkFieldAccessOfDocWithACP(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	// The document permissions have already been checked by the caller, and the values are
+	// unchanged so the embeddings and the relations derived from fields are still up to date.
+	return c.save(ctx, doc, false)
+}
+
+// setContextSchemaEncryption returns a new context with the encryption declared on the collection
+// merged into the doc encryption config of the given context.
+//
+// Documents are thus encrypted regardless of whether encryption has been requested on their creation.
+// Existing encrypted blocks keep being encrypted with their own keys, see [coreblock.AddDelta].
+func (c *collection) setContextSchemaEncryption(ctx context.Context) context.Context {
+	encryptDoc := c.Version().IsEncrypted
+	var encryptedFields []string
+	for _, field := range c.Version().Fields {
+		if field.IsEncrypted {
+			encryptedFields = append(encryptedFields, field.Name)
+		}
+	}
+	if !encryptDoc && len(encryptedFields) == 0 {
+		return ctx
+	}
+
+	encConf := encryption.GetContextConfig(ctx)
+	if encConf.HasValue() {
+		encryptDoc = encryptDoc || encConf.Value().IsDocEncrypted
+		for _, field := range encConf.Value().EncryptedFields {
+			if !slices.Contains(encryptedFields, field) {
+				encryptedFields = append(encryptedFields, field)
+			}
+		}
+	}
+	return encryption.SetContextConfigFromParams(ctx, encryptDoc, encryptedFields)
+}
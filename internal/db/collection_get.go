@@ -15,6 +15,7 @@ import (
 
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/acp/dac"
 	"github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/datastore"
@@ -68,15 +69,42 @@ func (c *collection) get(
 	fields []client.FieldDefinition,
 	showDeleted bool,
 ) (*client.Document, error) {
+	encodedDoc, err := c.fetch(ctx, c.newFetcher(), c.db.documentACP, primaryKey, fields, showDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if encodedDoc == nil {
+		return nil, nil
+	}
+
+	doc, err := fetcher.Decode(encodedDoc, c.Definition())
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// fetch returns the encoded document with the given primary key using the given fetcher.
+//
+// The document is only returned if the identity has access to it according to the given document
+// acp system, if any. The fetcher is closed before returning.
+func (c *collection) fetch(
+	ctx context.Context,
+	df fetcher.Fetcher,
+	documentACP immutable.Option[dac.DocumentACP],
+	primaryKey keys.PrimaryDataStoreKey,
+	fields []client.FieldDefinition,
+	showDeleted bool,
+) (fetcher.EncodedDocument, error) {
 	txn := datastore.CtxMustGetTxn(ctx)
-	// create a new document fetcher
-	df := c.newFetcher()
-	// initialize it with the primary index
+	// initialize the fetcher with the primary index
 	err := df.Init(
 		ctx,
 		identity.FromContext(ctx),
 		txn,
-		c.db.documentACP,
+		documentACP,
 		immutable.Option[client.IndexDescription]{},
 		c,
 		fields,
@@ -107,7 +135,7 @@ func (c *collection) get(
 		return nil, err
 	}
 
-	// return first matched decoded doc
+	// return first matched doc
 	encodedDoc, _, err := df.FetchNext(ctx)
 	if err != nil {
 		_ = df.Close()
@@ -119,14 +147,5 @@ func (c *collection) get(
 		return nil, err
 	}
 
-	if encodedDoc == nil {
-		return nil, nil
-	}
-
-	doc, err := fetcher.Decode(encodedDoc, c.Definition())
-	if err != nil {
-		return nil, err
-	}
-
-	return doc, nil
+	return encodedDoc, nil
 }
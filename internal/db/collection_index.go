@@ -19,6 +19,7 @@ import (
 
 	"slices"
 
+	"github.com/sourcenetwork/defradb/acp/dac"
 	"github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -28,6 +29,7 @@ import (
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
 	"github.com/sourcenetwork/defradb/internal/db/id"
 	"github.com/sourcenetwork/defradb/internal/db/sequence"
+	"github.com/sourcenetwork/defradb/internal/encryption"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/request/graphql/schema"
 )
@@ -176,6 +178,7 @@ func (c *collection) CreateIndex(
 
 func processCreateIndexRequest(
 	ctx context.Context,
+	blindIndexSecret []byte,
 	def client.CollectionDefinition,
 	desc client.IndexCreateRequest,
 ) (client.IndexDescription, error) {
@@ -184,11 +187,37 @@ func processCreateIndexRequest(
 		return client.IndexDescription{}, err
 	}
 
+	if len(def.Version.QuerySources()) != 0 && !def.Version.IsMaterialized {
+		return client.IndexDescription{}, NewErrIndexedViewNotMaterialized(def.Version.Name)
+	}
+
+	if len(def.Version.QuerySources()) != 0 && desc.Unique {
+		return client.IndexDescription{}, NewErrUniqueIndexOnView(def.Version.Name)
+	}
+
 	err = checkExistingFieldsAndAdjustRelFieldNames(def.Schema, desc.Fields)
 	if err != nil {
 		return client.IndexDescription{}, err
 	}
 
+	if desc.Blind && len(blindIndexSecret) == 0 {
+		return client.IndexDescription{}, NewErrBlindIndexWithoutSecret(def.Version.Name)
+	}
+
+	if desc.Blind {
+		err = checkBlindIndexSupported(def, desc.Fields)
+	} else {
+		err = checkIndexedFieldsNotEncrypted(def.Version, desc.Fields)
+	}
+	if err != nil {
+		return client.IndexDescription{}, err
+	}
+
+	err = checkIndexedFieldsStored(def.Version, desc.Fields)
+	if err != nil {
+		return client.IndexDescription{}, err
+	}
+
 	indexName, err := generateIndexNameIfNeeded(def.Version, desc)
 	if err != nil {
 		return client.IndexDescription{}, err
@@ -211,6 +240,7 @@ func processCreateIndexRequest(
 		ID:     uint32(indexID),
 		Fields: desc.Fields,
 		Unique: desc.Unique,
+		Blind:  desc.Blind,
 	}, nil
 }
 
@@ -218,7 +248,7 @@ func (c *collection) createIndex(
 	ctx context.Context,
 	createReq client.IndexCreateRequest,
 ) (CollectionIndex, error) {
-	desc, err := processCreateIndexRequest(ctx, c.Definition(), createReq)
+	desc, err := processCreateIndexRequest(ctx, c.db.blindIndexSecret, c.Definition(), createReq)
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +278,11 @@ func (c *collection) addNewIndex(ctx context.Context, desc client.IndexDescripti
 
 	c.indexes = append(c.indexes, colIndex)
 
-	err = c.indexExistingDocs(ctx, colIndex)
+	if len(c.Version().QuerySources()) != 0 {
+		err = c.indexExistingViewItems(ctx, desc)
+	} else {
+		err = c.indexExistingDocs(ctx, colIndex)
+	}
 	if err != nil {
 		removeErr := colIndex.RemoveAll(ctx)
 		return nil, errors.Join(err, removeErr)
@@ -259,6 +293,7 @@ func (c *collection) addNewIndex(ctx context.Context, desc client.IndexDescripti
 
 func (c *collection) iterateAllDocs(
 	ctx context.Context,
+	documentACP immutable.Option[dac.DocumentACP],
 	fields []client.FieldDefinition,
 	exec func(doc *client.Document) error,
 ) error {
@@ -268,7 +303,7 @@ func (c *collection) iterateAllDocs(
 		ctx,
 		identity.FromContext(ctx),
 		txn,
-		c.db.documentACP,
+		documentACP,
 		immutable.None[client.IndexDescription](),
 		c,
 		fields,
@@ -328,11 +363,28 @@ func (c *collection) indexExistingDocs(
 			fields = append(fields, colField)
 		}
 	}
-	return c.iterateAllDocs(ctx, fields, func(doc *client.Document) error {
+	return c.iterateAllDocs(ctx, c.db.documentACP, fields, func(doc *client.Document) error {
 		return index.Save(ctx, doc)
 	})
 }
 
+// indexExistingViewItems indexes the items currently held in the cache of the view.
+func (c *collection) indexExistingViewItems(
+	ctx context.Context,
+	desc client.IndexDescription,
+) error {
+	indexes, err := newViewIndexes(c)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if index.desc.ID == desc.ID {
+			return c.db.indexViewCache(ctx, c, index)
+		}
+	}
+	return nil
+}
+
 // DropIndex removes an index from the collection.
 //
 // The index will be removed from the system store.
@@ -414,6 +466,57 @@ func checkExistingFieldsAndAdjustRelFieldNames(
 	return nil
 }
 
+// checkIndexedFieldsNotEncrypted returns an error if any of the given fields is encrypted, as the
+// index would hold their values in plain text.
+func checkIndexedFieldsNotEncrypted(
+	colVersion client.CollectionVersion,
+	fields []client.IndexedFieldDescription,
+) error {
+	for _, indexedField := range fields {
+		if colVersion.IsEncrypted {
+			return NewErrCanNotIndexEncryptedField(colVersion.Name, indexedField.Name)
+		}
+		field, ok := colVersion.GetFieldByName(indexedField.Name)
+		if ok && field.IsEncrypted {
+			return NewErrCanNotIndexEncryptedField(colVersion.Name, indexedField.Name)
+		}
+	}
+	return nil
+}
+
+// checkBlindIndexSupported returns an error if the given fields can not be indexed by a blind index.
+//
+// Blind indexes only support equality on a single scalar field, as the hashed values can neither be
+// ordered nor combined into a meaningful prefix.
+func checkBlindIndexSupported(
+	def client.CollectionDefinition,
+	fields []client.IndexedFieldDescription,
+) error {
+	if len(fields) > 1 {
+		return NewErrBlindIndexWithMultipleFields(def.Version.Name)
+	}
+	field, ok := def.GetFieldByName(fields[0].Name)
+	if !ok {
+		return NewErrNonExistingFieldForIndex(fields[0].Name)
+	}
+	switch field.Kind {
+	case
+		client.FieldKind_NILLABLE_STRING,
+		client.FieldKind_NILLABLE_INT,
+		client.FieldKind_NILLABLE_FLOAT64,
+		client.FieldKind_NILLABLE_BOOL:
+		return nil
+	default:
+		return NewErrUnsupportedBlindIndexFieldType(field.Name, field.Kind)
+	}
+}
+
+// BlindIndexValue returns the value stored in the blind indexes of the collection in place of the
+// given value, keyed by the blind index secret of the database.
+func (c *collection) BlindIndexValue(value client.NormalValue) (client.NormalValue, error) {
+	return encryption.BlindIndexValue(c.db.blindIndexSecret, c.Version().CollectionID, value)
+}
+
 func generateIndexNameIfNeeded(
 	colVersion client.CollectionVersion,
 	createReq client.IndexCreateRequest,
@@ -0,0 +1,303 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/sourcenetwork/corekv"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+// MigrateDocuments migrates the next batch of stored documents to the version of this collection.
+func (c *collection) MigrateDocuments(
+	ctx context.Context,
+	batchSize int,
+) (client.DocumentMigrationProgress, error) {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	if batchSize <= 0 {
+		return client.DocumentMigrationProgress{}, NewErrInvalidMigrationBatchSize(batchSize)
+	}
+
+	ctx, txn, err := ensureContextTxn(ctx, c.db, false)
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	defer txn.Discard(ctx)
+
+	progress, err := c.migrateDocuments(ctx, batchSize)
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+
+	return progress, txn.Commit(ctx)
+}
+
+// documentMigrationState is the state of the migration of the documents of a collection version,
+// held between batches.
+type documentMigrationState struct {
+	// Cursor is the DocID of the last document processed by a previous batch.
+	Cursor string
+	// Processed is the number of documents processed by the previous batches.
+	Processed int
+	// Skipped is the number of documents the identity did not have access to.
+	Skipped int
+	// Total is the number of documents counted when the migration started.
+	Total int
+}
+
+func (c *collection) migrateDocuments(
+	ctx context.Context,
+	batchSize int,
+) (client.DocumentMigrationProgress, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+	migrationKey := keys.NewDocumentMigrationKey(c.Version().VersionID)
+
+	// The documents are read on behalf of the migration, which rewrites them.
+	ctx = permission.WithOperation(ctx, permission.OperationUpdate)
+
+	state := documentMigrationState{}
+	value, err := txn.Systemstore().Get(ctx, migrationKey.Bytes())
+	switch {
+	case errors.Is(err, corekv.ErrNotFound):
+		// The documents are only counted once, when the migration starts.
+		state.Total, err = c.countDocumentsToMigrate(ctx)
+		if err != nil {
+			return client.DocumentMigrationProgress{}, err
+		}
+	case err != nil:
+		return client.DocumentMigrationProgress{}, err
+	default:
+		err = json.Unmarshal(value, &state)
+		if err != nil {
+			return client.DocumentMigrationProgress{}, err
+		}
+	}
+
+	docIDs, isLastBatch, err := c.getNextDocIDsToMigrate(ctx, state.Cursor, batchSize)
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+
+	progress := client.DocumentMigrationProgress{}
+	for _, docID := range docIDs {
+		// Migrating a document rewrites it, so the update permission is required.
+		canUpdate, err := c.checkAccessOfDocWithACP(ctx, acpTypes.DocumentUpdatePerm, docID)
+		if err != nil {
+			return client.DocumentMigrationProgress{}, err
+		}
+		if !canUpdate {
+			state.Skipped++
+			continue
+		}
+
+		migrated, err := c.migrateDocument(ctx, docID)
+		if err != nil {
+			return client.DocumentMigrationProgress{}, err
+		}
+		if migrated {
+			progress.Migrated++
+		}
+	}
+
+	state.Processed += len(docIDs)
+	// Documents may have been received since the migration started.
+	state.Total = max(state.Total, state.Processed)
+
+	progress.Processed = state.Processed
+	progress.Skipped = state.Skipped
+	progress.Total = state.Total
+	progress.Done = isLastBatch
+
+	if progress.Done {
+		// The state is removed so that the migration may be run again, for example to
+		// migrate documents received later on, or skipped documents with another identity.
+		err = txn.Systemstore().Delete(ctx, migrationKey.Bytes())
+	} else {
+		state.Cursor = docIDs[len(docIDs)-1]
+		value, err = json.Marshal(state)
+		if err != nil {
+			return client.DocumentMigrationProgress{}, err
+		}
+		err = txn.Systemstore().Set(ctx, migrationKey.Bytes(), value)
+	}
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+
+	return progress, nil
+}
+
+// countDocumentsToMigrate returns the number of stored documents of this collection.
+func (c *collection) countDocumentsToMigrate(ctx context.Context) (int, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	shortID, err := id.GetShortCollectionID(ctx, c.Version().CollectionID)
+	if err != nil {
+		return 0, err
+	}
+	prefix := keys.PrimaryDataStoreKey{ // empty path for all keys prefix
+		CollectionShortID: shortID,
+	}
+	iter, err := txn.Datastore().Iterator(ctx, corekv.IterOptions{
+		Prefix:   prefix.Bytes(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for {
+		hasNext, err := iter.Next()
+		if err != nil {
+			return 0, errors.Join(err, iter.Close())
+		}
+		if !hasNext {
+			break
+		}
+		count++
+	}
+
+	return count, iter.Close()
+}
+
+// getNextDocIDsToMigrate returns up to batchSize DocIDs of the documents following the given cursor,
+// and whether there are no documents left to migrate once they are processed.
+func (c *collection) getNextDocIDsToMigrate(
+	ctx context.Context,
+	cursor string,
+	batchSize int,
+) ([]string, bool, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	shortID, err := id.GetShortCollectionID(ctx, c.Version().CollectionID)
+	if err != nil {
+		return nil, false, err
+	}
+	prefix := keys.PrimaryDataStoreKey{ // empty path for all keys prefix
+		CollectionShortID: shortID,
+	}
+	// Keys are iterated in order, so the iteration starts right after the key of the cursor as
+	// the documents up to the cursor have already been processed.
+	start := prefix.Bytes()
+	if cursor != "" {
+		cursorKey := keys.PrimaryDataStoreKey{
+			CollectionShortID: shortID,
+			DocID:             cursor,
+		}
+		start = append(cursorKey.Bytes(), 0)
+	}
+	iter, err := txn.Datastore().Iterator(ctx, corekv.IterOptions{
+		Start:    start,
+		End:      prefix.PrefixEnd(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	docIDs := []string{}
+	for {
+		hasNext, err := iter.Next()
+		if err != nil {
+			return nil, false, errors.Join(err, iter.Close())
+		}
+		if !hasNext {
+			return docIDs, true, iter.Close()
+		}
+		if len(docIDs) == batchSize {
+			return docIDs, false, iter.Close()
+		}
+
+		splitString := strings.Split(string(iter.Key()), "/")
+		docIDs = append(docIDs, splitString[len(splitString)-1])
+	}
+}
+
+// migrateDocument rewrites the stored document with the given DocID to the version of this collection
+// and updates its index entries.
+//
+// It returns true if the document was migrated, and false if it was already at the version of this
+// collection or has been deleted.
+//
+// Access to the document must have been checked by the caller, it is fetched regardless of acp.
+func (c *collection) migrateDocument(ctx context.Context, docID string) (bool, error) {
+	shortID, err := id.GetShortCollectionID(ctx, c.Version().CollectionID)
+	if err != nil {
+		return false, err
+	}
+	primaryKey := keys.PrimaryDataStoreKey{
+		CollectionShortID: shortID,
+		DocID:             docID,
+	}
+
+	// The document is first fetched as it is stored, as its index entries were written
+	// from the values held prior to migration.
+	storedDoc, err := c.fetch(
+		ctx,
+		c.newUnmigratedFetcher(),
+		immutable.None[dac.DocumentACP](),
+		primaryKey,
+		c.Definition().CollectIndexedFields(),
+		false,
+	)
+	if err != nil {
+		return false, err
+	}
+	if storedDoc == nil || storedDoc.SchemaVersionID() == c.Version().VersionID {
+		return false, nil
+	}
+
+	oldDoc, err := fetcher.Decode(storedDoc, c.Definition())
+	if err != nil {
+		return false, err
+	}
+
+	// Fetching all the fields of the document via the lensed fetcher migrates it and writes the
+	// migrated values back to the datastore.
+	encodedDoc, err := c.fetch(ctx, c.newFetcher(), immutable.None[dac.DocumentACP](), primaryKey, nil, false)
+	if err != nil {
+		return false, err
+	}
+	if encodedDoc == nil {
+		// The migration did not yield a document.
+		return false, nil
+	}
+
+	newDoc, err := fetcher.Decode(encodedDoc, c.Definition())
+	if err != nil {
+		return false, err
+	}
+
+	for _, index := range c.indexes {
+		err = index.Update(ctx, oldDoc, newDoc)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
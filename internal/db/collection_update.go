@@ -19,6 +19,7 @@ import (
 	"github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
 	"github.com/sourcenetwork/defradb/internal/planner"
 )
 
@@ -51,6 +52,8 @@ func (c *collection) updateWithFilter(
 	filter any,
 	updater string,
 ) (*client.UpdateResult, error) {
+	ctx = permission.WithOperation(ctx, permission.OperationUpdate)
+
 	parsedUpdater, err := fastjson.Parse(updater)
 	if err != nil {
 		return nil, err
@@ -95,6 +98,13 @@ func (c *collection) updateWithFilter(
 
 	docMap := selectionPlan.DocumentMap()
 
+	// The fields restricted by field permissions are not rewritten with their current
+	// value, as it might be masked, and as it would require their update permission.
+	restrictedFields, err := c.fieldsWithPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Keep looping until results from the selection plan have been iterated through.
 	for {
 		next, nextErr := selectionPlan.Next()
@@ -108,6 +118,11 @@ func (c *collection) updateWithFilter(
 
 		// Get the document, and apply the patch
 		docAsMap := docMap.ToMap(selectionPlan.Value())
+		for fieldName := range docAsMap {
+			if permission.IsFieldDenied(restrictedFields, fieldName) {
+				delete(docAsMap, fieldName)
+			}
+		}
 		doc, err := client.NewDocFromMap(docAsMap, c.Definition())
 		if err != nil {
 			return nil, err
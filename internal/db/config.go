@@ -11,6 +11,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/acp/identity"
@@ -22,9 +24,12 @@ const (
 )
 
 type dbOptions struct {
-	maxTxnRetries  immutable.Option[int]
-	identity       immutable.Option[identity.Identity]
-	disableSigning bool
+	maxTxnRetries     immutable.Option[int]
+	identity          immutable.Option[identity.Identity]
+	disableSigning    bool
+	acpAuditLog       bool
+	acpAuditRetention time.Duration
+	blindIndexSecret  []byte
 }
 
 // Option is a funtion that sets a config value on the db.
@@ -50,3 +55,28 @@ func WithEnabledSigning(value bool) Option {
 		opts.disableSigning = !value
 	}
 }
+
+// WithACPAuditLog enables the acp audit log, recording the authorization decisions made by
+// the document acp system. By default, the audit log is disabled.
+func WithACPAuditLog(enable bool) Option {
+	return func(opts *dbOptions) {
+		opts.acpAuditLog = enable
+	}
+}
+
+// WithACPAuditRetention sets how long the records of the acp audit log are kept for.
+// By default (zero), the records are kept forever.
+func WithACPAuditRetention(retention time.Duration) Option {
+	return func(opts *dbOptions) {
+		opts.acpAuditRetention = retention
+	}
+}
+
+// WithBlindIndexSecret sets the secret the keys of blind indexes are derived from.
+//
+// Blind indexes can not be written nor read if no secret is set.
+func WithBlindIndexSecret(secret []byte) Option {
+	return func(opts *dbOptions) {
+		opts.blindIndexSecret = secret
+	}
+}
@@ -83,6 +83,9 @@ type DB struct {
 	// Contains document ACP if it exists
 	documentACP immutable.Option[dac.DocumentACP]
 
+	// Contains the acp audit log if it is enabled
+	acpAuditLog immutable.Option[*acpAuditLog]
+
 	// To be able to close the context passed to NewDB on DB close,
 	// we need to keep a reference to the cancel function. Otherwise,
 	// some goroutines might leak.
@@ -90,6 +93,14 @@ type DB struct {
 
 	// If true, block signing is disabled. By default, block signing is enabled.
 	signingDisabled bool
+
+	// The secret the keys of blind indexes are derived from.
+	blindIndexSecret []byte
+
+	// The values derived from the active collection definitions.
+	//
+	// It is rebuilt from the active definitions each time they are loaded.
+	definitionCache atomic.Pointer[definitionCache]
 }
 
 var _ client.TxnStore = (*DB)(nil)
@@ -143,6 +154,21 @@ func newDB(
 
 	db.nodeIdentity = opts.identity
 	db.signingDisabled = opts.disableSigning
+	db.blindIndexSecret = opts.blindIndexSecret
+
+	if opts.acpAuditLog {
+		auditLog := newACPAuditLog(rootstore, db.events, opts.acpAuditRetention)
+		db.acpAuditLog = immutable.Some(auditLog)
+		if documentACP.HasValue() {
+			db.documentACP = immutable.Some[dac.DocumentACP](
+				permission.NewAuditedDocumentACP(documentACP.Value(), auditLog),
+			)
+		}
+		go auditLog.flushBuffered(ctx)
+		if opts.acpAuditRetention > 0 {
+			go auditLog.pruneExpired(ctx)
+		}
+	}
 
 	if lens != nil {
 		lens.Init(db)
@@ -159,6 +185,12 @@ func newDB(
 	}
 	go db.handleMessages(ctx, sub)
 
+	viewSub, err := db.events.Subscribe(event.UpdateName, event.MergeCompleteName)
+	if err != nil {
+		return nil, err
+	}
+	go db.handleViewRefreshes(ctx, viewSub)
+
 	return db, nil
 }
 
@@ -288,11 +320,21 @@ func (db *DB) AddDACActorRelationship(
 		return client.AddActorRelationshipResult{}, client.ErrACPOperationButCollectionHasNoPolicy
 	}
 
+	objectID, err := permission.ObjectIDOfRelationshipOnCollection(
+		ctx,
+		db.documentACP.Value(),
+		collection,
+		docID,
+	)
+	if err != nil {
+		return client.AddActorRelationshipResult{}, err
+	}
+
 	exists, err := db.documentACP.Value().AddDocActorRelationship(
 		ctx,
 		policyID,
 		resourceName,
-		docID,
+		objectID,
 		relation,
 		identity.FromContext(ctx).Value(),
 		targetActor,
@@ -302,7 +344,7 @@ func (db *DB) AddDACActorRelationship(
 		return client.AddActorRelationshipResult{}, err
 	}
 
-	if !exists {
+	if !exists && docID != "" {
 		err = db.publishDocUpdateEvent(ctx, docID, collection)
 		if err != nil {
 			return client.AddActorRelationshipResult{}, err
@@ -336,11 +378,21 @@ func (db *DB) DeleteDACActorRelationship(
 		return client.DeleteActorRelationshipResult{}, client.ErrACPOperationButCollectionHasNoPolicy
 	}
 
+	objectID, err := permission.ObjectIDOfRelationshipOnCollection(
+		ctx,
+		db.documentACP.Value(),
+		collection,
+		docID,
+	)
+	if err != nil {
+		return client.DeleteActorRelationshipResult{}, err
+	}
+
 	recordFound, err := db.documentACP.Value().DeleteDocActorRelationship(
 		ctx,
 		policyID,
 		resourceName,
-		docID,
+		objectID,
 		relation,
 		identity.FromContext(ctx).Value(),
 		targetActor,
@@ -353,6 +405,188 @@ func (db *DB) DeleteDACActorRelationship(
 	return client.DeleteActorRelationshipResult{RecordFound: recordFound}, nil
 }
 
+func (db *DB) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	return db.applyDACActorRelationshipBatch(ctx, batch, true)
+}
+
+func (db *DB) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	return db.applyDACActorRelationshipBatch(ctx, batch, false)
+}
+
+// relationshipBatchRevertAttempts is the number of times the revert of a relationship changed
+// by a failed batch is attempted.
+const relationshipBatchRevertAttempts = 3
+
+// applyDACActorRelationshipBatch makes (if add is true) or deletes the relationships between the
+// documents of the batch and the target actor.
+//
+// The relationships are not stored within the transaction, so every document is checked before
+// any relationship is changed: its object must be registered with acp, and the documents that are
+// not owned by the requesting actor are changed first as the requesting actor may not manage their
+// relation. If a relationship still fails to be changed, the relationships already changed by the
+// batch are reverted, and the whole batch fails.
+func (db *DB) applyDACActorRelationshipBatch(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+	add bool,
+) (client.ActorRelationshipBatchResult, error) {
+	if !db.documentACP.HasValue() {
+		return client.ActorRelationshipBatchResult{}, client.ErrACPOperationButACPNotAvailable
+	}
+	documentACP := db.documentACP.Value()
+
+	ctx, txn, err := ensureContextTxn(ctx, db, true)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	defer txn.Discard(ctx)
+
+	col, err := db.getCollectionByName(ctx, batch.CollectionName)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	policyID, resourceName, hasPolicy := permission.IsPermissioned(col)
+	if !hasPolicy {
+		return client.ActorRelationshipBatchResult{}, client.ErrACPOperationButCollectionHasNoPolicy
+	}
+
+	docIDs, err := col.(*collection).docIDsOfRelationshipBatch(ctx, batch)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	requestActor := identity.FromContext(ctx).Value()
+
+	// Every document is checked before any relationship is changed.
+	objectIDs := make(map[string]string, len(docIDs))
+	ownedDocIDs := make([]string, 0, len(docIDs))
+	unownedDocIDs := make([]string, 0, len(docIDs))
+	for _, docID := range docIDs {
+		objectID, err := permission.ObjectIDOfRelationshipOnCollection(ctx, documentACP, col, docID)
+		if err != nil {
+			return client.ActorRelationshipBatchResult{}, NewErrRelationshipBatchFailed(err, docID)
+		}
+		owner, err := documentACP.DocOwner(ctx, policyID, resourceName, objectID)
+		if err != nil {
+			return client.ActorRelationshipBatchResult{}, NewErrRelationshipBatchFailed(err, docID)
+		}
+		if !owner.HasValue() {
+			return client.ActorRelationshipBatchResult{}, NewErrRelationshipBatchFailed(
+				ErrRelationshipBatchDocNotRegistered,
+				docID,
+			)
+		}
+		objectIDs[docID] = objectID
+		if requestActor != nil && owner.Value() == requestActor.DID() {
+			ownedDocIDs = append(ownedDocIDs, docID)
+		} else {
+			unownedDocIDs = append(unownedDocIDs, docID)
+		}
+	}
+
+	apply := func(docID string, add bool) (bool, error) {
+		if add {
+			exists, err := documentACP.AddDocActorRelationship(
+				ctx,
+				policyID,
+				resourceName,
+				objectIDs[docID],
+				batch.Relation,
+				requestActor,
+				batch.TargetActor,
+			)
+			return !exists, err
+		}
+		recordFound, err := documentACP.DeleteDocActorRelationship(
+			ctx,
+			policyID,
+			resourceName,
+			objectIDs[docID],
+			batch.Relation,
+			requestActor,
+			batch.TargetActor,
+		)
+		return recordFound, err
+	}
+
+	result := client.ActorRelationshipBatchResult{}
+	changedDocIDs := make([]string, 0, len(docIDs))
+	for _, docID := range append(unownedDocIDs, ownedDocIDs...) {
+		changed, err := apply(docID, add)
+		if err != nil {
+			notReverted := revertDACActorRelationshipBatch(changedDocIDs, func(docID string) error {
+				_, err := apply(docID, !add)
+				return err
+			})
+			if len(notReverted) > 0 {
+				return client.ActorRelationshipBatchResult{}, NewErrRelationshipBatchRevertFailed(
+					err,
+					docID,
+					notReverted,
+				)
+			}
+			return client.ActorRelationshipBatchResult{}, NewErrRelationshipBatchFailed(err, docID)
+		}
+		if changed {
+			changedDocIDs = append(changedDocIDs, docID)
+			result.Changed++
+		} else {
+			result.Unchanged++
+		}
+	}
+
+	if add {
+		// The documents are pushed again so that the peers that were just given access receive them.
+		txn.OnSuccess(func() {
+			for _, docID := range changedDocIDs {
+				err := db.publishDocUpdateEvent(ctx, docID, col)
+				if err != nil {
+					log.ErrorContextE(
+						ctx,
+						"Failed to publish update event of relationship batch",
+						err,
+						corelog.Any("DocID", docID),
+					)
+				}
+			}
+		})
+	}
+
+	return result, txn.Commit(ctx)
+}
+
+// revertDACActorRelationshipBatch reverts the relationships of the given documents, in the reverse
+// order they were changed in, and returns the IDs of the documents that could not be reverted.
+func revertDACActorRelationshipBatch(docIDs []string, revert func(string) error) []string {
+	var notReverted []string
+	for i := len(docIDs) - 1; i >= 0; i-- {
+		var err error
+		for attempt := 0; attempt < relationshipBatchRevertAttempts; attempt++ {
+			err = revert(docIDs[i])
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			notReverted = append(notReverted, docIDs[i])
+		}
+	}
+	return notReverted
+}
+
 func (db *DB) GetNodeIdentity(_ context.Context) (immutable.Option[identity.PublicRawIdentity], error) {
 	if db.nodeIdentity.HasValue() {
 		return immutable.Some(db.nodeIdentity.Value().ToPublicRawIdentity()), nil
@@ -459,6 +693,13 @@ func (db *DB) Close() {
 
 	db.ctxCancel()
 
+	if db.acpAuditLog.HasValue() {
+		// The buffered records are written before the event bus and the rootstore are closed.
+		if err := db.acpAuditLog.Value().flush(context.Background()); err != nil {
+			log.ErrorE("Failure writing the acp audit records", err)
+		}
+	}
+
 	db.events.Close()
 
 	err := db.rootstore.Close()
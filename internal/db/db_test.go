@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/errors"
 )
 
 func newBadgerDB(ctx context.Context) (*DB, error) {
@@ -45,3 +46,25 @@ func TestNewDB(t *testing.T) {
 	_, err = NewDB(ctx, rootstore, adminInfo, dac.NoDocumentACP, nil)
 	require.NoError(t, err)
 }
+
+func TestRevertDACActorRelationshipBatch_WithFailingRevert_ShouldRetryAndReturnNotRevertedDocIDs(t *testing.T) {
+	attempts := map[string]int{}
+	notReverted := revertDACActorRelationshipBatch([]string{"doc1", "doc2", "doc3"}, func(docID string) error {
+		attempts[docID]++
+		switch {
+		case docID == "doc2":
+			return errors.New("revert failed")
+		case docID == "doc3" && attempts[docID] == 1:
+			return errors.New("revert failed once")
+		default:
+			return nil
+		}
+	})
+
+	require.Equal(t, []string{"doc2"}, notReverted)
+	require.Equal(t, map[string]int{
+		"doc1": 1,
+		"doc2": relationshipBatchRevertAttempts,
+		"doc3": 2,
+	}, attempts)
+}
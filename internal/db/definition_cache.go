@@ -0,0 +1,88 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"regexp"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/expr"
+)
+
+// definitionCache holds the values derived from the active collection definitions, by collection
+// version ID.
+//
+// It is built each time the active definitions are loaded, so that the values are not derived
+// again on each request.
+type definitionCache map[string]*definitionCacheEntry
+
+// definitionCacheEntry holds the values derived from a single collection definition.
+type definitionCacheEntry struct {
+	// The compiled pattern constraints of the fields, by field name.
+	fieldPatterns map[string]*regexp.Regexp
+
+	// The parsed expressions of the computed fields, by field name.
+	computedExpressions map[string]*expr.Expression
+
+	// The relation fields with a delete action that reference the documents of the collection.
+	relationReferences []relationReferenceField
+}
+
+// newDefinitionCache derives the cached values of the given definitions.
+func newDefinitionCache(definitions []client.CollectionDefinition) definitionCache {
+	cache := definitionCache{}
+	for _, def := range definitions {
+		if def.Version.VersionID == "" {
+			// Schema-only definitions have no collection to derive values for.
+			continue
+		}
+		cache[def.Version.VersionID] = &definitionCacheEntry{
+			fieldPatterns:       compileFieldPatterns(def),
+			computedExpressions: parseComputedExpressions(def),
+		}
+	}
+
+	definitionsByName := client.NewDefinitionCache(definitions)
+	for _, def := range definitions {
+		for _, field := range def.GetFields() {
+			if field.OnDelete == client.RelationDeleteNone || !field.IsPrimaryRelation {
+				continue
+			}
+
+			otherDef, ok := client.GetDefinition(definitionsByName, def, field.Kind)
+			if !ok {
+				continue
+			}
+			entry, ok := cache[otherDef.Version.VersionID]
+			if !ok {
+				continue
+			}
+
+			entry.relationReferences = append(entry.relationReferences, relationReferenceField{
+				collectionName: def.GetName(),
+				fieldName:      field.Name,
+			})
+		}
+	}
+
+	return cache
+}
+
+// getDefinitionCacheEntry returns the cached values of the active collection version with the
+// given ID, and false if there are none.
+func (db *DB) getDefinitionCacheEntry(versionID string) (*definitionCacheEntry, bool) {
+	cache := db.definitionCache.Load()
+	if cache == nil {
+		return nil, false
+	}
+	entry, ok := (*cache)[versionID]
+	return entry, ok
+}
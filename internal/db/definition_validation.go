@@ -13,7 +13,10 @@ package db
 import (
 	"context"
 	"reflect"
+	"regexp"
+	"slices"
 
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/errors"
@@ -94,6 +97,7 @@ var updateOnlyValidators = []definitionValidator{
 	validateSchemaFieldNotDeleted,
 	validateFieldNotMutated,
 	validateFieldNotMoved,
+	validateIndexedFieldsNotMutated,
 	validateCollectionNameNotMutated,
 }
 
@@ -124,7 +128,9 @@ var globalValidators = []definitionValidator{
 	validateCollectionNameUnique,
 	validateRelationPointsToValidKind,
 	validateSecondaryFieldsPairUp,
+	validateManyToManyJoinCollectionExists,
 	validateSingleSidePrimary,
+	validateRelationDeleteActionOnPrimary,
 	validateCollectionDefinitionPolicyDesc,
 	validateSchemaNameNotEmpty,
 	validateCollectionNameNotEmpty,
@@ -135,11 +141,20 @@ var globalValidators = []definitionValidator{
 	validateFieldNotDuplicated,
 	validateSelfReferences,
 	validateCollectionMaterialized,
-	validateMaterializedHasNoPolicy,
+	validateAutoRefreshedIsMaterializedView,
+	validateAutoRefreshedViewHasNoPolicy,
+	validateIndexedViewIsMaterialized,
+	validateViewIndexesNotUnique,
 	validateCollectionFieldDefaultValue,
+	validateFieldConstraints,
+	validateComputedFields,
+	validateComputedFieldsIndexedAreStored,
 	validateEmbeddingAndKindCompatible,
 	validateEmbeddingFieldsForGeneration,
 	validateEmbeddingProviderAndModel,
+	validateEncryptedFieldsSupported,
+	validateEncryptedFieldsNotIndexed,
+	validateEnumValuesConsistent,
 }
 
 var createValidators = append(
@@ -288,6 +303,13 @@ func validateSecondaryFieldsPairUp(
 				continue
 			}
 
+			fieldDef, _ := definition.GetFieldByName(field.Name)
+			if _, ok := client.GetManyToManyRelationField(definition, fieldDef, otherDef); ok {
+				// Both sides of many-to-many relations are secondary, their links are held
+				// by a join collection.
+				continue
+			}
+
 			otherField, ok := otherDef.Version.GetFieldByRelation(
 				field.RelationName.Value(),
 				definition.GetName(),
@@ -308,6 +330,44 @@ func validateSecondaryFieldsPairUp(
 	return errors.Join(errs...)
 }
 
+func validateManyToManyJoinCollectionExists(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, newCollection := range newState.collections {
+		schema, ok := newState.schemaByID[newCollection.VersionID]
+		if !ok {
+			continue
+		}
+
+		definition := client.CollectionDefinition{
+			Version: newCollection,
+			Schema:  schema,
+		}
+
+		for _, field := range definition.GetFields() {
+			otherDef, ok := client.GetDefinition(newState.definitionCache, definition, field.Kind)
+			if !ok {
+				continue
+			}
+
+			if _, ok := client.GetManyToManyRelationField(definition, field, otherDef); !ok {
+				continue
+			}
+
+			joinDef, ok := newState.definitionsByName[client.JoinCollectionName(field.RelationName)]
+			if !ok || !joinDef.Version.IsJoin {
+				errs = append(errs, NewErrJoinCollectionMissing(field.RelationName))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func validateSingleSidePrimary(
 	ctx context.Context,
 	db *DB,
@@ -686,12 +746,59 @@ func validateCollectionDefinitionPolicyDesc(
 
 		if err != nil {
 			errs = append(errs, err)
+			continue
+		}
+
+		// Field scoped permissions must target fields of the collection.
+		fieldPermissions, err := db.documentACP.Value().FieldPermissions(
+			ctx,
+			newCol.Policy.Value().ID,
+			newCol.Policy.Value().ResourceName,
+		)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		definition := newState.definitionsByName[newCol.Name]
+		for _, fieldPermission := range fieldPermissions {
+			if _, ok := definition.GetFieldByName(fieldPermission.FieldName); !ok {
+				errs = append(errs, NewErrPolicyFieldDoesNotExist(newCol.Name, fieldPermission.String()))
+			}
+		}
+
+		// Relations derived from fields must be given to actors held in string fields, and the
+		// owner relation can only be given by registering the document.
+		for _, fieldRelation := range newCol.Policy.Value().FieldRelations {
+			if fieldRelation.Relation == acpTypes.RequiredRegistererRelationName {
+				errs = append(errs, NewErrPolicyFieldRelationCanNotBeOwner(newCol.Name, fieldRelation.FieldName))
+			}
+			field, ok := definition.GetFieldByName(fieldRelation.FieldName)
+			if !ok || !isPolicyFieldRelationKind(field.Kind) {
+				errs = append(errs, NewErrPolicyFieldRelationInvalidField(newCol.Name, fieldRelation.FieldName))
+			}
 		}
 	}
 
 	return errors.Join(errs...)
 }
 
+// isPolicyFieldRelationKind returns true if a field of the given kind can hold the actors of a
+// relation derived from the field.
+func isPolicyFieldRelationKind(kind client.FieldKind) bool {
+	switch kind {
+	case client.FieldKind_NILLABLE_STRING,
+		client.FieldKind_STRING_ARRAY,
+		client.FieldKind_NILLABLE_STRING_ARRAY:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateSchemaFieldNotDeleted returns an error if a field has been removed from a schema.
+//
+// Fields may only be removed if the new collection version is sourced from the previous one via a
+// lens migration, and if the field is not part of a relation.
 func validateSchemaFieldNotDeleted(
 	ctx context.Context,
 	db *DB,
@@ -701,6 +808,7 @@ func validateSchemaFieldNotDeleted(
 	var errs []error
 	for _, newSchema := range newState.schemaByName {
 		oldSchema := oldState.schemaByName[newSchema.Name]
+		isMigrated := isMigratedSchemaVersion(newState, newSchema.VersionID)
 
 		for _, oldField := range oldSchema.Fields {
 			stillExists := false
@@ -711,7 +819,7 @@ func validateSchemaFieldNotDeleted(
 				}
 			}
 
-			if !stillExists {
+			if !stillExists && (!isMigrated || !isFieldKindMutable(oldField.Kind)) {
 				errs = append(errs, NewErrCannotDeleteField(oldField.Name))
 			}
 		}
@@ -720,6 +828,72 @@ func validateSchemaFieldNotDeleted(
 	return errors.Join(errs...)
 }
 
+// isMigratedSchemaVersion returns true if the collection version of the given schema version
+// is sourced from its previous version via a lens migration.
+func isMigratedSchemaVersion(state *definitionState, schemaVersionID string) bool {
+	col, ok := state.collectionsByID[schemaVersionID]
+	if !ok {
+		return false
+	}
+
+	for _, source := range col.CollectionSources() {
+		if source.Transform.HasValue() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isFieldKindMutable returns true if fields of the given kind may be removed, or have their kind
+// changed, by a migrated schema update.
+//
+// Relation fields may not be changed as both sides of the relation would need to be updated together.
+func isFieldKindMutable(kind client.FieldKind) bool {
+	return kind != nil && !kind.IsObject() && kind != client.FieldKind_DocID
+}
+
+// isFieldKindWidening returns true if the values stored using the old kind are valid values of
+// the new kind, and can thus be read using the new kind without being migrated.
+//
+// Array values are strictly typed when decoded, so array kinds may not be changed.
+func isFieldKindWidening(oldKind client.FieldKind, newKind client.FieldKind) bool {
+	switch newKind {
+	case client.FieldKind_NILLABLE_FLOAT64:
+		return oldKind == client.FieldKind_NILLABLE_INT || oldKind == client.FieldKind_NILLABLE_FLOAT32
+
+	case client.FieldKind_NILLABLE_JSON:
+		switch oldKind {
+		case client.FieldKind_NILLABLE_BOOL,
+			client.FieldKind_NILLABLE_INT,
+			client.FieldKind_NILLABLE_FLOAT64,
+			client.FieldKind_NILLABLE_FLOAT32,
+			client.FieldKind_NILLABLE_STRING:
+			return true
+		}
+	}
+	return false
+}
+
+// isEnumKindAppended returns true if the new kind is the old enum kind with values appended to it.
+//
+// Enum values are stored as their ordinals, so values may only be appended, the values stored using
+// the old kind would otherwise refer to different values of the new kind.
+func isEnumKindAppended(oldKind client.FieldKind, newKind client.FieldKind) bool {
+	oldEnum, ok := oldKind.(*client.EnumKind)
+	if !ok {
+		return false
+	}
+	newEnum, ok := newKind.(*client.EnumKind)
+	if !ok {
+		return false
+	}
+
+	return oldEnum.Name == newEnum.Name &&
+		len(newEnum.Values) >= len(oldEnum.Values) &&
+		slices.Equal(oldEnum.Values, newEnum.Values[:len(oldEnum.Values)])
+}
+
 func validateTypeAndKindCompatible(
 	ctx context.Context,
 	db *DB,
@@ -858,13 +1032,17 @@ func validateFieldNotMoved(
 ) error {
 	var errs []error
 	for _, oldSchema := range oldState.schemaByName {
+		newSchema := newState.schemaByName[oldSchema.Name]
+
+		// Fields removed from the schema are skipped, so that the fields after them are not
+		// considered to have been moved.
 		oldFieldIndexesByName := map[string]int{}
-		for i, field := range oldSchema.Fields {
-			oldFieldIndexesByName[field.Name] = i
+		for _, field := range oldSchema.Fields {
+			if _, ok := newSchema.GetFieldByName(field.Name); ok {
+				oldFieldIndexesByName[field.Name] = len(oldFieldIndexesByName)
+			}
 		}
 
-		newSchema := newState.schemaByName[oldSchema.Name]
-
 		for newIndex, newField := range newSchema.Fields {
 			if existingIndex, exists := oldFieldIndexesByName[newField.Name]; exists && newIndex != existingIndex {
 				errs = append(errs, NewErrCannotMoveField(newField.Name, newIndex, existingIndex))
@@ -889,13 +1067,79 @@ func validateFieldNotMutated(
 		}
 
 		newSchema := newState.schemaByName[oldSchema.Name]
+		isMigrated := isMigratedSchemaVersion(newState, newSchema.VersionID)
 
 		for _, newField := range newSchema.Fields {
 			oldField, exists := oldFieldsByName[newField.Name]
 
 			// DeepEqual is temporary, as this validation is temporary
-			if exists && !reflect.DeepEqual(oldField, newField) {
+			if !exists || reflect.DeepEqual(oldField, newField) {
+				continue
+			}
+
+			// The kind of a field may be changed if a migration is provided, all other properties
+			// must remain the same.
+			fieldWithOldKind := newField
+			fieldWithOldKind.Kind = oldField.Kind
+			if isEnumKindAppended(oldField.Kind, newField.Kind) && reflect.DeepEqual(oldField, fieldWithOldKind) {
+				// Values may be appended to enums without a migration, as the stored values remain valid.
+				continue
+			}
+			if !isMigrated || !reflect.DeepEqual(oldField, fieldWithOldKind) {
 				errs = append(errs, NewErrCannotMutateField(newField.Name))
+				continue
+			}
+
+			// Documents are migrated when read, and migrations are not required to write the field,
+			// so the values stored using the old kind must remain valid values of the new kind.
+			if !isFieldKindMutable(oldField.Kind) || !isFieldKindMutable(newField.Kind) ||
+				!isFieldKindWidening(oldField.Kind, newField.Kind) {
+				errs = append(errs, NewErrCannotMutateFieldKind(newField.Name, oldField.Kind, newField.Kind))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateIndexedFieldsNotMutated returns an error if a field used by an index has been removed
+// from the schema, or has had its kind changed.
+//
+// The index would otherwise hold values that are no longer valid for the field.
+func validateIndexedFieldsNotMutated(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, newSchema := range newState.schemaByName {
+		oldSchema, ok := oldState.schemaByName[newSchema.Name]
+		if !ok || oldSchema.VersionID == newSchema.VersionID {
+			continue
+		}
+
+		col, ok := newState.collectionsByID[newSchema.VersionID]
+		if !ok {
+			continue
+		}
+
+		for _, index := range col.Indexes {
+			for _, indexedField := range index.Fields {
+				oldField, ok := oldSchema.GetFieldByName(indexedField.Name)
+				if !ok {
+					continue
+				}
+
+				newField, ok := newSchema.GetFieldByName(indexedField.Name)
+				if !ok {
+					errs = append(errs, NewErrCannotRemoveIndexedSchemaField(indexedField.Name, index.Name))
+					continue
+				}
+
+				if !reflect.DeepEqual(newField.Kind, oldField.Kind) && !isEnumKindAppended(oldField.Kind, newField.Kind) {
+					errs = append(errs, NewErrCannotMutateIndexedFieldKind(indexedField.Name, index.Name))
+				}
 			}
 		}
 	}
@@ -977,6 +1221,230 @@ func validateSelfReferences(
 	return errors.Join(errs...)
 }
 
+func validateRelationDeleteActionOnPrimary(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, newCollection := range newState.collections {
+		schema, ok := newState.schemaByID[newCollection.VersionID]
+		if !ok {
+			continue
+		}
+
+		definition := client.CollectionDefinition{
+			Version: newCollection,
+			Schema:  schema,
+		}
+
+		for _, field := range definition.GetFields() {
+			if field.OnDelete != client.RelationDeleteNone && !field.IsPrimaryRelation {
+				errs = append(errs, NewErrRelationDeleteActionOnSecondary(newCollection.Name, field.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateFieldConstraints(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, newCollection := range newState.collections {
+		schema, ok := newState.schemaByID[newCollection.VersionID]
+		if !ok {
+			continue
+		}
+
+		definition := client.CollectionDefinition{
+			Version: newCollection,
+			Schema:  schema,
+		}
+
+		for _, localField := range newCollection.Fields {
+			if localField.Constraints.IsEmpty() {
+				continue
+			}
+			field, ok := definition.GetFieldByName(localField.Name)
+			if !ok {
+				continue
+			}
+			errs = append(errs, checkFieldConstraints(newCollection.Name, field, localField.Constraints)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateComputedFields(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, newCollection := range newState.collections {
+		schema, ok := newState.schemaByID[newCollection.VersionID]
+		if !ok {
+			continue
+		}
+
+		definition := client.CollectionDefinition{
+			Version: newCollection,
+			Schema:  schema,
+		}
+
+		// The values of computed fields are not restricted by the field permissions of the fields
+		// they reference, they may thus not reference fields with field permissions.
+		permissionedFields := map[string]struct{}{}
+		if newCollection.Policy.HasValue() && db.documentACP.HasValue() {
+			fieldPermissions, err := db.documentACP.Value().FieldPermissions(
+				ctx,
+				newCollection.Policy.Value().ID,
+				newCollection.Policy.Value().ResourceName,
+			)
+			if err != nil {
+				// Invalid policies are reported by [validateCollectionDefinitionPolicyDesc].
+				continue
+			}
+			for _, fieldPermission := range fieldPermissions {
+				permissionedFields[fieldPermission.FieldName] = struct{}{}
+			}
+		}
+
+		for _, localField := range newCollection.Fields {
+			if !localField.Computed.HasValue() {
+				continue
+			}
+			errs = append(errs, checkComputedField(definition, localField, permissionedFields)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateComputedFieldsIndexedAreStored(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, col := range newState.collections {
+		for _, index := range col.Indexes {
+			err := checkIndexedFieldsStored(col, index.Fields)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkFieldConstraints returns the errors caused by the constraints of the given field.
+func checkFieldConstraints(
+	collectionName string,
+	field client.FieldDefinition,
+	constraints client.FieldConstraints,
+) []error {
+	var errs []error
+	notSupported := func(constraint string) {
+		errs = append(errs, NewErrFieldConstraintNotSupported(collectionName, field.Name, constraint, field.Kind))
+	}
+
+	if field.Kind.IsObject() {
+		notSupported(constraintsName)
+		return errs
+	}
+
+	isNumeric := field.Kind == client.FieldKind_NILLABLE_INT ||
+		field.Kind == client.FieldKind_NILLABLE_FLOAT64 ||
+		field.Kind == client.FieldKind_NILLABLE_FLOAT32
+	isCounter := field.Typ == client.PN_COUNTER || field.Typ == client.P_COUNTER
+	isString := field.Kind == client.FieldKind_NILLABLE_STRING
+
+	if constraints.Min.HasValue() && (!isNumeric || isCounter) {
+		notSupported(constraintMinName)
+	}
+	if constraints.Max.HasValue() && (!isNumeric || isCounter) {
+		notSupported(constraintMaxName)
+	}
+	if constraints.Min.HasValue() && constraints.Max.HasValue() && constraints.Min.Value() > constraints.Max.Value() {
+		errs = append(errs, NewErrInvalidFieldConstraint(
+			collectionName,
+			field.Name,
+			constraintMinName,
+			ErrConstraintMinGreaterThanMax,
+		))
+	}
+
+	if constraints.MinLength.HasValue() && !isString {
+		notSupported(constraintMinLengthName)
+	}
+	if constraints.MaxLength.HasValue() && !isString {
+		notSupported(constraintMaxLengthName)
+	}
+	if constraints.MinLength.HasValue() && constraints.MinLength.Value() < 0 {
+		errs = append(errs, NewErrInvalidFieldConstraint(
+			collectionName,
+			field.Name,
+			constraintMinLengthName,
+			ErrConstraintNegativeLength,
+		))
+	}
+	if constraints.MaxLength.HasValue() && constraints.MaxLength.Value() < 0 {
+		errs = append(errs, NewErrInvalidFieldConstraint(
+			collectionName,
+			field.Name,
+			constraintMaxLengthName,
+			ErrConstraintNegativeLength,
+		))
+	}
+	if constraints.MinLength.HasValue() && constraints.MaxLength.HasValue() &&
+		constraints.MinLength.Value() > constraints.MaxLength.Value() {
+		errs = append(errs, NewErrInvalidFieldConstraint(
+			collectionName,
+			field.Name,
+			constraintMinLengthName,
+			ErrConstraintMinGreaterThanMax,
+		))
+	}
+
+	if constraints.Pattern.HasValue() {
+		if !isString {
+			notSupported(constraintPatternName)
+		} else if _, err := regexp.Compile(constraints.Pattern.Value()); err != nil {
+			errs = append(errs, NewErrInvalidFieldConstraint(collectionName, field.Name, constraintPatternName, err))
+		}
+	}
+
+	if len(constraints.OneOf) > 0 {
+		if field.Kind != client.FieldKind_NILLABLE_INT && !isString {
+			notSupported(constraintOneOfName)
+		} else {
+			for _, value := range constraints.OneOf {
+				if _, ok := constraintValueFromAny(field.Kind, value); !ok {
+					errs = append(errs, NewErrInvalidFieldConstraint(
+						collectionName,
+						field.Name,
+						constraintOneOfName,
+						NewErrConstraintValueKindMismatch(value, field.Kind),
+					))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
 func validateSecondaryNotOnSchema(
 	ctx context.Context,
 	db *DB,
@@ -1127,10 +1595,64 @@ func validateCollectionMaterialized(
 	return errors.Join(errs...)
 }
 
-// validateMaterializedHasNoPolicy verifies that a materialized view has no ACP policy.
+// validateAutoRefreshedIsMaterializedView verifies that only materialized views are auto refreshed.
+func validateAutoRefreshedIsMaterializedView(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, col := range newState.collections {
+		if col.IsAutoRefreshed && (!col.IsMaterialized || len(col.QuerySources()) == 0) {
+			errs = append(errs, NewErrAutoRefreshedColNotMaterializedView(col.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateAutoRefreshedViewHasNoPolicy verifies that views with a policy are not auto refreshed.
 //
-// Long term we wish to support this, however for now we block it off.
-func validateMaterializedHasNoPolicy(
+// Auto refreshes are not made on behalf of an identity that may be checked against the policy of
+// the view, nor one whose access to the source documents the view is meant to reflect.
+func validateAutoRefreshedViewHasNoPolicy(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, col := range newState.collections {
+		if col.IsAutoRefreshed && col.Policy.HasValue() {
+			errs = append(errs, NewErrAutoRefreshedViewWithPolicy(col.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateIndexedViewIsMaterialized verifies that only materialized views are indexed, as the
+// items of other views are not stored.
+func validateIndexedViewIsMaterialized(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, col := range newState.collections {
+		if len(col.Indexes) != 0 && len(col.QuerySources()) != 0 && !col.IsMaterialized {
+			errs = append(errs, NewErrIndexedViewNotMaterialized(col.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateViewIndexesNotUnique verifies that views do not have unique indexes, as a refresh of
+// the view would fail should its items not be unique.
+func validateViewIndexesNotUnique(
 	ctx context.Context,
 	db *DB,
 	newState *definitionState,
@@ -1138,8 +1660,14 @@ func validateMaterializedHasNoPolicy(
 ) error {
 	var errs []error
 	for _, col := range newState.collections {
-		if col.IsMaterialized && len(col.QuerySources()) != 0 && col.Policy.HasValue() {
-			errs = append(errs, NewErrMaterializedViewAndACPNotSupported(col.Name))
+		if len(col.QuerySources()) == 0 {
+			continue
+		}
+		for _, index := range col.Indexes {
+			if index.Unique {
+				errs = append(errs, NewErrUniqueIndexOnView(col.Name))
+				break
+			}
 		}
 	}
 
@@ -1184,3 +1712,78 @@ func validateCollectionIsBranchableNotMutated(
 
 	return errors.Join(errs...)
 }
+
+// validateEncryptedFieldsSupported verifies that no relation field is declared as encrypted.
+func validateEncryptedFieldsSupported(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, def := range newState.definitionsByName {
+		for _, field := range def.GetFields() {
+			if field.IsEncrypted && field.Kind.IsObject() {
+				errs = append(errs, NewErrCanNotEncryptRelationField(def.Version.Name, field.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateEncryptedFieldsNotIndexed verifies that no index, other than blind ones, includes an encrypted field.
+func validateEncryptedFieldsNotIndexed(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	for _, col := range newState.collections {
+		for _, index := range col.Indexes {
+			if index.Blind {
+				// Blind indexes do not hold the values of the fields.
+				continue
+			}
+			err := checkIndexedFieldsNotEncrypted(col, index.Fields)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateEnumValuesConsistent verifies that all the enums of the same name hold the same values.
+//
+// Enums of the same name are represented by the same GQL type.
+func validateEnumValuesConsistent(
+	ctx context.Context,
+	db *DB,
+	newState *definitionState,
+	oldState *definitionState,
+) error {
+	var errs []error
+	enumsByName := map[string]*client.EnumKind{}
+	for _, schema := range newState.schemaByName {
+		for _, field := range schema.Fields {
+			enumKind, ok := field.Kind.(*client.EnumKind)
+			if !ok {
+				continue
+			}
+
+			existing, ok := enumsByName[enumKind.Name]
+			if !ok {
+				enumsByName[enumKind.Name] = enumKind
+				continue
+			}
+			if !slices.Equal(existing.Values, enumKind.Values) {
+				errs = append(errs, NewErrEnumValuesMismatch(enumKind.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -59,7 +59,6 @@ func getEmbeddingFunc(provider, model, url string) chromem.EmbeddingFunc {
 // setEmbedding sets the embedding fields on the document if the related fields are dirty.
 // However, if the vector field itself has been set, it will not be overwritten by a new embedding generation.
 func (c *collection) setEmbedding(ctx context.Context, doc *client.Document, isCreate bool) error {
-	embeddingGenerated := false
 	for _, embedding := range c.Version().VectorEmbeddings {
 		vecValue, err := doc.GetValue(embedding.FieldName)
 		if err != nil && !errors.Is(err, client.ErrFieldNotExist) {
@@ -133,15 +132,6 @@ func (c *collection) setEmbedding(ctx context.Context, doc *client.Document, isC
 		if err != nil {
 			return err
 		}
-		embeddingGenerated = true
-	}
-
-	// If an embedding was generated on create, we need to update the document ID.
-	if isCreate && embeddingGenerated {
-		err := doc.GenerateAndSetDocID()
-		if err != nil {
-			return err
-		}
 	}
 
 	return nil
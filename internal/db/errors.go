@@ -104,7 +104,6 @@ const (
 	errSelfReferenceWithoutSelf                 string = "must specify 'Self' kind for self referencing relations"
 	errColNotMaterialized                       string = "non-materialized collections are not supported"
 	errColMutatingIsBranchable                  string = "mutating IsBranchable is not supported"
-	errMaterializedViewAndACPNotSupported       string = "materialized views do not support ACP"
 	errInvalidDefaultFieldValue                 string = "default field value is invalid"
 	errDocIDNotFound                            string = "docID not found"
 	errCollectionWithSchemaRootNotFound         string = "collection with schema root not found"
@@ -123,6 +122,49 @@ const (
 	errNACIsEnabledButIsMissingPolicyInfo       string = "node acp is enabled, but is missing policy info"
 	errNACNodeObjectToGateIsNotRegistered       string = "node acp is enabled, but object to gate must be registered"
 	errNACIsEnabledButInstanceIsNotAvailable    string = "node acp is enabled, but the acp instance is not available"
+	errFieldUpdateNotAuthorized                 string = "not authorized to update field"
+	errPolicyFieldDoesNotExist                  string = "field of the field permission does not exist on collection"
+	errCollectionCreateNotAuthorized            string = "not authorized to create documents in collection"
+	errPolicyFieldRelationInvalidField          string = "field of the policy relation must be a String or [String] field"
+	errPolicyFieldRelationCanNotBeOwner         string = "policy relation derived from a field can not be the owner relation"
+	errRelationshipBatchHasNoDocuments          string = "relationship batch must have docIDs or a filter"
+	errRelationshipBatchHasEmptyDocID           string = "relationship batch has an empty docID"
+	errRelationshipBatchFailed                  string = "relationship batch failed, changes were reverted"
+	errRelationshipBatchRevertFailed            string = "relationship batch failed, some changes could not be reverted"
+	errRelationshipBatchDocNotRegistered        string = "relationship batch document is not registered with acp"
+	errACPAuditLogNotEnabled                    string = "acp audit log is not enabled"
+	errACPAuditLogRequiresNodeIdentity          string = "acp audit log can only be read with the node identity"
+	errCanNotEncryptRelationField               string = "can not encrypt relation field"
+	errCanNotIndexEncryptedField                string = "can not index encrypted field"
+	errBlindIndexWithMultipleFields             string = "blind index can not have multiple fields"
+	errUnsupportedBlindIndexFieldType           string = "unsupported blind index field type"
+	errBlindIndexWithoutSecret                  string = "can not create blind index, no blind index secret is configured"
+	errCannotMutateFieldKind                    string = "changing the kind of this field is not supported"
+	errCannotRemoveIndexedSchemaField           string = "removing an indexed field is not supported"
+	errCannotMutateIndexedFieldKind             string = "changing the kind of an indexed field is not supported"
+	errInvalidMigrationBatchSize                string = "migration batch size must be greater than zero"
+	errAutoRefreshedColNotMaterializedView      string = "only materialized views may be auto refreshed"
+	errIndexedViewNotMaterialized               string = "only materialized views may be indexed"
+	errAutoRefreshedViewWithPolicy              string = "views with a policy may not be auto refreshed"
+	errUniqueIndexOnView                        string = "unique indexes are not supported on views"
+	errJoinCollectionMissing                    string = "many-to-many relation is missing its join collection"
+	errRelationDeleteActionOnSecondary          string = "onDelete may only be set on the primary side of a relation"
+	errDeleteRestricted                         string = "document is referenced by another document and may not be deleted"
+	errDeleteActionNotAuthorized                string = "not authorized to apply the delete action to a referencing document"
+	errFieldConstraintNotSupported              string = "constraint is not supported by the kind of this field"
+	errInvalidFieldConstraint                   string = "invalid field constraint"
+	errFieldConstraintViolated                  string = "field value violates constraint"
+	errConstraintValueKindMismatch              string = "constraint value does not match the kind of the field"
+	errComputedFieldKindNotSupported            string = "computed fields of this kind are not supported"
+	errComputedFieldWithDefaultValue            string = "computed fields may not have a default value"
+	errInvalidComputedExpression                string = "invalid computed field expression"
+	errInvalidComputedFieldReference            string = "computed field expressions may only reference non-computed scalar fields"
+	errComputedExpressionKindMismatch           string = "computed field expression does not match the kind of the field"
+	errRestrictedComputedFieldReference         string = "computed field expressions may not reference encrypted or field permissioned fields"
+	errCanNotIndexNotStoredComputedField        string = "computed fields that are not stored may not be indexed"
+	errCanNotSetComputedField                   string = "computed fields may not be set"
+	errFailedToComputeField                     string = "failed to compute field"
+	errEnumValuesMismatch                       string = "enums of the same name must hold the same values"
 )
 
 var (
@@ -162,7 +204,6 @@ var (
 	ErrCanNotEncryptBuiltinField                = errors.New(errCanNotEncryptBuiltinField)
 	ErrSelfReferenceWithoutSelf                 = errors.New(errSelfReferenceWithoutSelf)
 	ErrColNotMaterialized                       = errors.New(errColNotMaterialized)
-	ErrMaterializedViewAndACPNotSupported       = errors.New(errMaterializedViewAndACPNotSupported)
 	ErrDocIDNotFound                            = errors.New(errDocIDNotFound)
 	ErrorCollectionWithSchemaRootNotFound       = errors.New(errCollectionWithSchemaRootNotFound)
 	ErrColMutatingIsBranchable                  = errors.New(errColMutatingIsBranchable)
@@ -182,6 +223,51 @@ var (
 	ErrNACNodeObjectToGateIsNotRegistered       = errors.New(errNACNodeObjectToGateIsNotRegistered)
 	ErrNACIsEnabledButInstanceIsNotAvailable    = errors.New(errNACIsEnabledButInstanceIsNotAvailable)
 	ErrNACRelationshipOperationRequiresIdentity = errors.New("node acp relationship operation requires identity")
+	ErrFieldUpdateNotAuthorized                 = errors.New(errFieldUpdateNotAuthorized)
+	ErrPolicyFieldDoesNotExist                  = errors.New(errPolicyFieldDoesNotExist)
+	ErrCollectionCreateNotAuthorized            = errors.New(errCollectionCreateNotAuthorized)
+	ErrPolicyFieldRelationInvalidField          = errors.New(errPolicyFieldRelationInvalidField)
+	ErrPolicyFieldRelationCanNotBeOwner         = errors.New(errPolicyFieldRelationCanNotBeOwner)
+	ErrRelationshipBatchHasNoDocuments          = errors.New(errRelationshipBatchHasNoDocuments)
+	ErrRelationshipBatchHasEmptyDocID           = errors.New(errRelationshipBatchHasEmptyDocID)
+	ErrRelationshipBatchFailed                  = errors.New(errRelationshipBatchFailed)
+	ErrRelationshipBatchRevertFailed            = errors.New(errRelationshipBatchRevertFailed)
+	ErrRelationshipBatchDocNotRegistered        = errors.New(errRelationshipBatchDocNotRegistered)
+	ErrACPAuditLogNotEnabled                    = errors.New(errACPAuditLogNotEnabled)
+	ErrACPAuditLogRequiresNodeIdentity          = errors.New(errACPAuditLogRequiresNodeIdentity)
+	ErrCanNotEncryptRelationField               = errors.New(errCanNotEncryptRelationField)
+	ErrCanNotIndexEncryptedField                = errors.New(errCanNotIndexEncryptedField)
+	ErrBlindIndexWithMultipleFields             = errors.New(errBlindIndexWithMultipleFields)
+	ErrUnsupportedBlindIndexFieldType           = errors.New(errUnsupportedBlindIndexFieldType)
+	ErrBlindIndexWithoutSecret                  = errors.New(errBlindIndexWithoutSecret)
+	ErrCannotMutateFieldKind                    = errors.New(errCannotMutateFieldKind)
+	ErrCannotRemoveIndexedSchemaField           = errors.New(errCannotRemoveIndexedSchemaField)
+	ErrCannotMutateIndexedFieldKind             = errors.New(errCannotMutateIndexedFieldKind)
+	ErrInvalidMigrationBatchSize                = errors.New(errInvalidMigrationBatchSize)
+	ErrAutoRefreshedColNotMaterializedView      = errors.New(errAutoRefreshedColNotMaterializedView)
+	ErrIndexedViewNotMaterialized               = errors.New(errIndexedViewNotMaterialized)
+	ErrAutoRefreshedViewWithPolicy              = errors.New(errAutoRefreshedViewWithPolicy)
+	ErrUniqueIndexOnView                        = errors.New(errUniqueIndexOnView)
+	ErrJoinCollectionMissing                    = errors.New(errJoinCollectionMissing)
+	ErrRelationDeleteActionOnSecondary          = errors.New(errRelationDeleteActionOnSecondary)
+	ErrDeleteRestricted                         = errors.New(errDeleteRestricted)
+	ErrDeleteActionNotAuthorized                = errors.New(errDeleteActionNotAuthorized)
+	ErrFieldConstraintNotSupported              = errors.New(errFieldConstraintNotSupported)
+	ErrInvalidFieldConstraint                   = errors.New(errInvalidFieldConstraint)
+	ErrFieldConstraintViolated                  = errors.New(errFieldConstraintViolated)
+	ErrConstraintValueKindMismatch              = errors.New(errConstraintValueKindMismatch)
+	ErrComputedFieldKindNotSupported            = errors.New(errComputedFieldKindNotSupported)
+	ErrComputedFieldWithDefaultValue            = errors.New(errComputedFieldWithDefaultValue)
+	ErrInvalidComputedExpression                = errors.New(errInvalidComputedExpression)
+	ErrInvalidComputedFieldReference            = errors.New(errInvalidComputedFieldReference)
+	ErrComputedExpressionKindMismatch           = errors.New(errComputedExpressionKindMismatch)
+	ErrRestrictedComputedFieldReference         = errors.New(errRestrictedComputedFieldReference)
+	ErrCanNotIndexNotStoredComputedField        = errors.New(errCanNotIndexNotStoredComputedField)
+	ErrCanNotSetComputedField                   = errors.New(errCanNotSetComputedField)
+	ErrFailedToComputeField                     = errors.New(errFailedToComputeField)
+	ErrEnumValuesMismatch                       = errors.New(errEnumValuesMismatch)
+	ErrConstraintMinGreaterThanMax              = errors.New("constraint minimum is greater than its maximum")
+	ErrConstraintNegativeLength                 = errors.New("constraint length may not be negative")
 )
 
 // NewErrFailedToGetHeads returns a new error indicating that the heads of a document
@@ -371,6 +457,26 @@ func NewErrCanNotEncryptBuiltinField(name string) error {
 	return errors.New(errCanNotEncryptBuiltinField, errors.NewKV("Name", name))
 }
 
+// NewErrCanNotEncryptRelationField returns an error indicating that a relation field
+// has been declared as encrypted.
+func NewErrCanNotEncryptRelationField(collectionName string, fieldName string) error {
+	return errors.New(
+		errCanNotEncryptRelationField,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrCanNotIndexEncryptedField returns an error indicating that an index includes a field
+// whose values are encrypted.
+func NewErrCanNotIndexEncryptedField(collectionName string, fieldName string) error {
+	return errors.New(
+		errCanNotIndexEncryptedField,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
 func NewErrCannotDeleteField(name string) error {
 	return errors.New(
 		errCannotDeleteField,
@@ -704,13 +810,6 @@ func NewErrColMutatingIsBranchable(collection string) error {
 	)
 }
 
-func NewErrMaterializedViewAndACPNotSupported(collection string) error {
-	return errors.New(
-		errMaterializedViewAndACPNotSupported,
-		errors.NewKV("Collection", collection),
-	)
-}
-
 func NewErrDefaultFieldValueInvalid(collection string, inner error) error {
 	return errors.New(
 		errInvalidDefaultFieldValue,
@@ -754,3 +853,371 @@ func NewErrCollectionNameMutated(newName string, oldName string) error {
 func NewErrUnsupportedTxnType(actual any) error {
 	return errors.New(errUnsupportedTxnType, errors.NewKV("Actual", fmt.Sprintf("%T", actual)))
 }
+
+// NewErrFieldUpdateNotAuthorized returns an error indicating that the identity does not have
+// the field scoped update permission of a field it attempted to update.
+func NewErrFieldUpdateNotAuthorized(docID string, fieldName string) error {
+	return errors.New(
+		errFieldUpdateNotAuthorized,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrPolicyFieldDoesNotExist returns an error indicating that a field scoped permission
+// of the collection policy targets a field that does not exist.
+func NewErrPolicyFieldDoesNotExist(collectionName string, permission string) error {
+	return errors.New(
+		errPolicyFieldDoesNotExist,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Permission", permission),
+	)
+}
+
+// NewErrCollectionCreateNotAuthorized returns an error indicating that the identity does not have
+// the collection scoped create permission of the collection it attempted to create a document in.
+func NewErrCollectionCreateNotAuthorized(collectionName string) error {
+	return errors.New(
+		errCollectionCreateNotAuthorized,
+		errors.NewKV("Collection", collectionName),
+	)
+}
+
+// NewErrPolicyFieldRelationInvalidField returns an error indicating that a relation derived from a
+// field targets a field that does not exist or can not hold actors.
+func NewErrPolicyFieldRelationInvalidField(collectionName string, fieldName string) error {
+	return errors.New(
+		errPolicyFieldRelationInvalidField,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrPolicyFieldRelationCanNotBeOwner returns an error indicating that a relation derived from a
+// field is the owner relation, which can only be given by registering the document.
+func NewErrPolicyFieldRelationCanNotBeOwner(collectionName string, fieldName string) error {
+	return errors.New(
+		errPolicyFieldRelationCanNotBeOwner,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrRelationshipBatchFailed returns an error indicating that the relationship of a document
+// within a batch could not be changed, and the changes of the batch were reverted.
+func NewErrRelationshipBatchFailed(inner error, docID string) error {
+	return errors.Wrap(errRelationshipBatchFailed, inner, errors.NewKV("DocID", docID))
+}
+
+// NewErrRelationshipBatchRevertFailed returns an error indicating that the relationship of a document
+// within a batch could not be changed, and that the changes of the batch made to the relationships of
+// the given documents could not be reverted.
+func NewErrRelationshipBatchRevertFailed(inner error, docID string, notRevertedDocIDs []string) error {
+	return errors.Wrap(
+		errRelationshipBatchRevertFailed,
+		inner,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("NotRevertedDocIDs", notRevertedDocIDs),
+	)
+}
+
+// NewErrBlindIndexWithMultipleFields returns an error indicating that a blind index was declared
+// on more than one field.
+func NewErrBlindIndexWithMultipleFields(collectionName string) error {
+	return errors.New(errBlindIndexWithMultipleFields, errors.NewKV("Collection", collectionName))
+}
+
+// NewErrUnsupportedBlindIndexFieldType returns an error indicating that the kind of the given field
+// can not be indexed by a blind index.
+func NewErrUnsupportedBlindIndexFieldType(fieldName string, kind client.FieldKind) error {
+	return errors.New(
+		errUnsupportedBlindIndexFieldType,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrBlindIndexWithoutSecret returns an error indicating that a blind index was declared on
+// the given collection while the database has no blind index secret.
+func NewErrBlindIndexWithoutSecret(collectionName string) error {
+	return errors.New(errBlindIndexWithoutSecret, errors.NewKV("Collection", collectionName))
+}
+
+// NewErrCannotMutateFieldKind returns an error indicating that the kind of the given field can not
+// be changed from the old kind to the new one, even with a migration.
+func NewErrCannotMutateFieldKind(name string, oldKind client.FieldKind, newKind client.FieldKind) error {
+	return errors.New(
+		errCannotMutateFieldKind,
+		errors.NewKV("Name", name),
+		errors.NewKV("OldKind", oldKind),
+		errors.NewKV("NewKind", newKind),
+	)
+}
+
+// NewErrCannotRemoveIndexedSchemaField returns an error indicating that the given field can not be
+// removed from the schema as it is used by an index.
+func NewErrCannotRemoveIndexedSchemaField(fieldName string, indexName string) error {
+	return errors.New(
+		errCannotRemoveIndexedSchemaField,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Index", indexName),
+	)
+}
+
+// NewErrCannotMutateIndexedFieldKind returns an error indicating that the kind of the given field
+// can not be changed as it is used by an index.
+func NewErrCannotMutateIndexedFieldKind(fieldName string, indexName string) error {
+	return errors.New(
+		errCannotMutateIndexedFieldKind,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Index", indexName),
+	)
+}
+
+// NewErrInvalidMigrationBatchSize returns an error indicating that the given document migration batch
+// size is invalid.
+func NewErrInvalidMigrationBatchSize(batchSize int) error {
+	return errors.New(
+		errInvalidMigrationBatchSize,
+		errors.NewKV("BatchSize", batchSize),
+	)
+}
+
+// NewErrAutoRefreshedColNotMaterializedView returns an error indicating that the given collection
+// is set to be auto refreshed but is not a materialized view.
+func NewErrAutoRefreshedColNotMaterializedView(collection string) error {
+	return errors.New(
+		errAutoRefreshedColNotMaterializedView,
+		errors.NewKV("Collection", collection),
+	)
+}
+
+// NewErrIndexedViewNotMaterialized returns an error indicating that the given view has indexes
+// but is not materialized.
+func NewErrIndexedViewNotMaterialized(collection string) error {
+	return errors.New(
+		errIndexedViewNotMaterialized,
+		errors.NewKV("Collection", collection),
+	)
+}
+
+// NewErrAutoRefreshedViewWithPolicy returns an error indicating that the given view is set to be
+// auto refreshed but has a policy.
+func NewErrAutoRefreshedViewWithPolicy(collection string) error {
+	return errors.New(
+		errAutoRefreshedViewWithPolicy,
+		errors.NewKV("Collection", collection),
+	)
+}
+
+// NewErrUniqueIndexOnView returns an error indicating that the given view has a unique index.
+func NewErrUniqueIndexOnView(collection string) error {
+	return errors.New(
+		errUniqueIndexOnView,
+		errors.NewKV("Collection", collection),
+	)
+}
+
+// NewErrJoinCollectionMissing returns an error indicating that the join collection holding the links
+// of the given many-to-many relation was not found.
+func NewErrJoinCollectionMissing(relationName string) error {
+	return errors.New(
+		errJoinCollectionMissing,
+		errors.NewKV("RelationName", relationName),
+		errors.NewKV("JoinCollection", client.JoinCollectionName(relationName)),
+	)
+}
+
+// NewErrRelationDeleteActionOnSecondary returns an error indicating that an onDelete action has been
+// set on a field that is not the primary side of a relation.
+func NewErrRelationDeleteActionOnSecondary(collection string, fieldName string) error {
+	return errors.New(
+		errRelationDeleteActionOnSecondary,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrDeleteRestricted returns an error indicating that the document with the given ID may not be
+// deleted, as it is referenced by another document through a relation with a RESTRICT delete action.
+//
+// The ID of the referencing document is omitted if it is empty.
+func NewErrDeleteRestricted(docID string, collection string, fieldName string, referencingDocID string) error {
+	kvs := []errors.KV{
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+	}
+	if referencingDocID != "" {
+		kvs = append(kvs, errors.NewKV("ReferencingDocID", referencingDocID))
+	}
+	return errors.New(errDeleteRestricted, kvs...)
+}
+
+// NewErrDeleteActionNotAuthorized returns an error indicating that the document with the given ID may
+// not be deleted, as the identity is not authorized to apply the delete action of the given relation
+// field to a document referencing it.
+func NewErrDeleteActionNotAuthorized(docID string, collection string, fieldName string) error {
+	return errors.New(
+		errDeleteActionNotAuthorized,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrFieldConstraintNotSupported returns an error indicating that the given constraint may not be
+// applied to a field of the given kind.
+func NewErrFieldConstraintNotSupported(
+	collection string,
+	fieldName string,
+	constraint string,
+	kind client.FieldKind,
+) error {
+	return errors.New(
+		errFieldConstraintNotSupported,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Constraint", constraint),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrInvalidFieldConstraint returns an error indicating that the given constraint of a field is
+// not valid.
+func NewErrInvalidFieldConstraint(collection string, fieldName string, constraint string, inner error) error {
+	return errors.Wrap(
+		errInvalidFieldConstraint,
+		inner,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Constraint", constraint),
+	)
+}
+
+// NewErrFieldConstraintViolated returns an error indicating that the value of a field of the document
+// with the given ID violates the given constraint.
+func NewErrFieldConstraintViolated(docID string, fieldName string, constraint string, value any) error {
+	return errors.New(
+		errFieldConstraintViolated,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Constraint", constraint),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrConstraintValueKindMismatch returns an error indicating that the given constraint value does
+// not match the given kind of the constrained field.
+func NewErrConstraintValueKindMismatch(value any, kind client.FieldKind) error {
+	return errors.New(
+		errConstraintValueKindMismatch,
+		errors.NewKV("Value", value),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrComputedFieldKindNotSupported returns an error indicating that the given field may not be
+// computed as its kind is not supported.
+func NewErrComputedFieldKindNotSupported(collection string, fieldName string, kind client.FieldKind) error {
+	return errors.New(
+		errComputedFieldKindNotSupported,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrComputedFieldWithDefaultValue returns an error indicating that the given computed field
+// has a default value.
+func NewErrComputedFieldWithDefaultValue(collection string, fieldName string) error {
+	return errors.New(
+		errComputedFieldWithDefaultValue,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrInvalidComputedExpression returns an error indicating that the expression of the given
+// computed field is not valid.
+func NewErrInvalidComputedExpression(collection string, fieldName string, expression string, inner error) error {
+	return errors.Wrap(
+		errInvalidComputedExpression,
+		inner,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Expression", expression),
+	)
+}
+
+// NewErrInvalidComputedFieldReference returns an error indicating that the expression of the given
+// computed field references a field that does not exist, is computed, or is not of a supported kind.
+func NewErrInvalidComputedFieldReference(collection string, fieldName string, referencedField string) error {
+	return errors.New(
+		errInvalidComputedFieldReference,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("ReferencedField", referencedField),
+	)
+}
+
+// NewErrRestrictedComputedFieldReference returns an error indicating that the expression of the given
+// computed field references a field whose value is encrypted, or restricted by a field permission.
+func NewErrRestrictedComputedFieldReference(collection string, fieldName string, referencedField string) error {
+	return errors.New(
+		errRestrictedComputedFieldReference,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("ReferencedField", referencedField),
+	)
+}
+
+// NewErrComputedExpressionKindMismatch returns an error indicating that the values yielded by the
+// expression of the given computed field do not match the kind of the field.
+func NewErrComputedExpressionKindMismatch(
+	collection string,
+	fieldName string,
+	expressionType string,
+	kind client.FieldKind,
+) error {
+	return errors.New(
+		errComputedExpressionKindMismatch,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("ExpressionType", expressionType),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrCanNotIndexNotStoredComputedField returns an error indicating that the given computed
+// field may not be indexed, as its value is only computed when the document is read.
+func NewErrCanNotIndexNotStoredComputedField(collection string, fieldName string) error {
+	return errors.New(
+		errCanNotIndexNotStoredComputedField,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrCanNotSetComputedField returns an error indicating that a value was given for the given
+// computed field.
+func NewErrCanNotSetComputedField(fieldName string) error {
+	return errors.New(errCanNotSetComputedField, errors.NewKV("Field", fieldName))
+}
+
+// NewErrFailedToComputeField returns an error indicating that the value of the given computed field
+// could not be computed for the document with the given ID.
+func NewErrFailedToComputeField(docID string, fieldName string, inner error) error {
+	return errors.Wrap(
+		errFailedToComputeField,
+		inner,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrEnumValuesMismatch returns an error indicating that fields hold enums of the given name with
+// different values.
+func NewErrEnumValuesMismatch(name string) error {
+	return errors.New(errEnumValuesMismatch, errors.NewKV("Name", name))
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fetcher
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/expr"
+)
+
+// computedField is a computed field whose value is computed when documents are read.
+type computedField struct {
+	definition client.FieldDefinition
+	expression *expr.Expression
+}
+
+// computedExpressionSource is implemented by the collections holding the parsed expressions of
+// their computed fields, so that they need not be parsed each time documents are fetched.
+type computedExpressionSource interface {
+	ComputedExpression(field client.CollectionFieldDescription) (*expr.Expression, error)
+}
+
+// getComputedExpression returns the parsed expression of the given computed field of the given
+// collection.
+func getComputedExpression(
+	col client.Collection,
+	field client.CollectionFieldDescription,
+) (*expr.Expression, error) {
+	if source, ok := col.(computedExpressionSource); ok {
+		return source.ComputedExpression(field)
+	}
+	return expr.Parse(field.Computed.Value().Expression)
+}
+
+// computedFetcher adds the values of computed fields that are not stored to the documents fetched.
+//
+// The fields referenced by the computed fields must be fetched by the inner fetcher.
+type computedFetcher struct {
+	fields  []computedField
+	fetcher fetcher
+}
+
+var _ fetcher = (*computedFetcher)(nil)
+
+func newComputedFetcher(fields []computedField, fetcher fetcher) *computedFetcher {
+	return &computedFetcher{
+		fields:  fields,
+		fetcher: fetcher,
+	}
+}
+
+func (f *computedFetcher) NextDoc() (immutable.Option[string], error) {
+	return f.fetcher.NextDoc()
+}
+
+func (f *computedFetcher) GetFields() (immutable.Option[EncodedDocument], error) {
+	doc, err := f.fetcher.GetFields()
+	if err != nil || !doc.HasValue() {
+		return doc, err
+	}
+
+	return immutable.Some[EncodedDocument](&computedDocument{
+		EncodedDocument: doc.Value(),
+		fields:          f.fields,
+	}), nil
+}
+
+func (f *computedFetcher) Close() error {
+	return f.fetcher.Close()
+}
+
+// computedDocument is an encoded document with the properties of the computed fields that are
+// not stored.
+type computedDocument struct {
+	EncodedDocument
+
+	fields []computedField
+}
+
+var _ EncodedDocument = (*computedDocument)(nil)
+
+func (doc *computedDocument) Properties(onlyFilterProps bool) (map[client.FieldDefinition]any, error) {
+	properties, err := doc.EncodedDocument.Properties(onlyFilterProps)
+	if err != nil {
+		return nil, err
+	}
+
+	// The computed values must be computed from all the properties of the document, not just the
+	// filter properties.
+	allProperties, err := doc.EncodedDocument.Properties(false)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any, len(allProperties))
+	for field, value := range allProperties {
+		values[field.Name] = value
+	}
+
+	for _, field := range doc.fields {
+		value, err := field.expression.Eval(values)
+		if err != nil {
+			return nil, NewErrFailedToComputeField(string(doc.ID()), field.definition.Name, err)
+		}
+		if value == nil {
+			continue
+		}
+		value, err = core.NormalizeFieldValue(field.definition, value)
+		if err != nil {
+			return nil, NewErrFailedToComputeField(string(doc.ID()), field.definition.Name, err)
+		}
+		properties[field.definition] = value
+	}
+
+	return properties, nil
+}
@@ -32,6 +32,7 @@ const (
 	errInvalidFilterOperator      string = "invalid filter operator is provided"
 	errNotSupportedKindByIndex    string = "kind is not supported by index"
 	errUnexpectedTypeValue        string = "unexpected type value"
+	errFailedToComputeField       string = "failed to compute field"
 )
 
 var (
@@ -48,6 +49,7 @@ var (
 	ErrInvalidInOperatorValue     = errors.New(errInvalidInOperatorValue)
 	ErrInvalidFilterOperator      = errors.New(errInvalidFilterOperator)
 	ErrUnexpectedTypeValue        = errors.New(errUnexpectedTypeValue)
+	ErrFailedToComputeField       = errors.New(errFailedToComputeField)
 )
 
 // NewErrFieldIdNotFound returns an error indicating that the given FieldId was not found.
@@ -116,3 +118,14 @@ func NewErrUnexpectedTypeValue[T any](value any) error {
 	var t T
 	return errors.New(errUnexpectedTypeValue, errors.NewKV("Value", value), errors.NewKV("Type", fmt.Sprintf("%T", t)))
 }
+
+// NewErrFailedToComputeField returns an error indicating that the value of the given computed field
+// could not be computed for the document with the given ID.
+func NewErrFailedToComputeField(docID string, fieldName string, inner error) error {
+	return errors.Wrap(
+		errFailedToComputeField,
+		inner,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Field", fieldName),
+	)
+}
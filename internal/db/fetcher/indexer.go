@@ -163,8 +163,8 @@ func CanBeOrderedByIndex(
 	mapping *core.DocumentMapping,
 ) (bool, bool) {
 	// if there is no ordering in the query or the query requests ordering on more fields, then index
-	// contains, we can't use index
-	if len(ordering) == 0 || len(ordering) > len(index.Fields) {
+	// contains, we can't use index. Blind indexes hold hashes of the values, so they can't be used either.
+	if len(ordering) == 0 || len(ordering) > len(index.Fields) || index.Blind {
 		return false, false
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/connor"
 	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/encryption"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/planner/filter"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
@@ -653,6 +654,10 @@ func (f *indexFetcher) createIndexIterator() (indexIterator, error) {
 		return f.tryCreateOrderedIndexIterator()
 	}
 
+	if f.indexDesc.Blind {
+		return f.createBlindIndexIterator(fieldConditions)
+	}
+
 	matchers, err := createValueMatchers(fieldConditions)
 	if err != nil {
 		return nil, err
@@ -704,6 +709,60 @@ func (f *indexFetcher) createIndexIterator() (indexIterator, error) {
 	return iter, nil
 }
 
+// blindIndexCollection is a collection that can compute the values of its blind indexes.
+type blindIndexCollection interface {
+	BlindIndexValue(value client.NormalValue) (client.NormalValue, error)
+}
+
+// createBlindIndexIterator creates an iterator over the blind index for the given conditions.
+//
+// Blind indexes hold hashes of the values, so only equality conditions can use them by hashing
+// their values the same way. It returns nil if the conditions can not use the index.
+func (f *indexFetcher) createBlindIndexIterator(fieldConditions []fieldFilterCond) (indexIterator, error) {
+	cond := &fieldConditions[0]
+	if cond.arrOp != "" || len(cond.jsonPath) > 0 {
+		return nil, nil
+	}
+
+	col, ok := f.col.(blindIndexCollection)
+	if !ok {
+		return nil, encryption.ErrBlindIndexSecretNotSet
+	}
+	switch cond.op {
+	case opEq:
+		val, err := col.BlindIndexValue(cond.val)
+		if err != nil {
+			return nil, err
+		}
+		cond.val = val
+		if isUniqueFetchByFullKey(&f.indexDesc, fieldConditions) {
+			return f.newEqSingleIndexIterator(val, fieldConditions)
+		}
+		return f.newPrefixBasedMatchIteratorFromConditions(fieldConditions, nil)
+
+	case opIn:
+		inValues, err := client.ToArrayOfNormalValues(cond.val)
+		if err != nil {
+			return nil, NewErrInvalidInOperatorValue(err)
+		}
+		for i := range inValues {
+			inValues[i], err = col.BlindIndexValue(inValues[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &inIndexIterator{
+			inValues:        inValues,
+			fetcher:         f,
+			fieldConditions: fieldConditions,
+			isUnique:        isUniqueFetchByFullKey(&f.indexDesc, fieldConditions),
+		}, nil
+
+	default:
+		return nil, nil
+	}
+}
+
 func doConditionsHaveArrayOrJSON(conditions []fieldFilterCond) bool {
 	hasArray := false
 	hasJSON := false
@@ -23,12 +23,19 @@ import (
 )
 
 // permissionedFetcher fetcher applies access control based filtering to documents fetched.
+//
+// The fields restricted by a field scoped read permission are masked from the documents
+// fetched if the identity does not have that permission.
 type permissionedFetcher struct {
 	ctx context.Context
 
-	identity    immutable.Option[acpIdentity.Identity]
-	documentACP dac.DocumentACP
-	col         client.Collection
+	identity         immutable.Option[acpIdentity.Identity]
+	documentACP      dac.DocumentACP
+	col              client.Collection
+	fieldPermissions []acpTypes.FieldResourcePermission
+
+	// deniedFields are the fields of the current document the identity can not read.
+	deniedFields map[string]struct{}
 
 	fetcher fetcher
 }
@@ -40,14 +47,16 @@ func newPermissionedFetcher(
 	identity immutable.Option[acpIdentity.Identity],
 	documentACP dac.DocumentACP,
 	col client.Collection,
+	fieldPermissions []acpTypes.FieldResourcePermission,
 	fetcher fetcher,
 ) *permissionedFetcher {
 	return &permissionedFetcher{
-		ctx:         ctx,
-		identity:    identity,
-		documentACP: documentACP,
-		col:         col,
-		fetcher:     fetcher,
+		ctx:              ctx,
+		identity:         identity,
+		documentACP:      documentACP,
+		col:              col,
+		fieldPermissions: fieldPermissions,
+		fetcher:          fetcher,
 	}
 }
 
@@ -67,6 +76,7 @@ func (f *permissionedFetcher) NextDoc() (immutable.Option[string], error) {
 		f.documentACP,
 		f.col,
 		acpTypes.DocumentReadPerm,
+		permission.OperationFromContext(f.ctx),
 		docID.Value(),
 	)
 	if err != nil {
@@ -77,13 +87,60 @@ func (f *permissionedFetcher) NextDoc() (immutable.Option[string], error) {
 		return f.NextDoc()
 	}
 
+	f.deniedFields, err = permission.DeniedFieldsOfDocOnCollectionWithACP(
+		f.ctx,
+		f.identity,
+		f.documentACP,
+		f.col,
+		acpTypes.DocumentReadPerm,
+		permission.OperationFromContext(f.ctx),
+		f.fieldPermissions,
+		docID.Value(),
+	)
+	if err != nil {
+		return immutable.None[string](), err
+	}
+
 	return docID, nil
 }
 
 func (f *permissionedFetcher) GetFields() (immutable.Option[EncodedDocument], error) {
-	return f.fetcher.GetFields()
+	doc, err := f.fetcher.GetFields()
+	if err != nil || !doc.HasValue() || len(f.deniedFields) == 0 {
+		return doc, err
+	}
+
+	return immutable.Some[EncodedDocument](&maskedDocument{
+		EncodedDocument: doc.Value(),
+		deniedFields:    f.deniedFields,
+	}), nil
 }
 
 func (f *permissionedFetcher) Close() error {
 	return f.fetcher.Close()
 }
+
+// maskedDocument is an encoded document without the properties of the fields that
+// the identity is not permitted to read.
+type maskedDocument struct {
+	EncodedDocument
+
+	deniedFields map[string]struct{}
+}
+
+var _ EncodedDocument = (*maskedDocument)(nil)
+
+func (doc *maskedDocument) Properties(onlyFilterProps bool) (map[client.FieldDefinition]any, error) {
+	properties, err := doc.EncodedDocument.Properties(onlyFilterProps)
+	if err != nil {
+		return nil, err
+	}
+
+	for field := range properties {
+		if permission.IsFieldDenied(doc.deniedFields, field.Name) {
+			delete(properties, field)
+		}
+	}
+
+	return properties, nil
+}
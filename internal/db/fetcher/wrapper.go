@@ -21,6 +21,7 @@ import (
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/datastore"
 	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 	"github.com/sourcenetwork/defradb/internal/request/graphql/parser"
@@ -124,6 +125,11 @@ func (f *wrappingFetcher) Start(ctx context.Context, prefixes ...keys.Walkable)
 		f.fields = f.col.Definition().GetFields()
 	}
 
+	computedFields, err := f.addComputedFields()
+	if err != nil {
+		return err
+	}
+
 	fieldsByID := make(map[uint32]client.FieldDefinition, len(f.fields))
 	for _, field := range f.fields {
 		fieldShortID, err := id.GetShortFieldID(ctx, colShortID, field.Name)
@@ -166,8 +172,16 @@ func (f *wrappingFetcher) Start(ctx context.Context, prefixes ...keys.Walkable)
 		top = newMultiFetcher(top, deletedFetcher)
 	}
 
+	if len(computedFields) > 0 {
+		top = newComputedFetcher(computedFields, top)
+	}
+
 	if f.documentACP.HasValue() {
-		top = newPermissionedFetcher(ctx, f.identity, f.documentACP.Value(), f.col, top)
+		fieldPermissions, err := permission.FieldPermissionsOnCollectionWithACP(ctx, f.documentACP.Value(), f.col)
+		if err != nil {
+			return err
+		}
+		top = newPermissionedFetcher(ctx, f.identity, f.documentACP.Value(), f.col, fieldPermissions, top)
 	}
 
 	if f.filter != nil {
@@ -178,6 +192,48 @@ func (f *wrappingFetcher) Start(ctx context.Context, prefixes ...keys.Walkable)
 	return nil
 }
 
+// addComputedFields returns the computed fields amongst the fields to fetch whose values are not
+// stored, and adds the fields they reference to the fields to fetch.
+func (f *wrappingFetcher) addComputedFields() ([]computedField, error) {
+	definition := f.col.Definition()
+
+	existingFields := make(map[string]struct{}, len(f.fields))
+	for _, field := range f.fields {
+		existingFields[field.Name] = struct{}{}
+	}
+
+	computedFields := []computedField{}
+	for _, field := range f.fields {
+		localField, ok := definition.Version.GetFieldByName(field.Name)
+		if !ok || !localField.Computed.HasValue() || localField.Computed.Value().IsStored {
+			continue
+		}
+
+		expression, err := getComputedExpression(f.col, localField)
+		if err != nil {
+			return nil, err
+		}
+		computedFields = append(computedFields, computedField{
+			definition: field,
+			expression: expression,
+		})
+
+		for _, name := range expression.Fields() {
+			if _, ok := existingFields[name]; ok {
+				continue
+			}
+			referencedField, ok := definition.GetFieldByName(name)
+			if !ok {
+				continue
+			}
+			f.fields = append(f.fields, referencedField)
+			existingFields[name] = struct{}{}
+		}
+	}
+
+	return computedFields, nil
+}
+
 func (f *wrappingFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo, error) {
 	f.execInfo.Reset()
 
@@ -17,6 +17,7 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/datastore"
 	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/encryption"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/utils/slice"
 )
@@ -35,6 +36,10 @@ func isSupportedKind(kind client.FieldKind) bool {
 		return true
 	}
 
+	if _, ok := kind.(*client.EnumKind); ok {
+		return true
+	}
+
 	switch kind {
 	case
 		client.FieldKind_DocID,
@@ -157,6 +162,11 @@ func getFieldGenerator(kind client.FieldKind) FieldIndexGenerator {
 	return &SimpleFieldGenerator{}
 }
 
+// blindIndexCollection is a collection that can compute the values of its blind indexes.
+type blindIndexCollection interface {
+	BlindIndexValue(value client.NormalValue) (client.NormalValue, error)
+}
+
 type collectionBaseIndex struct {
 	collection client.Collection
 	desc       client.IndexDescription
@@ -197,6 +207,19 @@ func (index *collectionBaseIndex) getDocumentsIndexKey(
 		return keys.IndexDataStoreKey{}, err
 	}
 
+	if index.desc.Blind {
+		col, ok := index.collection.(blindIndexCollection)
+		if !ok {
+			return keys.IndexDataStoreKey{}, encryption.ErrBlindIndexSecretNotSet
+		}
+		for i := range fieldValues {
+			fieldValues[i], err = col.BlindIndexValue(fieldValues[i])
+			if err != nil {
+				return keys.IndexDataStoreKey{}, err
+			}
+		}
+	}
+
 	fields := make([]keys.IndexedField, len(index.fieldsDescs))
 	for i := range index.fieldsDescs {
 		fields[i].Value = fieldValues[i]
@@ -285,6 +285,28 @@ func TestCreateIndex_IfProvideInvalidIndexName_ReturnError(t *testing.T) {
 	require.ErrorIs(t, err, schema.NewErrIndexWithInvalidName(indexDesc.Name))
 }
 
+func TestCreateIndex_IfBlindWithoutSecret_ReturnError(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	indexDesc := getUsersIndexDescOnName()
+	indexDesc.Blind = true
+	_, err := f.users.CreateIndex(f.ctx, indexDesc)
+	require.ErrorIs(t, err, ErrBlindIndexWithoutSecret)
+}
+
+func TestAddSchema_IfBlindIndexWithoutSecret_ReturnError(t *testing.T) {
+	f := newIndexTestFixtureBare(t)
+	defer f.db.Close()
+
+	_, err := f.db.AddSchema(f.ctx, `
+		type Users @index(blind: true, includes: [{field: "ssn"}]) {
+			ssn: String @encrypted
+		}
+	`)
+	require.ErrorIs(t, err, ErrBlindIndexWithoutSecret)
+}
+
 func TestCreateIndex_ShouldUpdateCollectionsDescription(t *testing.T) {
 	f := newIndexTestFixture(t)
 	defer f.db.Close()
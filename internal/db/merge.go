@@ -86,6 +86,10 @@ func (db *DB) executeMerge(ctx context.Context, col *collection, dagMerge event.
 		if err != nil {
 			return err
 		}
+		err = col.syncMergedFieldRelationshipsWithACP(ctx, docID)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = txn.Commit(ctx)
@@ -602,6 +606,8 @@ func syncIndexedDoc(
 	if isNewDoc {
 		return col.indexNewDoc(ctx, doc)
 	} else if isDeletedDoc {
+		// The relation delete actions are not applied to replicated deletes. They have been applied
+		// by the node that deleted the document, and their effects are replicated alongside it.
 		return col.deleteIndexedDoc(ctx, oldDoc)
 	} else {
 		return col.updateDocIndex(ctx, oldDoc, doc)
@@ -0,0 +1,76 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package permission
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// AuditLog records the authorization decisions made by the access checks of this package.
+type AuditLog interface {
+	// Record appends the given authorization decision to the audit log.
+	//
+	// Failing to record a decision must not fail the access check, so no error is returned.
+	Record(ctx context.Context, record client.ACPAuditRecord)
+}
+
+// AuditedDocumentACP is a document acp system whose authorization decisions are recorded
+// in an audit log.
+//
+// The decisions are recorded by the access checks of this package, rather than by the
+// document acp system itself, as only the checks know the collection of the document.
+type AuditedDocumentACP struct {
+	dac.DocumentACP
+
+	auditLog AuditLog
+}
+
+var _ dac.DocumentACP = (*AuditedDocumentACP)(nil)
+
+// NewAuditedDocumentACP returns the given document acp system, with the authorization
+// decisions made with it recorded in the given audit log.
+func NewAuditedDocumentACP(documentACP dac.DocumentACP, auditLog AuditLog) *AuditedDocumentACP {
+	return &AuditedDocumentACP{
+		DocumentACP: documentACP,
+		auditLog:    auditLog,
+	}
+}
+
+// recordAccessDecision records the authorization decision in the audit log of the document
+// acp system, if it has one.
+func recordAccessDecision(
+	ctx context.Context,
+	documentACP dac.DocumentACP,
+	actorID string,
+	collection client.Collection,
+	operation Operation,
+	permission string,
+	docID string,
+	allowed bool,
+) {
+	audited, ok := documentACP.(*AuditedDocumentACP)
+	if !ok {
+		return
+	}
+	audited.auditLog.Record(ctx, client.ACPAuditRecord{
+		Timestamp:      time.Now(),
+		Identity:       actorID,
+		Operation:      string(operation),
+		CollectionName: collection.Version().Name,
+		DocID:          docID,
+		Permission:     permission,
+		Allowed:        allowed,
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package permission
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// checkDelegatedDocAccess checks if the capability token of the request (if any) delegates the given
+// permission on the target document to the actor, and if so whether the delegator of the token has
+// that permission itself.
+//
+// Delegated read access is granted by any capability implying read access.
+func checkDelegatedDocAccess(
+	ctx context.Context,
+	documentACP dac.DocumentACP,
+	actorID string,
+	collection client.Collection,
+	policyID string,
+	resourceName string,
+	permission acpTypes.DocumentResourcePermission,
+	docID string,
+) (bool, error) {
+	token := acpIdentity.CapabilityFromContext(ctx)
+	if !token.HasValue() {
+		return false, nil
+	}
+
+	delegatedPermissions := []acpTypes.DocumentResourcePermission{permission}
+	if permission == acpTypes.DocumentReadPerm {
+		delegatedPermissions = acpTypes.ImplyDocumentReadPerm
+	}
+
+	for _, delegatedPermission := range delegatedPermissions {
+		if !token.Value().Grants(actorID, collection.Version().Name, docID, delegatedPermission.String()) {
+			continue
+		}
+		return documentACP.CheckDocAccess(
+			ctx,
+			permission,
+			token.Value().Delegator(),
+			policyID,
+			resourceName,
+			docID,
+		)
+	}
+	return false, nil
+}
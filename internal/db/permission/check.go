@@ -12,6 +12,7 @@ package permission
 
 import (
 	"context"
+	"strings"
 
 	"github.com/sourcenetwork/immutable"
 
@@ -19,6 +20,7 @@ import (
 	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
 	acpTypes "github.com/sourcenetwork/defradb/acp/types"
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 )
 
 // CheckAccessOfDocOnCollectionWithACP handles the check, which tells us if access to the target
@@ -40,6 +42,7 @@ func CheckAccessOfDocOnCollectionWithACP(
 	documentACP dac.DocumentACP,
 	collection client.Collection,
 	permission acpTypes.ResourceInterfacePermission,
+	operation Operation,
 	docID string,
 ) (bool, error) {
 	identityFunc := func() immutable.Option[acpIdentity.Identity] {
@@ -51,6 +54,7 @@ func CheckAccessOfDocOnCollectionWithACP(
 		documentACP,
 		collection,
 		permission,
+		operation,
 		docID,
 	)
 }
@@ -76,6 +80,7 @@ func CheckDocAccessWithIdentityFunc(
 	documentACP dac.DocumentACP,
 	collection client.Collection,
 	permission acpTypes.ResourceInterfacePermission,
+	operation Operation,
 	docID string,
 ) (bool, error) {
 	// Even if acp exists, but there is no policy on the collection (unpermissioned collection)
@@ -135,5 +140,242 @@ func CheckDocAccessWithIdentityFunc(
 		return false, err
 	}
 
+	if !hasAccess {
+		// The identity may still have been delegated access by a capability token.
+		hasAccess, err = checkDelegatedDocAccess(
+			ctx,
+			documentACP,
+			identityValue,
+			collection,
+			policyID,
+			resourceName,
+			documentResourcePerm,
+			docID,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	recordAccessDecision(
+		ctx,
+		documentACP,
+		identityValue,
+		collection,
+		operation,
+		documentResourcePerm.String(),
+		docID,
+		hasAccess,
+	)
+
+	return hasAccess, nil
+}
+
+// FieldPermissionsOnCollectionWithACP returns the field scoped permissions declared on the policy
+// of the collection.
+//
+// No field permissions are returned if the collection is not permissioned.
+func FieldPermissionsOnCollectionWithACP(
+	ctx context.Context,
+	documentACP dac.DocumentACP,
+	collection client.Collection,
+) ([]acpTypes.FieldResourcePermission, error) {
+	policyID, resourceName, hasPolicy := IsPermissioned(collection)
+	if !hasPolicy {
+		return nil, nil
+	}
+	return documentACP.FieldPermissions(ctx, policyID, resourceName)
+}
+
+// DeniedFieldsOfDocOnCollectionWithACP returns the names of the fields of the target document that
+// the identity can not access with respect to the permission type.
+//
+// Only the fields that have a field scoped permission of the given type, within the given field
+// permissions, are restricted. Field read access is implied by the field update permission.
+//
+// Unrestricted Access to all fields if:
+// - The collection is not permissioned.
+// - Document is public (unregistered), whether signatured request or not doesn't matter.
+func DeniedFieldsOfDocOnCollectionWithACP(
+	ctx context.Context,
+	identity immutable.Option[acpIdentity.Identity],
+	documentACP dac.DocumentACP,
+	collection client.Collection,
+	permission acpTypes.DocumentResourcePermission,
+	operation Operation,
+	fieldPermissions []acpTypes.FieldResourcePermission,
+	docID string,
+) (map[string]struct{}, error) {
+	policyID, resourceName, hasPolicy := IsPermissioned(collection)
+	if !hasPolicy || len(fieldPermissions) == 0 {
+		return nil, nil
+	}
+
+	declared := make(map[acpTypes.FieldResourcePermission]struct{}, len(fieldPermissions))
+	for _, fieldPermission := range fieldPermissions {
+		declared[fieldPermission] = struct{}{}
+	}
+
+	isRegistered, err := documentACP.IsDocRegistered(
+		ctx,
+		policyID,
+		resourceName,
+		docID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isRegistered {
+		// Unrestricted access as it is a public document.
+		return nil, nil
+	}
+
+	var identityValue string
+	if identity.HasValue() {
+		identityValue = identity.Value().DID()
+	}
+
+	impliedPermissions := []acpTypes.DocumentResourcePermission{permission}
+	if permission == acpTypes.DocumentReadPerm {
+		impliedPermissions = acpTypes.ImplyFieldReadPerm
+	}
+
+	deniedFields := map[string]struct{}{}
+	for _, fieldPermission := range fieldPermissions {
+		if fieldPermission.Permission != permission {
+			continue
+		}
+
+		hasAccess := false
+		for _, impliedPermission := range impliedPermissions {
+			impliedFieldPermission := acpTypes.FieldResourcePermission{
+				Permission: impliedPermission,
+				FieldName:  fieldPermission.FieldName,
+			}
+			if _, ok := declared[impliedFieldPermission]; !ok {
+				continue
+			}
+
+			hasAccess, err = documentACP.CheckDocFieldAccess(
+				ctx,
+				impliedFieldPermission,
+				identityValue,
+				policyID,
+				resourceName,
+				docID,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if hasAccess {
+				break
+			}
+		}
+
+		recordAccessDecision(
+			ctx,
+			documentACP,
+			identityValue,
+			collection,
+			operation,
+			fieldPermission.String(),
+			docID,
+			hasAccess,
+		)
+
+		if !hasAccess {
+			deniedFields[fieldPermission.FieldName] = struct{}{}
+		}
+	}
+
+	return deniedFields, nil
+}
+
+// IsFieldDenied returns true if the given field is within the denied fields.
+//
+// The field holding the ID of a related object is denied if its relation field is denied.
+func IsFieldDenied(deniedFields map[string]struct{}, fieldName string) bool {
+	if _, ok := deniedFields[fieldName]; ok {
+		return true
+	}
+	relationName, isRelationID := strings.CutSuffix(fieldName, request.RelatedObjectID)
+	if !isRelationID {
+		return false
+	}
+	_, ok := deniedFields[relationName]
+	return ok
+}
+
+// CheckCollectionAccessWithACP handles the check, which tells us if the identity has the given
+// collection scoped permission on the specified collection.
+//
+// This function should only be called if acp is available. As we have unrestricted
+// access when acp is not available (acp turned off).
+//
+// Unrestricted Access to collection if:
+// - The collection is not permissioned (has no policy).
+// - The collection scoped permission is not declared on the resource of the policy.
+//
+// Access is denied if the permission is declared but the collection is not registered with acp,
+// as there is then no owner or relationship that could grant it.
+func CheckCollectionAccessWithACP(
+	ctx context.Context,
+	identity immutable.Option[acpIdentity.Identity],
+	documentACP dac.DocumentACP,
+	collection client.Collection,
+	permission acpTypes.CollectionResourcePermission,
+	operation Operation,
+) (bool, error) {
+	policyID, resourceName, hasPolicy := IsPermissioned(collection)
+	if !hasPolicy {
+		return true, nil
+	}
+
+	hasPermission, err := documentACP.HasCollectionPermission(ctx, permission, policyID, resourceName)
+	if err != nil {
+		return false, err
+	}
+	if !hasPermission {
+		return true, nil
+	}
+
+	collectionID := collection.Version().CollectionID
+	isRegistered, err := documentACP.IsDocRegistered(ctx, policyID, resourceName, collectionID)
+	if err != nil {
+		return false, err
+	}
+	if !isRegistered {
+		return false, nil
+	}
+
+	var identityValue string
+	if identity.HasValue() {
+		identityValue = identity.Value().DID()
+	}
+
+	hasAccess, err := documentACP.CheckCollectionAccess(
+		ctx,
+		permission,
+		identityValue,
+		policyID,
+		resourceName,
+		collectionID,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	recordAccessDecision(
+		ctx,
+		documentACP,
+		identityValue,
+		collection,
+		operation,
+		permission.String(),
+		"",
+		hasAccess,
+	)
+
 	return hasAccess, nil
 }
@@ -0,0 +1,24 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package permission
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errCollectionPermissionRequiresIdentity string = "an identity is required to register a collection with a " +
+		"collection scoped permission"
+)
+
+var (
+	ErrCollectionPermissionRequiresIdentity = errors.New(errCollectionPermissionRequiresIdentity)
+)
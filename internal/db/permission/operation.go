@@ -0,0 +1,49 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package permission
+
+import "context"
+
+// Operation is the operation an access check is made for, as recorded in the audit log.
+//
+// It may differ from the permission checked, for example an update reads the documents it
+// targets before updating them.
+type Operation string
+
+const (
+	OperationQuery  Operation = "query"
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+	// OperationSync is the operation of a peer syncing documents, or their encryption keys,
+	// from this node.
+	OperationSync Operation = "sync"
+)
+
+// operationContextKey is the key type for operation context values.
+type operationContextKey struct{}
+
+// WithOperation returns a new context with the given operation set.
+//
+// The operation is read by the access checks made on behalf of the operation, such as those
+// of the fetcher, that can not otherwise tell which operation they are made for.
+func WithOperation(ctx context.Context, operation Operation) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, operation)
+}
+
+// OperationFromContext returns the operation of the given context, defaulting to a query.
+func OperationFromContext(ctx context.Context) Operation {
+	operation, ok := ctx.Value(operationContextKey{}).(Operation)
+	if !ok {
+		return OperationQuery
+	}
+	return operation
+}
@@ -11,6 +11,10 @@
 package permission
 
 import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
 	"github.com/sourcenetwork/defradb/client"
 )
 
@@ -30,3 +34,91 @@ func IsPermissioned(collection client.Collection) (string, string, bool) {
 
 	return "", "", false
 }
+
+// ObjectIDOfRelationshipOnCollection returns the acp objectID that a relationship targeting the
+// given docID on the collection is made with.
+//
+// An empty docID targets the collection itself, if the collection is registered with acp as an
+// object. Otherwise the docID is the objectID.
+func ObjectIDOfRelationshipOnCollection(
+	ctx context.Context,
+	documentACP dac.DocumentACP,
+	collection client.Collection,
+	docID string,
+) (string, error) {
+	if docID != "" {
+		return docID, nil
+	}
+
+	isObject, err := IsCollectionAnObject(ctx, documentACP, collection)
+	if err != nil || !isObject {
+		return docID, err
+	}
+
+	return collection.Version().CollectionID, nil
+}
+
+// IsCollectionAnObject returns true if the collection itself is registered with acp as an object,
+// with the collectionID as the objectID.
+//
+// This is the case for permissioned views, as access to their items is controlled by the policy
+// of the view as a whole, and for permissioned collections with a collection scoped permission.
+func IsCollectionAnObject(
+	ctx context.Context,
+	documentACP dac.DocumentACP,
+	collection client.Collection,
+) (bool, error) {
+	policyID, resourceName, hasPolicy := IsPermissioned(collection)
+	if !hasPolicy {
+		return false, nil
+	}
+
+	if len(collection.Version().QuerySources()) > 0 {
+		return true, nil
+	}
+
+	return documentACP.HasCollectionPermission(
+		ctx,
+		acpTypes.CollectionCreatePerm,
+		policyID,
+		resourceName,
+	)
+}
+
+// FieldRelationActors returns the actors held in the field of the document, that are given the
+// relation derived from the field.
+//
+// No actors are returned if the document is nil or the field has no value.
+func FieldRelationActors(doc *client.Document, fieldName string) []string {
+	if doc == nil {
+		return nil
+	}
+
+	for field, value := range doc.Values() {
+		if field.Name() != fieldName || value.NormalValue().IsNil() {
+			continue
+		}
+
+		normalValue := value.NormalValue()
+		if actor, ok := normalValue.String(); ok {
+			return []string{actor}
+		}
+		if actor, ok := normalValue.NillableString(); ok && actor.HasValue() {
+			return []string{actor.Value()}
+		}
+		if actors, ok := normalValue.StringArray(); ok {
+			return actors
+		}
+		if actors, ok := normalValue.NillableStringArray(); ok {
+			result := make([]string, 0, len(actors))
+			for _, actor := range actors {
+				if actor.HasValue() {
+					result = append(result, actor.Value())
+				}
+			}
+			return result
+		}
+	}
+
+	return nil
+}
@@ -49,3 +49,46 @@ func RegisterDocOnCollectionWithDocumentACP(
 
 	return nil
 }
+
+// RegisterCollectionWithDocumentACP handles the registration of the collection with document acp system,
+// so that access to the collection itself can be controlled by collection scoped permissions.
+//
+// The collection is only registered if it is permissioned (has a policy) and is either a view, or
+// a collection scoped permission is declared on the resource of the policy. The collection is registered as an object
+// with the collectionID as the objectID, and the given identity becomes its owner.
+//
+// Registering an already registered collection is a no-op.
+func RegisterCollectionWithDocumentACP(
+	ctx context.Context,
+	identity immutable.Option[acpIdentity.Identity],
+	documentACP dac.DocumentACP,
+	collection client.Collection,
+) error {
+	policyID, resourceName, hasPolicy := IsPermissioned(collection)
+	if !hasPolicy {
+		return nil
+	}
+
+	isObject, err := IsCollectionAnObject(ctx, documentACP, collection)
+	if err != nil || !isObject {
+		return err
+	}
+
+	collectionID := collection.Version().CollectionID
+	isRegistered, err := documentACP.IsDocRegistered(ctx, policyID, resourceName, collectionID)
+	if err != nil || isRegistered {
+		return err
+	}
+
+	if !identity.HasValue() {
+		return ErrCollectionPermissionRequiresIdentity
+	}
+
+	return documentACP.RegisterDocObject(
+		ctx,
+		identity.Value(),
+		policyID,
+		resourceName,
+		collectionID,
+	)
+}
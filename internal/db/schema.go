@@ -69,6 +69,9 @@ func (db *DB) loadSchema(ctx context.Context) error {
 		return err
 	}
 
+	cache := newDefinitionCache(definitions)
+	db.definitionCache.Store(&cache)
+
 	return db.parser.SetSchema(ctx, definitions)
 }
 
@@ -464,6 +467,8 @@ func (db *DB) updateSchema(
 				},
 			}
 
+			col.Fields = removeDeletedSchemaFields(col.Fields, existingSchemaByName[schema.Name], schema)
+
 			for _, globalField := range schema.Fields {
 				_, exists := col.GetFieldByName(globalField.Name)
 				if !exists {
@@ -534,6 +539,29 @@ func (db *DB) updateSchema(
 	return nil
 }
 
+// removeDeletedSchemaFields returns the given collection fields, minus any global fields that exist
+// on the previous schema but have been removed from the new one.
+//
+// Local-only fields, such as the secondary side of a relation, are preserved.
+func removeDeletedSchemaFields(
+	fields []client.CollectionFieldDescription,
+	previousSchema client.SchemaDescription,
+	newSchema client.SchemaDescription,
+) []client.CollectionFieldDescription {
+	result := make([]client.CollectionFieldDescription, 0, len(fields))
+	for _, field := range fields {
+		if !field.Kind.HasValue() {
+			_, existedPreviously := previousSchema.GetFieldByName(field.Name)
+			_, stillExists := newSchema.GetFieldByName(field.Name)
+			if existedPreviously && !stillExists {
+				continue
+			}
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
 func areSchemasEqual(this client.SchemaDescription, that client.SchemaDescription) bool {
 	if len(this.Fields) != len(that.Fields) {
 		return false
@@ -144,6 +144,30 @@ func (txn *Txn) DeleteDACActorRelationship(
 	return txn.db.DeleteDACActorRelationship(ctx, collectionName, docID, relation, targetActor)
 }
 
+func (txn *Txn) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx = InitContext(ctx, txn)
+	return txn.db.AddDACActorRelationships(ctx, batch)
+}
+
+func (txn *Txn) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx = InitContext(ctx, txn)
+	return txn.db.DeleteDACActorRelationships(ctx, batch)
+}
+
+func (txn *Txn) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	ctx = InitContext(ctx, txn)
+	return txn.db.GetACPAuditLog(ctx, query)
+}
+
 func (txn *Txn) AddNACActorRelationship(
 	ctx context.Context,
 	relation string,
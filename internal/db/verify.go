@@ -66,6 +66,7 @@ func (db *DB) VerifySignature(ctx context.Context, blockCid string, pubKey crypt
 			db.documentACP.Value(),
 			collection,
 			acpTypes.DocumentReadPerm,
+			permission.OperationFromContext(ctx),
 			docID,
 		)
 
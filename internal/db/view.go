@@ -24,7 +24,6 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/datastore"
-	"github.com/sourcenetwork/defradb/internal/db/description"
 	"github.com/sourcenetwork/defradb/internal/db/id"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/planner"
@@ -94,6 +93,20 @@ func (db *DB) addView(
 		return nil, err
 	}
 
+	for _, definition := range returnDescriptions {
+		if definition.Version.IsAutoRefreshed {
+			// Auto refreshed views are populated on creation, after which they are kept
+			// up to date as their source documents change.
+			//
+			// Like the later refreshes, the cache is built on behalf of the node identity so
+			// that it does not depend on the identity that created the view.
+			err = db.buildViewCache(identity.WithContext(ctx, db.nodeIdentity), definition)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return returnDescriptions, nil
 }
 
@@ -110,15 +123,37 @@ func (db *DB) refreshViews(ctx context.Context, opts client.CollectionFetchOptio
 			continue
 		}
 
+		canRefresh, err := db.checkViewRefreshAccessWithACP(ctx, col)
+		if err != nil {
+			return err
+		}
+		if !canRefresh {
+			// The cache of the view would be rebuilt from the documents visible to the identity,
+			// so it is left as is if the identity may not update the view.
+			continue
+		}
+
+		buildCtx := ctx
+		if col.Version.IsAutoRefreshed {
+			// The cache of auto refreshed views is kept up to date on behalf of the node identity,
+			// so it must also be rebuilt on its behalf.
+			buildCtx = identity.WithContext(ctx, db.nodeIdentity)
+		}
+
 		// Clearing and then constructing is a bit inefficient, but it should do for now.
 		// Long term we probably want to update inline as much as possible to avoid unnessecarily
 		// moving/adding/deleting keys in storage
-		err := db.clearViewCache(ctx, col)
+		err = db.clearViewCache(ctx, col)
 		if err != nil {
 			return err
 		}
 
-		err = db.buildViewCache(ctx, col)
+		err = db.buildViewCache(buildCtx, col)
+		if err != nil {
+			return err
+		}
+
+		err = unmarkViewStale(ctx, col)
 		if err != nil {
 			return err
 		}
@@ -145,38 +180,68 @@ func (db *DB) getViews(ctx context.Context, opts client.CollectionFetchOptions)
 	return views, nil
 }
 
-func (db *DB) buildViewCache(ctx context.Context, col client.CollectionDefinition) (err error) {
+func (db *DB) buildViewCache(ctx context.Context, col client.CollectionDefinition) error {
+	return db.setViewCacheItems(ctx, col, immutable.None[[]string]())
+}
+
+// setViewCacheItems queries the given view and writes the resulting items, and their index entries,
+// to its cache.
+//
+// If docIDs are provided, only the items sourced from the documents with those IDs will be replaced.
+// This is only supported for views that may be refreshed incrementally.
+func (db *DB) setViewCacheItems(
+	ctx context.Context,
+	col client.CollectionDefinition,
+	docIDs immutable.Option[[]string],
+) (err error) {
 	txn := datastore.CtxMustGetTxn(ctx)
 
-	p := planner.New(ctx, identity.FromContext(ctx), db.documentACP, db)
+	// The view is queried from its source, instead of its cache, in order to rebuild the cache.
+	p := planner.New(
+		planner.WithViewCacheBypassed(ctx, col.Version.CollectionID),
+		identity.FromContext(ctx),
+		db.documentACP,
+		db,
+	)
 
-	// temporarily disable the cache in order to query without using it
-	col.Version.IsMaterialized = false
-	err = description.SaveCollection(ctx, col.Version)
+	request, err := db.generateMaximalSelectFromCollection(ctx, col, immutable.None[string](), map[string]struct{}{})
 	if err != nil {
 		return err
 	}
-	defer func() {
-		var defErr error
-		col.Version.IsMaterialized = true
-		defErr = description.SaveCollection(ctx, col.Version)
-		if err == nil {
-			// Do not overwrite the original error if there is one, defErr is probably an artifact of the original
-			// failue and can be discarded.
-			err = defErr
-		}
-	}()
+	request.DocIDs = docIDs
 
-	request, err := db.generateMaximalSelectFromCollection(ctx, col, immutable.None[string](), map[string]struct{}{})
+	source, err := p.MakeSelectionPlan(request)
 	if err != nil {
 		return err
 	}
 
-	source, err := p.MakeSelectionPlan(request)
+	shortID, err := id.GetShortCollectionID(ctx, col.Version.CollectionID)
 	if err != nil {
 		return err
 	}
 
+	indexes, err := db.getViewIndexes(col)
+	if err != nil {
+		return err
+	}
+
+	if docIDs.HasValue() {
+		// The existing items are deleted first as the documents may have been deleted, or may no longer
+		// match the query of the view.
+		for _, docID := range docIDs.Value() {
+			itemKey := keys.NewViewCacheDocKey(shortID, docID).Bytes()
+			err = deleteViewItemSources(ctx, shortID, docID, itemKey)
+			if err != nil {
+				return err
+			}
+
+			err = deleteViewItem(ctx, col, indexes, source.DocumentMap(), docID, itemKey)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	err = source.Init()
 	if err != nil {
 		return err
@@ -195,14 +260,19 @@ func (db *DB) buildViewCache(ctx context.Context, col client.CollectionDefinitio
 		return err
 	}
 
+	prefix := keys.NewViewCacheColPrefix(shortID).Bytes()
 	hasValue, err := source.Next()
 	if err != nil {
 		return err
 	}
 
-	// View items are currently keyed by their index, starting at 1.
+	// Items of views that are refreshed incrementally are keyed by the ID of the document they
+	// are sourced from, so that they may be replaced individually.
+	//
+	// Other view items are currently keyed by their index, starting at 1.
 	// The order in which results are returned must be consistent with the results of the
 	// underlying query/transform.
+	isKeyedByDocID := isIncrementallyRefreshable(col)
 	var itemID uint
 	for itemID = 1; hasValue; itemID++ {
 		doc := source.Value()
@@ -212,13 +282,35 @@ func (db *DB) buildViewCache(ctx context.Context, col client.CollectionDefinitio
 			return err
 		}
 
-		shortID, err := id.GetShortCollectionID(ctx, col.Version.CollectionID)
+		var itemKey keys.ViewCacheKey
+		if isKeyedByDocID {
+			itemKey = keys.NewViewCacheDocKey(shortID, doc.GetID())
+		} else {
+			itemKey = keys.NewViewCacheKey(shortID, itemID)
+		}
+
+		itemKeyBytes := itemKey.Bytes()
+		err = txn.Datastore().Set(ctx, itemKeyBytes, serializedItem)
 		if err != nil {
 			return err
 		}
 
-		itemKey := keys.NewViewCacheKey(shortID, itemID)
-		err = txn.Datastore().Set(ctx, itemKey.Bytes(), serializedItem)
+		if isKeyedByDocID {
+			err = setViewItemSources(ctx, shortID, doc.GetID(), serializedItem)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = indexViewItem(
+			ctx,
+			col,
+			indexes,
+			source.DocumentMap(),
+			doc,
+			getViewItemID(prefix, itemKeyBytes),
+			itemKeyBytes,
+		)
 		if err != nil {
 			return err
 		}
@@ -240,30 +332,87 @@ func (db *DB) clearViewCache(ctx context.Context, col client.CollectionDefinitio
 		return err
 	}
 
-	iter, err := txn.Datastore().Iterator(ctx, corekv.IterOptions{
-		Prefix:   keys.NewViewCacheColPrefix(shortID).Bytes(),
-		KeysOnly: true,
-	})
-	if err != nil {
-		return err
+	prefixes := [][]byte{
+		keys.NewViewCacheColPrefix(shortID).Bytes(),
+		keys.NewViewCacheSourceColPrefix(shortID).Bytes(),
 	}
-
-	for {
-		hasNext, err := iter.Next()
+	for _, prefix := range prefixes {
+		iter, err := txn.Datastore().Iterator(ctx, corekv.IterOptions{
+			Prefix:   prefix,
+			KeysOnly: true,
+		})
 		if err != nil {
-			return errors.Join(err, iter.Close())
+			return err
+		}
+
+		for {
+			hasNext, err := iter.Next()
+			if err != nil {
+				return errors.Join(err, iter.Close())
+			}
+			if !hasNext {
+				break
+			}
+
+			err = txn.Datastore().Delete(ctx, iter.Key())
+			if err != nil {
+				return errors.Join(err, iter.Close())
+			}
 		}
-		if !hasNext {
-			break
+
+		err = iter.Close()
+		if err != nil {
+			return err
 		}
+	}
 
-		err = txn.Datastore().Delete(ctx, iter.Key())
+	indexes, err := db.getViewIndexes(col)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		err = index.RemoveAll(ctx)
 		if err != nil {
-			return errors.Join(err, iter.Close())
+			return err
 		}
 	}
 
-	return iter.Close()
+	return nil
+}
+
+// getViewIndexes returns the indexes of the given view.
+func (db *DB) getViewIndexes(col client.CollectionDefinition) ([]*viewIndex, error) {
+	if len(col.Version.Indexes) == 0 {
+		return nil, nil
+	}
+
+	viewCol, err := db.newCollection(col.Version, col.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return newViewIndexes(viewCol)
+}
+
+// getViewCacheMapping returns the document mapping of the items held in the cache of the given view.
+func (db *DB) getViewCacheMapping(
+	ctx context.Context,
+	col client.CollectionDefinition,
+) (*core.DocumentMapping, error) {
+	p := planner.New(ctx, identity.FromContext(ctx), db.documentACP, db)
+
+	request, err := db.generateMaximalSelectFromCollection(ctx, col, immutable.None[string](), map[string]struct{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := p.MakeSelectionPlan(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return source.DocumentMap(), nil
 }
 
 func (db *DB) generateMaximalSelectFromCollection(
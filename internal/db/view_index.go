@@ -0,0 +1,244 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/sourcenetwork/corekv"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+// viewIndex is an index over the items held in the cache of a materialized view.
+//
+// Entries are keyed by the indexed values of the item followed by the ID of the item, and
+// hold the key of the item within the cache. The IDs of view items are not necessarily
+// DocIDs, so unlike collection indexes, the uniqueness of view indexes is not enforced.
+type viewIndex struct {
+	collectionBaseIndex
+}
+
+func newViewIndexes(col client.Collection) ([]*viewIndex, error) {
+	indexes := make([]*viewIndex, 0, len(col.Version().Indexes))
+	for _, desc := range col.Version().Indexes {
+		index, err := NewCollectionIndex(col, desc)
+		if err != nil {
+			return nil, err
+		}
+
+		var base collectionBaseIndex
+		switch typedIndex := index.(type) {
+		case *collectionSimpleIndex:
+			base = typedIndex.collectionBaseIndex
+		case *collectionUniqueIndex:
+			base = typedIndex.collectionBaseIndex
+		}
+		indexes = append(indexes, &viewIndex{collectionBaseIndex: base})
+	}
+	return indexes, nil
+}
+
+// Save indexes the given view item.
+func (index *viewIndex) Save(ctx context.Context, item *client.Document, itemID string, itemKey []byte) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	return index.generateViewKeysAndProcess(ctx, item, itemID, func(key keys.IndexDataStoreKey) error {
+		return txn.Datastore().Set(ctx, key.Bytes(), itemKey)
+	})
+}
+
+// Delete removes the given view item from the index.
+func (index *viewIndex) Delete(ctx context.Context, item *client.Document, itemID string) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	return index.generateViewKeysAndProcess(ctx, item, itemID, func(key keys.IndexDataStoreKey) error {
+		return txn.Datastore().Delete(ctx, key.Bytes())
+	})
+}
+
+func (index *viewIndex) generateViewKeysAndProcess(
+	ctx context.Context,
+	item *client.Document,
+	itemID string,
+	processKey func(keys.IndexDataStoreKey) error,
+) error {
+	baseKey, err := index.getDocumentsIndexKey(ctx, item, false)
+	if err != nil {
+		return err
+	}
+	baseKey.Fields = append(baseKey.Fields, keys.IndexedField{Value: client.NewNormalString(itemID)})
+
+	return index.generateKeysForFieldAndProcess(0, baseKey, processKey)
+}
+
+// newViewItemDocument returns a document holding the values of the given fields of the view item,
+// converted to the kinds of the fields of the view.
+//
+// The values of cached items are decoded from json, this ensures that they are indexed the same way
+// as the values they were encoded from.
+func newViewItemDocument(
+	def client.CollectionDefinition,
+	mapping *core.DocumentMapping,
+	item core.Doc,
+	indexes []*viewIndex,
+) (*client.Document, error) {
+	values := map[string]any{}
+	for _, index := range indexes {
+		for _, field := range index.desc.Fields {
+			fieldIndexes, ok := mapping.IndexesByName[field.Name]
+			if !ok || len(fieldIndexes) == 0 || fieldIndexes[0] >= len(item.Fields) {
+				continue
+			}
+			values[field.Name] = item.Fields[fieldIndexes[0]]
+		}
+	}
+
+	return client.NewDocFromMap(values, def)
+}
+
+// indexViewItem adds the given view item to all the given indexes.
+func indexViewItem(
+	ctx context.Context,
+	def client.CollectionDefinition,
+	indexes []*viewIndex,
+	mapping *core.DocumentMapping,
+	item core.Doc,
+	itemID string,
+	itemKey []byte,
+) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	doc, err := newViewItemDocument(def, mapping, item, indexes)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		err = index.Save(ctx, doc, itemID, itemKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteViewItem deletes the item with the given key from the cache of the view, along with
+// its index entries.
+//
+// Deleting an item that does not exist is a no-op.
+func deleteViewItem(
+	ctx context.Context,
+	def client.CollectionDefinition,
+	indexes []*viewIndex,
+	mapping *core.DocumentMapping,
+	itemID string,
+	itemKey []byte,
+) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	if len(indexes) != 0 {
+		value, err := txn.Datastore().Get(ctx, itemKey)
+		if errors.Is(err, corekv.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		item, err := core.UnmarshalViewItem(mapping, value)
+		if err != nil {
+			return err
+		}
+
+		doc, err := newViewItemDocument(def, mapping, item, indexes)
+		if err != nil {
+			return err
+		}
+
+		for _, index := range indexes {
+			err = index.Delete(ctx, doc, itemID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return txn.Datastore().Delete(ctx, itemKey)
+}
+
+// indexViewCache adds all the items currently held in the cache of the given view to the given index.
+func (db *DB) indexViewCache(ctx context.Context, col client.Collection, index *viewIndex) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	mapping, err := db.getViewCacheMapping(ctx, col.Definition())
+	if err != nil {
+		return err
+	}
+
+	shortID, err := id.GetShortCollectionID(ctx, col.Version().CollectionID)
+	if err != nil {
+		return err
+	}
+
+	prefix := keys.NewViewCacheColPrefix(shortID).Bytes()
+	iter, err := txn.Datastore().Iterator(ctx, corekv.IterOptions{
+		Prefix: prefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		hasNext, err := iter.Next()
+		if err != nil {
+			return errors.Join(err, iter.Close())
+		}
+		if !hasNext {
+			break
+		}
+
+		value, err := iter.Value()
+		if err != nil {
+			return errors.Join(err, iter.Close())
+		}
+
+		item, err := core.UnmarshalViewItem(mapping, value)
+		if err != nil {
+			return errors.Join(err, iter.Close())
+		}
+
+		itemKey := bytes.Clone(iter.Key())
+		itemID := getViewItemID(prefix, itemKey)
+
+		err = indexViewItem(ctx, col.Definition(), []*viewIndex{index}, mapping, item, itemID, itemKey)
+		if err != nil {
+			return errors.Join(err, iter.Close())
+		}
+	}
+
+	return iter.Close()
+}
+
+// getViewItemID returns the ID of the view item with the given key, within the cache with the given prefix.
+//
+// The key is the prefix, followed by a separator and the ID of the item.
+func getViewItemID(prefix []byte, itemKey []byte) string {
+	return string(itemKey[len(prefix)+1:])
+}
@@ -0,0 +1,758 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/sourcenetwork/corekv"
+	"github.com/sourcenetwork/corelog"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/keys"
+	"github.com/sourcenetwork/defradb/internal/planner"
+)
+
+// handleViewRefreshes keeps the caches of auto refreshed views up to date as the documents
+// they are sourced from change.
+//
+// Events are processed serially, in the order in which they are received, so that concurrent
+// refreshes of the same view can not conflict with one another.
+//
+// The refreshes are made on behalf of the node identity, as they are not requested by any other
+// identity. Auto refreshed views may not have a policy, so the access to the views themselves
+// need not be checked.
+func (db *DB) handleViewRefreshes(ctx context.Context, sub event.Subscription) {
+	ctx = identity.WithContext(ctx, db.nodeIdentity)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-sub.Message():
+			if !ok {
+				return
+			}
+
+			var collectionID, docID string
+			switch evt := msg.Data.(type) {
+			case event.Update:
+				collectionID, docID = evt.CollectionID, evt.DocID
+			case event.MergeComplete:
+				collectionID, docID = evt.Merge.CollectionID, evt.Merge.DocID
+			default:
+				continue
+			}
+			if docID == "" {
+				// Collection level commits do not change the contents of any document.
+				continue
+			}
+
+			err := db.refreshViewsForDocument(ctx, collectionID, docID)
+			if err != nil {
+				log.ErrorContextE(
+					ctx,
+					"Failed to get views to refresh",
+					err,
+					corelog.String("CollectionID", collectionID),
+					corelog.String("DocID", docID),
+				)
+			}
+		}
+	}
+}
+
+// refreshViewsForDocument refreshes the caches of all the auto refreshed views that are sourced from
+// the given document.
+//
+// The failure to refresh a view does not prevent the other views from being refreshed. It is instead
+// published, and the cache of the view is marked as stale so that it is rebuilt in full on its next
+// refresh.
+func (db *DB) refreshViewsForDocument(ctx context.Context, collectionID string, docID string) error {
+	views, err := db.getAutoRefreshedViews(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, view := range views {
+		var refreshed bool
+		// retry the refresh if a conflict occurs
+		//
+		// conflicts occur when a user refreshes or otherwise mutates the view while the
+		// refresh is in progress.
+		for i := 0; i < db.MaxTxnRetries(); i++ {
+			refreshed, err = db.refreshViewForDocument(ctx, view, collectionID, docID)
+			if errors.Is(err, corekv.ErrTxnConflict) {
+				continue
+			}
+			break
+		}
+		if err != nil {
+			log.ErrorContextE(
+				ctx,
+				"Failed to refresh view",
+				err,
+				corelog.String("ViewCollectionID", view.Version.CollectionID),
+				corelog.String("CollectionID", collectionID),
+				corelog.String("DocID", docID),
+			)
+
+			staleErr := db.markViewStale(ctx, view)
+			if staleErr != nil {
+				err = errors.Join(err, staleErr)
+			}
+		}
+
+		if refreshed || err != nil {
+			db.events.Publish(event.NewMessage(event.ViewRefreshName, event.ViewRefresh{
+				CollectionID: view.Version.CollectionID,
+				DocID:        docID,
+				Err:          err,
+			}))
+		}
+	}
+
+	return nil
+}
+
+// markViewStale marks the cache of the given view as stale, so that it is rebuilt in full on its
+// next refresh.
+func (db *DB) markViewStale(ctx context.Context, view client.CollectionDefinition) error {
+	ctx, txn, err := ensureContextTxn(ctx, db, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	err = txn.Systemstore().Set(ctx, keys.NewViewStaleKey(view.Version.CollectionID).Bytes(), []byte{})
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// isViewStale returns true if the cache of the given view has been marked as stale.
+func isViewStale(ctx context.Context, view client.CollectionDefinition) (bool, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+	return txn.Systemstore().Has(ctx, keys.NewViewStaleKey(view.Version.CollectionID).Bytes())
+}
+
+// unmarkViewStale removes the stale mark of the given view, once its cache has been rebuilt.
+func unmarkViewStale(ctx context.Context, view client.CollectionDefinition) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+	return txn.Systemstore().Delete(ctx, keys.NewViewStaleKey(view.Version.CollectionID).Bytes())
+}
+
+func (db *DB) getAutoRefreshedViews(ctx context.Context) ([]client.CollectionDefinition, error) {
+	ctx, txn, err := ensureContextTxn(ctx, db, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	views, err := db.getViews(ctx, client.CollectionFetchOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []client.CollectionDefinition
+	for _, view := range views {
+		if view.Version.IsAutoRefreshed {
+			result = append(result, view)
+		}
+	}
+
+	return result, nil
+}
+
+// refreshViewForDocument refreshes the cache of the given view, if it is sourced from the given document.
+//
+// It returns true if the view is sourced from the collection of the document, and false otherwise.
+func (db *DB) refreshViewForDocument(
+	ctx context.Context,
+	view client.CollectionDefinition,
+	collectionID string,
+	docID string,
+) (bool, error) {
+	ctx, txn, err := ensureContextTxn(ctx, db, false)
+	if err != nil {
+		return false, err
+	}
+	defer txn.Discard(ctx)
+
+	rootCol, relationPaths, err := db.getViewSourcePaths(ctx, view, collectionID)
+	if err != nil {
+		return false, err
+	}
+	if len(relationPaths) == 0 {
+		return false, nil
+	}
+
+	isStale, err := isViewStale(ctx, view)
+	if err != nil {
+		return false, err
+	}
+
+	if isIncrementallyRefreshable(view) && !isStale {
+		rootDocIDs, err := db.getViewSourceRootDocIDs(ctx, view, rootCol, relationPaths, docID)
+		if err != nil {
+			return false, err
+		}
+
+		err = db.refreshViewItems(ctx, view, rootDocIDs)
+		if err != nil {
+			return false, err
+		}
+	} else {
+		// The items of the view do not map to individual source documents, or the cache has failed
+		// to be refreshed before, so the whole cache must be rebuilt.
+		err = db.clearViewCache(ctx, view)
+		if err != nil {
+			return false, err
+		}
+
+		err = db.buildViewCache(ctx, view)
+		if err != nil {
+			return false, err
+		}
+
+		err = unmarkViewStale(ctx, view)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, txn.Commit(ctx)
+}
+
+// getViewSourcePaths returns the root collection of the given view's query, along with all the
+// relation paths, from that root, through which the query reads from the collection with the given ID.
+//
+// The root collection itself is reachable through an empty path.
+func (db *DB) getViewSourcePaths(
+	ctx context.Context,
+	view client.CollectionDefinition,
+	collectionID string,
+) (client.CollectionDefinition, [][]string, error) {
+	querySources := view.Version.QuerySources()
+	if len(querySources) == 0 {
+		return client.CollectionDefinition{}, nil, nil
+	}
+	// For now, we assume a single source.  This will need to change if/when we support multiple sources
+	query := querySources[0].Query
+
+	cols, err := db.getCollections(ctx, client.CollectionFetchOptions{Name: immutable.Some(query.Name)})
+	if err != nil {
+		return client.CollectionDefinition{}, nil, err
+	}
+	if len(cols) == 0 {
+		return client.CollectionDefinition{}, nil, client.NewErrCollectionNotFoundForName(query.Name)
+	}
+	rootCol := cols[0].Definition()
+
+	var paths [][]string
+	err = db.collectSelectSourcePaths(ctx, rootCol, &query, collectionID, nil, map[string]struct{}{}, &paths)
+	if err != nil {
+		return client.CollectionDefinition{}, nil, err
+	}
+
+	return rootCol, paths, nil
+}
+
+// collectSelectSourcePaths appends the relation paths through which the given select, and its filter,
+// read from the collection with the given ID to paths.
+func (db *DB) collectSelectSourcePaths(
+	ctx context.Context,
+	col client.CollectionDefinition,
+	sel *request.Select,
+	collectionID string,
+	path []string,
+	pathsHit map[string]struct{},
+	paths *[][]string,
+) error {
+	addSourcePath(col, collectionID, path, pathsHit, paths)
+
+	for _, field := range sel.Fields {
+		switch typedField := field.(type) {
+		case *request.Select:
+			relatedCol, ok, err := db.getRelatedDefinition(ctx, col, typedField.Name)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			err = db.collectSelectSourcePaths(
+				ctx,
+				relatedCol,
+				typedField,
+				collectionID,
+				appendPath(path, typedField.Name),
+				pathsHit,
+				paths,
+			)
+			if err != nil {
+				return err
+			}
+
+		case *request.Aggregate:
+			for _, target := range typedField.Targets {
+				relatedCol, ok, err := db.getRelatedDefinition(ctx, col, target.HostName)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+
+				conditions := map[string]any{}
+				if target.Filter.HasValue() {
+					conditions = target.Filter.Value().Conditions
+				}
+
+				err = db.collectFilterSourcePaths(
+					ctx,
+					relatedCol,
+					conditions,
+					collectionID,
+					appendPath(path, target.HostName),
+					pathsHit,
+					paths,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if sel.Filter.HasValue() {
+		return db.collectFilterSourcePaths(
+			ctx,
+			col,
+			sel.Filter.Value().Conditions,
+			collectionID,
+			path,
+			pathsHit,
+			paths,
+		)
+	}
+
+	return nil
+}
+
+// collectFilterSourcePaths appends the relation paths through which the given filter conditions read
+// from the collection with the given ID to paths.
+func (db *DB) collectFilterSourcePaths(
+	ctx context.Context,
+	col client.CollectionDefinition,
+	conditions map[string]any,
+	collectionID string,
+	path []string,
+	pathsHit map[string]struct{},
+	paths *[][]string,
+) error {
+	addSourcePath(col, collectionID, path, pathsHit, paths)
+
+	for key, value := range conditions {
+		switch key {
+		case request.FilterOpAnd, request.FilterOpOr:
+			innerConditions, _ := value.([]any)
+			for _, inner := range innerConditions {
+				innerMap, ok := inner.(map[string]any)
+				if !ok {
+					continue
+				}
+				err := db.collectFilterSourcePaths(ctx, col, innerMap, collectionID, path, pathsHit, paths)
+				if err != nil {
+					return err
+				}
+			}
+
+		case request.FilterOpNot:
+			innerMap, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			err := db.collectFilterSourcePaths(ctx, col, innerMap, collectionID, path, pathsHit, paths)
+			if err != nil {
+				return err
+			}
+
+		default:
+			innerMap, ok := value.(map[string]any)
+			if !ok || strings.HasPrefix(key, "_") {
+				continue
+			}
+
+			relatedCol, ok, err := db.getRelatedDefinition(ctx, col, key)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			err = db.collectFilterSourcePaths(
+				ctx,
+				relatedCol,
+				innerMap,
+				collectionID,
+				appendPath(path, key),
+				pathsHit,
+				paths,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getRelatedDefinition returns the definition of the collection that the given relation field of the
+// given collection points to.
+//
+// It returns false if the field is not a relation field.
+func (db *DB) getRelatedDefinition(
+	ctx context.Context,
+	col client.CollectionDefinition,
+	fieldName string,
+) (client.CollectionDefinition, bool, error) {
+	field, ok := col.GetFieldByName(fieldName)
+	if !ok || !field.IsRelation() || !field.Kind.IsObject() {
+		return client.CollectionDefinition{}, false, nil
+	}
+
+	return client.GetDefinitionFromStore(ctx, db, col, field.Kind)
+}
+
+func addSourcePath(
+	col client.CollectionDefinition,
+	collectionID string,
+	path []string,
+	pathsHit map[string]struct{},
+	paths *[][]string,
+) {
+	if col.Version.CollectionID != collectionID {
+		return
+	}
+
+	// `/` is not a valid field name character, so the joined path is unique to the path.
+	identifier := strings.Join(path, "/")
+	if _, ok := pathsHit[identifier]; ok {
+		return
+	}
+	pathsHit[identifier] = struct{}{}
+	*paths = append(*paths, path)
+}
+
+func appendPath(path []string, fieldName string) []string {
+	result := make([]string, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, fieldName)
+}
+
+// getViewSourceRootDocIDs returns the IDs of the root documents of the given view's query whose items
+// may be affected by a change to the document with the given ID.
+//
+// These are the root documents that are currently related to the document through any of the given
+// relation paths, and the root documents whose cached items currently hold the document.
+func (db *DB) getViewSourceRootDocIDs(
+	ctx context.Context,
+	view client.CollectionDefinition,
+	rootCol client.CollectionDefinition,
+	relationPaths [][]string,
+	docID string,
+) ([]string, error) {
+	rootDocIDs := []string{}
+	rootDocIDsHit := map[string]struct{}{}
+	addRootDocID := func(rootDocID string) {
+		if _, ok := rootDocIDsHit[rootDocID]; ok {
+			return
+		}
+		rootDocIDsHit[rootDocID] = struct{}{}
+		rootDocIDs = append(rootDocIDs, rootDocID)
+	}
+
+	conditions := []any{}
+	for _, path := range relationPaths {
+		if len(path) == 0 {
+			addRootDocID(docID)
+			continue
+		}
+
+		var condition map[string]any = map[string]any{
+			request.DocIDFieldName: map[string]any{"_eq": docID},
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			condition = map[string]any{path[i]: condition}
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if len(conditions) > 0 {
+		relatedDocIDs, err := db.getFilteredDocIDs(ctx, rootCol, map[string]any{request.FilterOpOr: conditions})
+		if err != nil {
+			return nil, err
+		}
+		for _, relatedDocID := range relatedDocIDs {
+			addRootDocID(relatedDocID)
+		}
+
+		// Documents that are no longer related to the given document can only be found
+		// via the items previously cached for them.
+		cachedDocIDs, err := db.getViewCacheDocIDsHolding(ctx, view, docID)
+		if err != nil {
+			return nil, err
+		}
+		for _, cachedDocID := range cachedDocIDs {
+			addRootDocID(cachedDocID)
+		}
+	}
+
+	return rootDocIDs, nil
+}
+
+// getFilteredDocIDs returns the IDs of the documents in the given collection that match the given
+// filter conditions.
+func (db *DB) getFilteredDocIDs(
+	ctx context.Context,
+	col client.CollectionDefinition,
+	conditions map[string]any,
+) (docIDs []string, err error) {
+	p := planner.New(ctx, identity.FromContext(ctx), db.documentACP, db)
+
+	source, err := p.MakeSelectionPlan(&request.Select{
+		Field: request.Field{
+			Name: col.GetName(),
+		},
+		Filterable: request.Filterable{
+			Filter: immutable.Some(request.Filter{Conditions: conditions}),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = source.Init()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		defErr := source.Close()
+		if err == nil {
+			err = defErr
+		}
+	}()
+
+	err = source.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		hasValue, err := source.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !hasValue {
+			break
+		}
+
+		doc := source.Value()
+		docIDs = append(docIDs, doc.GetID())
+	}
+
+	return docIDs, nil
+}
+
+// getViewCacheDocIDsHolding returns the IDs of the root documents of the cached items of the given view
+// that hold the given DocID.
+func (db *DB) getViewCacheDocIDsHolding(
+	ctx context.Context,
+	view client.CollectionDefinition,
+	docID string,
+) ([]string, error) {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	shortID, err := id.GetShortCollectionID(ctx, view.Version.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := keys.ViewCacheSourceKey{
+		CollectionShortID: shortID,
+		SourceDocID:       docID,
+	}.Bytes()
+	iter, err := txn.Datastore().Iterator(ctx, corekv.IterOptions{
+		Prefix:   prefix,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docIDs := []string{}
+	for {
+		hasNext, err := iter.Next()
+		if err != nil {
+			return nil, errors.Join(err, iter.Close())
+		}
+		if !hasNext {
+			break
+		}
+
+		docIDs = append(docIDs, getViewItemID(prefix, iter.Key()))
+	}
+
+	return docIDs, iter.Close()
+}
+
+// setViewItemSources adds the given serialized view item, sourced from the document with the given ID,
+// to the reverse index of the documents it holds.
+func setViewItemSources(ctx context.Context, shortID uint32, itemDocID string, serializedItem []byte) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	sourceDocIDs, err := getViewItemSourceDocIDs(serializedItem)
+	if err != nil {
+		return err
+	}
+
+	for sourceDocID := range sourceDocIDs {
+		err = txn.Datastore().Set(ctx, keys.NewViewCacheSourceKey(shortID, sourceDocID, itemDocID).Bytes(), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteViewItemSources removes the cached view item with the given key, sourced from the document with
+// the given ID, from the reverse index of the documents it holds.
+func deleteViewItemSources(ctx context.Context, shortID uint32, itemDocID string, itemKey []byte) error {
+	txn := datastore.CtxMustGetTxn(ctx)
+
+	serializedItem, err := txn.Datastore().Get(ctx, itemKey)
+	if errors.Is(err, corekv.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sourceDocIDs, err := getViewItemSourceDocIDs(serializedItem)
+	if err != nil {
+		return err
+	}
+
+	for sourceDocID := range sourceDocIDs {
+		err = txn.Datastore().Delete(ctx, keys.NewViewCacheSourceKey(shortID, sourceDocID, itemDocID).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getViewItemSourceDocIDs returns the set of DocIDs held by the given serialized view item.
+//
+// These are the IDs of the item and its related documents, and the values of its relation ID fields.
+//
+// The serialized item is read, instead of the item, so that the same DocIDs are found when the item
+// is written and when it is deleted, regardless of the mapping it is read with.
+func getViewItemSourceDocIDs(serializedItem []byte) (map[string]struct{}, error) {
+	var fields []any
+	err := json.Unmarshal(serializedItem, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	docIDs := map[string]struct{}{}
+	addViewItemSourceDocIDs(fields, docIDs)
+	return docIDs, nil
+}
+
+func addViewItemSourceDocIDs(fields []any, docIDs map[string]struct{}) {
+	for _, field := range fields {
+		switch typedField := field.(type) {
+		case []any:
+			// Related documents, and arrays of them, are serialized as arrays.
+			addViewItemSourceDocIDs(typedField, docIDs)
+
+		case string:
+			// DocIDs are content identifiers, so they can not be mistaken for any other string value.
+			if _, err := client.NewDocIDFromString(typedField); err == nil {
+				docIDs[typedField] = struct{}{}
+			}
+		}
+	}
+}
+
+// refreshViewItems replaces the cached items of the given view that are sourced from the root
+// documents with the given IDs.
+func (db *DB) refreshViewItems(
+	ctx context.Context,
+	view client.CollectionDefinition,
+	docIDs []string,
+) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+
+	return db.setViewCacheItems(ctx, view, immutable.Some(docIDs))
+}
+
+// isIncrementallyRefreshable returns true if the cache of the given view may be refreshed one item
+// at a time.
+//
+// This is the case for auto refreshed views whose items each map to a single document of the root
+// collection of their query, and do not hold aggregates that may be affected by documents
+// not held by the item.
+func isIncrementallyRefreshable(view client.CollectionDefinition) bool {
+	if !view.Version.IsAutoRefreshed {
+		return false
+	}
+
+	querySources := view.Version.QuerySources()
+	if len(querySources) != 1 || !planner.ViewItemsMapToDocuments(querySources[0]) {
+		return false
+	}
+
+	return !hasAggregate(querySources[0].Query.Fields)
+}
+
+func hasAggregate(fields []request.Selection) bool {
+	for _, field := range fields {
+		switch typedField := field.(type) {
+		case *request.Aggregate:
+			return true
+		case *request.Select:
+			if hasAggregate(typedField.Fields) {
+				return true
+			}
+		}
+	}
+	return false
+}
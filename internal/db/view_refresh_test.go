@@ -0,0 +1,80 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestRefreshViewsForDocument_WithStaleView_RebuildsCache(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newBadgerDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	views, err := db.AddView(
+		ctx,
+		`User { name }`,
+		`type UserView @materialized(autoRefresh: true) { name: String }`,
+		immutable.None[model.Lens](),
+	)
+	require.NoError(t, err)
+	view := views[0]
+
+	// The cache is cleared and marked as stale, as it would be left by a failed refresh.
+	txnCtx, txn, err := ensureContextTxn(ctx, db, false)
+	require.NoError(t, err)
+	err = db.clearViewCache(txnCtx, view)
+	require.NoError(t, err)
+	err = txn.Commit(txnCtx)
+	require.NoError(t, err)
+
+	err = db.markViewStale(ctx, view)
+	require.NoError(t, err)
+
+	// Refreshing the view for a document that it does not hold rebuilds the whole cache, as it is stale.
+	err = db.refreshViewsForDocument(ctx, col.Version().CollectionID, "bae-00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+
+	result := db.ExecRequest(ctx, `query { UserView { name } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.Equal(
+		t,
+		map[string]any{"UserView": []map[string]any{{"name": "John"}}},
+		result.GQL.Data,
+	)
+
+	txnCtx, txn, err = ensureContextTxn(ctx, db, true)
+	require.NoError(t, err)
+	defer txn.Discard(txnCtx)
+	isStale, err := isViewStale(txnCtx, view)
+	require.NoError(t, err)
+	require.False(t, isStale)
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/encoding"
+)
+
+// BlindIndexValue returns the value stored in a blind index of the collection with the given ID
+// in place of the given value.
+//
+// The returned value is an HMAC of the encoded value, keyed by a key derived from the given secret
+// and the collection ID. Equal values thus have the same blind value within a collection,
+// but different blind values across collections. Nil values are returned as is.
+func BlindIndexValue(
+	secret []byte,
+	collectionID string,
+	value client.NormalValue,
+) (client.NormalValue, error) {
+	if value.IsNil() {
+		return value, nil
+	}
+
+	if len(secret) == 0 {
+		return nil, ErrBlindIndexSecretNotSet
+	}
+
+	colMac := hmac.New(sha256.New, secret)
+	colMac.Write([]byte(collectionID))
+
+	mac := hmac.New(sha256.New, colMac.Sum(nil))
+	mac.Write(encoding.EncodeFieldValue(nil, value, false))
+	return client.NewNormalString(string(mac.Sum(nil))), nil
+}
@@ -15,6 +15,7 @@ import (
 	"crypto/rand"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/sourcenetwork/immutable"
@@ -29,7 +30,7 @@ const keyLength = 32 // 32 bytes for AES-256
 const testEncryptionKey = "examplekey1234567890examplekey12"
 
 // generateEncryptionKey generates a random AES key.
-func generateEncryptionKey(_ string, _ immutable.Option[string]) ([]byte, error) {
+func generateEncryptionKey(_ string, _ immutable.Option[string], _ uint64) ([]byte, error) {
 	key := make([]byte, keyLength)
 	if _, err := io.ReadFull(rand.Reader, key); err != nil {
 		return nil, err
@@ -40,10 +41,27 @@ func generateEncryptionKey(_ string, _ immutable.Option[string]) ([]byte, error)
 // generateTestEncryptionKey generates a deterministic encryption key for testing.
 // While testing, we also want to make sure different keys are generated for different docs and fields
 // and that's why we use the docID and fieldName to generate the key.
-func generateTestEncryptionKey(docID string, fieldName immutable.Option[string]) ([]byte, error) {
+// Rotated keys also use the key epoch, so that every rotation generates a different key.
+func generateTestEncryptionKey(docID string, fieldName immutable.Option[string], epoch uint64) ([]byte, error) {
+	if epoch > 0 {
+		return []byte(strconv.FormatUint(epoch, 10) + fieldName.Value() + docID + testEncryptionKey)[0:keyLength], nil
+	}
 	return []byte(fieldName.Value() + docID + testEncryptionKey)[0:keyLength], nil
 }
 
+// GenerateRotatedEncryptionKey generates a new encryption key for the given docID, (optional) fieldName
+// and key epoch.
+//
+// It is used to rotate the key of a document, or of an individually encrypted field, that has already
+// been encrypted.
+func GenerateRotatedEncryptionKey(
+	docID string,
+	fieldName immutable.Option[string],
+	epoch uint64,
+) ([]byte, error) {
+	return generateEncryptionKeyFunc(docID, fieldName, epoch)
+}
+
 // DocEncryptor is a document encryptor that encrypts and decrypts individual document fields.
 // It acts based on the configuration [DocEncConfig] provided and data stored in the provided store.
 // DocEncryptor is a session-bound, i.e. once a user requests to create (or update) a document or a node
@@ -159,7 +177,7 @@ func (d *DocEncryptor) generateEncryptionKey(
 		return nil, nil
 	}
 
-	encryptionKey, err := generateEncryptionKeyFunc(docID, fieldName)
+	encryptionKey, err := generateEncryptionKeyFunc(docID, fieldName, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -15,11 +15,13 @@ import (
 )
 
 const (
-	errNoStorageProvided     string = "no storage provided"
-	errContextHasNoEncryptor string = "context has no encryptor"
+	errNoStorageProvided      string = "no storage provided"
+	errContextHasNoEncryptor  string = "context has no encryptor"
+	errBlindIndexSecretNotSet string = "blind index secret is not set"
 )
 
 var (
-	ErrNoStorageProvided     = errors.New(errNoStorageProvided)
-	ErrContextHasNoEncryptor = errors.New(errContextHasNoEncryptor)
+	ErrNoStorageProvided      = errors.New(errNoStorageProvided)
+	ErrContextHasNoEncryptor  = errors.New(errContextHasNoEncryptor)
+	ErrBlindIndexSecretNotSet = errors.New(errBlindIndexSecretNotSet)
 )
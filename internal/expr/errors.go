@@ -0,0 +1,92 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package expr
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errUnexpectedCharacter  string = "unexpected character"
+	errUnexpectedToken      string = "unexpected token"
+	errUnterminatedString   string = "unterminated string"
+	errInvalidNumber        string = "invalid number"
+	errUnknownFunction      string = "unknown function"
+	errInvalidArgumentCount string = "invalid number of arguments"
+	errUnknownField         string = "unknown field"
+	errInvalidOperandType   string = "invalid operand type"
+	errDivisionByZero       string = "division by zero"
+)
+
+// Errors returnable from this package.
+//
+// This list is incomplete and undefined errors may also be returned.
+// Errors returned from this package may be tested against these errors with errors.Is.
+var (
+	ErrUnexpectedCharacter  = errors.New(errUnexpectedCharacter)
+	ErrUnexpectedToken      = errors.New(errUnexpectedToken)
+	ErrUnterminatedString   = errors.New(errUnterminatedString)
+	ErrInvalidNumber        = errors.New(errInvalidNumber)
+	ErrUnknownFunction      = errors.New(errUnknownFunction)
+	ErrInvalidArgumentCount = errors.New(errInvalidArgumentCount)
+	ErrUnknownField         = errors.New(errUnknownField)
+	ErrInvalidOperandType   = errors.New(errInvalidOperandType)
+	ErrDivisionByZero       = errors.New(errDivisionByZero)
+)
+
+func NewErrUnexpectedCharacter(character rune, position int) error {
+	return errors.New(
+		errUnexpectedCharacter,
+		errors.NewKV("Character", string(character)),
+		errors.NewKV("Position", position),
+	)
+}
+
+func NewErrUnexpectedToken(token string, position int) error {
+	return errors.New(
+		errUnexpectedToken,
+		errors.NewKV("Token", token),
+		errors.NewKV("Position", position),
+	)
+}
+
+func NewErrUnterminatedString(position int) error {
+	return errors.New(errUnterminatedString, errors.NewKV("Position", position))
+}
+
+func NewErrInvalidNumber(number string, inner error) error {
+	return errors.Wrap(errInvalidNumber, inner, errors.NewKV("Number", number))
+}
+
+func NewErrUnknownFunction(name string) error {
+	return errors.New(errUnknownFunction, errors.NewKV("Function", name))
+}
+
+func NewErrInvalidArgumentCount(function string, expected string, actual int) error {
+	return errors.New(
+		errInvalidArgumentCount,
+		errors.NewKV("Function", function),
+		errors.NewKV("Expected", expected),
+		errors.NewKV("Actual", actual),
+	)
+}
+
+func NewErrUnknownField(name string) error {
+	return errors.New(errUnknownField, errors.NewKV("Field", name))
+}
+
+func NewErrInvalidOperandType(operator string, operand any) error {
+	return errors.New(
+		errInvalidOperandType,
+		errors.NewKV("Operator", operator),
+		errors.NewKV("Operand", operand),
+	)
+}
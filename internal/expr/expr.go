@@ -0,0 +1,119 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+// Package expr provides a small expression language used to compute the value of a field
+// from the other fields of the same document.
+//
+// Expressions are made of field names, literals (numbers, single or double quoted strings,
+// true and false), the arithmetic operators +, -, *, / and %, parentheses and calls to the
+// following functions:
+//   - concat(values...) concatenates its arguments as strings, skipping nil values
+//   - lower(string) and upper(string) change the case of a string
+//   - coalesce(values...) returns its first non-nil argument
+//
+// Arithmetic on integers yields integers, any float operand yields a float. Operations on nil
+// values yield nil, with the exception of concat and coalesce.
+package expr
+
+import (
+	"slices"
+)
+
+// Type is the type of the value an expression evaluates to.
+type Type uint8
+
+// The types an expression may evaluate to.
+const (
+	TypeInt = Type(iota)
+	TypeFloat
+	TypeString
+	TypeBool
+)
+
+// String returns the name of the type.
+func (t Type) String() string {
+	switch t {
+	case TypeInt:
+		return "Int"
+	case TypeFloat:
+		return "Float"
+	case TypeString:
+		return "String"
+	case TypeBool:
+		return "Boolean"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsNumeric returns true if values of this type may be used in arithmetic operations.
+func (t Type) IsNumeric() bool {
+	return t == TypeInt || t == TypeFloat
+}
+
+// Expression is a parsed expression.
+type Expression struct {
+	source string
+	root   node
+	fields []string
+}
+
+// Parse parses the given source into an expression.
+func Parse(source string) (*Expression, error) {
+	p, err := newParser(source)
+	if err != nil {
+		return nil, err
+	}
+	root, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{}
+	root.walk(func(n node) {
+		if f, ok := n.(*fieldNode); ok && !slices.Contains(fields, f.name) {
+			fields = append(fields, f.name)
+		}
+	})
+	slices.Sort(fields)
+
+	return &Expression{
+		source: source,
+		root:   root,
+		fields: fields,
+	}, nil
+}
+
+// String returns the source the expression was parsed from.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Fields returns the names of the fields referenced by the expression, in lexicographical order.
+func (e *Expression) Fields() []string {
+	return e.fields
+}
+
+// Type returns the type of the values the expression evaluates to, given the types of the fields
+// it references.
+//
+// An error is returned if a referenced field is missing from the given types, or if an operand
+// is not of a type supported by its operator.
+func (e *Expression) Type(fieldTypes map[string]Type) (Type, error) {
+	return e.root.typ(fieldTypes)
+}
+
+// Eval evaluates the expression against the given field values.
+//
+// Missing fields are treated as nil. Field values must be of one of the Go types yielded by
+// expressions (int64, float64, string and bool), or be convertible to one.
+func (e *Expression) Eval(values map[string]any) (any, error) {
+	return e.root.eval(values)
+}
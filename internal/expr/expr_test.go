@@ -0,0 +1,150 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	values := map[string]any{
+		"first": "John",
+		"last":  "Doe",
+		"qty":   int64(3),
+		"price": float64(2.5),
+		"age":   int64(21),
+		"none":  nil,
+	}
+
+	tests := []struct {
+		expression string
+		expected   any
+	}{
+		{expression: "qty * price", expected: float64(7.5)},
+		{expression: "qty + 2 * 3", expected: int64(9)},
+		{expression: "(qty + 2) * 3", expected: int64(15)},
+		{expression: "age / 2", expected: int64(10)},
+		{expression: "age % 4", expected: int64(1)},
+		{expression: "-qty - 1", expected: int64(-4)},
+		{expression: "age / 2.0", expected: float64(10.5)},
+		{expression: "concat(first, ' ', last)", expected: "John Doe"},
+		{expression: `concat(upper(last), ", ", first, " (", age, ")")`, expected: "DOE, John (21)"},
+		{expression: `concat('it\'s')`, expected: "it's"},
+		{expression: "lower(first)", expected: "john"},
+		{expression: "concat(first, none)", expected: "John"},
+		{expression: "qty * none", expected: nil},
+		{expression: "upper(none)", expected: nil},
+		{expression: "coalesce(none, missing, qty)", expected: int64(3)},
+		{expression: "true", expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			e, err := Parse(test.expression)
+			require.NoError(t, err)
+
+			actual, err := e.Eval(values)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestEval_WithDivisionByZero_Errors(t *testing.T) {
+	e, err := Parse("qty / 0")
+	require.NoError(t, err)
+
+	_, err = e.Eval(map[string]any{"qty": int64(3)})
+	require.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestEval_WithInvalidOperand_Errors(t *testing.T) {
+	e, err := Parse("qty * 2")
+	require.NoError(t, err)
+
+	_, err = e.Eval(map[string]any{"qty": "3"})
+	require.ErrorIs(t, err, ErrInvalidOperandType)
+}
+
+func TestParse_ReturnsReferencedFields(t *testing.T) {
+	e, err := Parse("concat(last, ', ', first, last)")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"first", "last"}, e.Fields())
+}
+
+func TestParse_WithInvalidExpression_Errors(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   error
+	}{
+		{expression: "qty *", expected: ErrUnexpectedToken},
+		{expression: "qty price", expected: ErrUnexpectedToken},
+		{expression: "(qty + 1", expected: ErrUnexpectedToken},
+		{expression: "concat(first,)", expected: ErrUnexpectedToken},
+		{expression: "qty & 1", expected: ErrUnexpectedCharacter},
+		{expression: "concat('John)", expected: ErrUnterminatedString},
+		{expression: "1.2.3", expected: ErrInvalidNumber},
+		{expression: "sum(qty)", expected: ErrUnknownFunction},
+		{expression: "upper(first, last)", expected: ErrInvalidArgumentCount},
+		{expression: "concat()", expected: ErrInvalidArgumentCount},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			_, err := Parse(test.expression)
+			require.ErrorIs(t, err, test.expected)
+		})
+	}
+}
+
+func TestType(t *testing.T) {
+	fieldTypes := map[string]Type{
+		"name":   TypeString,
+		"qty":    TypeInt,
+		"price":  TypeFloat,
+		"active": TypeBool,
+	}
+
+	tests := []struct {
+		expression string
+		expected   Type
+		err        error
+	}{
+		{expression: "qty * 2", expected: TypeInt},
+		{expression: "qty * price", expected: TypeFloat},
+		{expression: "concat(name, qty)", expected: TypeString},
+		{expression: "coalesce(qty, price)", expected: TypeFloat},
+		{expression: "active", expected: TypeBool},
+		{expression: "name * 2", err: ErrInvalidOperandType},
+		{expression: "price % 2", err: ErrInvalidOperandType},
+		{expression: "upper(qty)", err: ErrInvalidOperandType},
+		{expression: "coalesce(name, qty)", err: ErrInvalidOperandType},
+		{expression: "total * 2", err: ErrUnknownField},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			e, err := Parse(test.expression)
+			require.NoError(t, err)
+
+			actual, err := e.Type(fieldTypes)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
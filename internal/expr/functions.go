@@ -0,0 +1,133 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package expr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// function is a function that may be called from an expression.
+type function struct {
+	minArgs int
+	// maxArgs is the maximum number of arguments of the function, a negative value
+	// allows any number of arguments.
+	maxArgs int
+
+	typ  func(name string, args []Type) (Type, error)
+	eval func(name string, args []any) (any, error)
+}
+
+func (fn function) arity() string {
+	switch {
+	case fn.maxArgs < 0:
+		return "at least " + strconv.Itoa(fn.minArgs)
+	case fn.minArgs == fn.maxArgs:
+		return strconv.Itoa(fn.minArgs)
+	default:
+		return strconv.Itoa(fn.minArgs) + " to " + strconv.Itoa(fn.maxArgs)
+	}
+}
+
+// functions contains the functions that may be called from expressions, mapped by name.
+var functions = map[string]function{
+	"concat": {
+		minArgs: 1,
+		maxArgs: -1,
+		typ: func(string, []Type) (Type, error) {
+			return TypeString, nil
+		},
+		eval: evalConcat,
+	},
+	"lower": {
+		minArgs: 1,
+		maxArgs: 1,
+		typ:     stringFunctionType,
+		eval: func(name string, args []any) (any, error) {
+			return evalStringFunction(name, args[0], strings.ToLower)
+		},
+	},
+	"upper": {
+		minArgs: 1,
+		maxArgs: 1,
+		typ:     stringFunctionType,
+		eval: func(name string, args []any) (any, error) {
+			return evalStringFunction(name, args[0], strings.ToUpper)
+		},
+	},
+	"coalesce": {
+		minArgs: 1,
+		maxArgs: -1,
+		typ:     coalesceType,
+		eval:    evalCoalesce,
+	},
+}
+
+func evalConcat(name string, args []any) (any, error) {
+	var result strings.Builder
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case nil:
+		case string:
+			result.WriteString(v)
+		case int64:
+			result.WriteString(strconv.FormatInt(v, 10))
+		case float64:
+			result.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			result.WriteString(strconv.FormatBool(v))
+		default:
+			return nil, NewErrInvalidOperandType(name, arg)
+		}
+	}
+	return result.String(), nil
+}
+
+func stringFunctionType(name string, args []Type) (Type, error) {
+	if args[0] != TypeString {
+		return 0, NewErrInvalidOperandType(name, args[0])
+	}
+	return TypeString, nil
+}
+
+func evalStringFunction(name string, arg any, f func(string) string) (any, error) {
+	switch v := arg.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return f(v), nil
+	default:
+		return nil, NewErrInvalidOperandType(name, arg)
+	}
+}
+
+func coalesceType(name string, args []Type) (Type, error) {
+	result := args[0]
+	for _, t := range args[1:] {
+		switch {
+		case t == result:
+		case t.IsNumeric() && result.IsNumeric():
+			result = TypeFloat
+		default:
+			return 0, NewErrInvalidOperandType(name, t)
+		}
+	}
+	return result, nil
+}
+
+func evalCoalesce(_ string, args []any) (any, error) {
+	for _, arg := range args {
+		if arg != nil {
+			return arg, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package expr
+
+import (
+	"math"
+)
+
+// node is a node of a parsed expression tree.
+type node interface {
+	// walk calls the given function with this node and all of its descendants.
+	walk(func(node))
+
+	// typ returns the type of the values this node evaluates to.
+	typ(fieldTypes map[string]Type) (Type, error)
+
+	// eval evaluates this node against the given field values.
+	eval(values map[string]any) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) walk(f func(node)) {
+	f(n)
+}
+
+func (n *literalNode) typ(map[string]Type) (Type, error) {
+	return typeOf(n.value)
+}
+
+func (n *literalNode) eval(map[string]any) (any, error) {
+	return n.value, nil
+}
+
+type fieldNode struct {
+	name string
+}
+
+func (n *fieldNode) walk(f func(node)) {
+	f(n)
+}
+
+func (n *fieldNode) typ(fieldTypes map[string]Type) (Type, error) {
+	t, ok := fieldTypes[n.name]
+	if !ok {
+		return 0, NewErrUnknownField(n.name)
+	}
+	return t, nil
+}
+
+func (n *fieldNode) eval(values map[string]any) (any, error) {
+	return normalize(values[n.name]), nil
+}
+
+type negateNode struct {
+	operand node
+}
+
+func (n *negateNode) walk(f func(node)) {
+	f(n)
+	n.operand.walk(f)
+}
+
+func (n *negateNode) typ(fieldTypes map[string]Type) (Type, error) {
+	t, err := n.operand.typ(fieldTypes)
+	if err != nil {
+		return 0, err
+	}
+	if !t.IsNumeric() {
+		return 0, NewErrInvalidOperandType("-", t)
+	}
+	return t, nil
+}
+
+func (n *negateNode) eval(values map[string]any) (any, error) {
+	value, err := n.operand.eval(values)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		return -v, nil
+	case float64:
+		return -v, nil
+	default:
+		return nil, NewErrInvalidOperandType("-", value)
+	}
+}
+
+type binaryNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (n *binaryNode) walk(f func(node)) {
+	f(n)
+	n.left.walk(f)
+	n.right.walk(f)
+}
+
+func (n *binaryNode) typ(fieldTypes map[string]Type) (Type, error) {
+	left, err := n.left.typ(fieldTypes)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.typ(fieldTypes)
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range []Type{left, right} {
+		if !t.IsNumeric() || (n.op == "%" && t != TypeInt) {
+			return 0, NewErrInvalidOperandType(n.op, t)
+		}
+	}
+	if left == TypeInt && right == TypeInt {
+		return TypeInt, nil
+	}
+	return TypeFloat, nil
+}
+
+func (n *binaryNode) eval(values map[string]any) (any, error) {
+	left, err := n.left.eval(values)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(values)
+	if err != nil {
+		return nil, err
+	}
+	if left == nil || right == nil {
+		return nil, nil
+	}
+
+	leftInt, leftIsInt := left.(int64)
+	rightInt, rightIsInt := right.(int64)
+	if leftIsInt && rightIsInt {
+		return evalIntOperation(n.op, leftInt, rightInt)
+	}
+
+	leftFloat, ok := toFloat(left)
+	if !ok || n.op == "%" {
+		return nil, NewErrInvalidOperandType(n.op, left)
+	}
+	rightFloat, ok := toFloat(right)
+	if !ok {
+		return nil, NewErrInvalidOperandType(n.op, right)
+	}
+	return evalFloatOperation(n.op, leftFloat, rightFloat)
+}
+
+func evalIntOperation(op string, left, right int64) (any, error) {
+	switch op {
+	case "+":
+		return left + right, nil
+	case "-":
+		return left - right, nil
+	case "*":
+		return left * right, nil
+	case "/":
+		if right == 0 {
+			return nil, ErrDivisionByZero
+		}
+		return left / right, nil
+	default:
+		if right == 0 {
+			return nil, ErrDivisionByZero
+		}
+		return left % right, nil
+	}
+}
+
+func evalFloatOperation(op string, left, right float64) (any, error) {
+	switch op {
+	case "+":
+		return left + right, nil
+	case "-":
+		return left - right, nil
+	case "*":
+		return left * right, nil
+	default:
+		if right == 0 {
+			return nil, ErrDivisionByZero
+		}
+		return left / right, nil
+	}
+}
+
+type callNode struct {
+	name string
+	fn   function
+	args []node
+}
+
+func (n *callNode) walk(f func(node)) {
+	f(n)
+	for _, arg := range n.args {
+		arg.walk(f)
+	}
+}
+
+func (n *callNode) typ(fieldTypes map[string]Type) (Type, error) {
+	argTypes := make([]Type, len(n.args))
+	for i, arg := range n.args {
+		t, err := arg.typ(fieldTypes)
+		if err != nil {
+			return 0, err
+		}
+		argTypes[i] = t
+	}
+	return n.fn.typ(n.name, argTypes)
+}
+
+func (n *callNode) eval(values map[string]any) (any, error) {
+	args := make([]any, len(n.args))
+	for i, arg := range n.args {
+		value, err := arg.eval(values)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = value
+	}
+	return n.fn.eval(n.name, args)
+}
+
+// typeOf returns the type of the given normalized value.
+func typeOf(value any) (Type, error) {
+	switch value.(type) {
+	case int64:
+		return TypeInt, nil
+	case float64:
+		return TypeFloat, nil
+	case string:
+		return TypeString, nil
+	case bool:
+		return TypeBool, nil
+	default:
+		return 0, NewErrInvalidOperandType("", value)
+	}
+}
+
+// normalize converts the given field value to the Go type used for its [Type] by expressions.
+//
+// Values that can not be converted are returned as is, and will be rejected by the operators.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v)
+		}
+		return float64(v)
+	case float32:
+		return float64(v)
+	default:
+		return value
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package keys
+
+import (
+	"fmt"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+)
+
+// ACPAuditRecordKey is the key of a record within the acp audit log.
+//
+// Keys are ordered by the time at which the record was made, the sequence number orders the
+// records made at the same time.
+type ACPAuditRecordKey struct {
+	// Timestamp is the time at which the record was made.
+	Timestamp time.Time
+	// Seq is the sequence number of the record.
+	Seq uint64
+}
+
+var _ Key = (*ACPAuditRecordKey)(nil)
+
+func NewACPAuditRecordKey(timestamp time.Time, seq uint64) ACPAuditRecordKey {
+	return ACPAuditRecordKey{Timestamp: timestamp, Seq: seq}
+}
+
+func (k ACPAuditRecordKey) ToString() string {
+	// Zero padding the numbers keeps the lexicographic order of the keys chronological.
+	return fmt.Sprintf("/%020d/%020d", k.Timestamp.UnixNano(), k.Seq)
+}
+
+func (k ACPAuditRecordKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k ACPAuditRecordKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
@@ -11,5 +11,6 @@
 package keys
 
 const (
-	COLLECTION_VIEW_ITEMS = "/collection/vi"
+	COLLECTION_VIEW_ITEMS   = "/collection/vi"
+	COLLECTION_VIEW_SOURCES = "/collection/vs"
 )
@@ -38,6 +38,11 @@ func (k PrimaryDataStoreKey) Bytes() []byte {
 	return []byte(k.ToString())
 }
 
+// PrefixEnd returns a key that would sort immediately after all keys with this prefix.
+func (k PrimaryDataStoreKey) PrefixEnd() []byte {
+	return bytesPrefixEnd(k.Bytes())
+}
+
 func (k PrimaryDataStoreKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
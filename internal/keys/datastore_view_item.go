@@ -21,8 +21,9 @@ import (
 // ViewCacheKey is a trimmed down [DataStoreKey] used for caching the results
 // of View items.
 //
-// It is stored in the format `/collection/vi/[CollectionRootID]/[ItemID]`. It points to the
-// full serialized View item.
+// It is stored in the format `/collection/vi/[CollectionRootID]/[ItemID]`, or
+// `/collection/vi/[CollectionRootID]/[DocID]` for items that are maintained incrementally.
+// It points to the full serialized View item.
 type ViewCacheKey struct {
 	// CollectionShortID is the id of the Collection that this item belongs to.
 	CollectionShortID uint32
@@ -32,6 +33,12 @@ type ViewCacheKey struct {
 	// For now this is essentially just the index of the item in the result-set, however
 	// that is likely to change in the near future.
 	ItemID uint
+
+	// DocID is the ID of the source document that the View item was produced from.
+	//
+	// It is only set for the items of views that are maintained incrementally, in which case
+	// ItemID is not set.
+	DocID string
 }
 
 var _ Key = (*ViewCacheKey)(nil)
@@ -49,6 +56,13 @@ func NewViewCacheKey(collectionShortID uint32, itemID uint) ViewCacheKey {
 	}
 }
 
+func NewViewCacheDocKey(collectionShortID uint32, docID string) ViewCacheKey {
+	return ViewCacheKey{
+		CollectionShortID: collectionShortID,
+		DocID:             docID,
+	}
+}
+
 func (k ViewCacheKey) ToString() string {
 	return string(k.Bytes())
 }
@@ -66,6 +80,11 @@ func (k ViewCacheKey) Bytes() []byte {
 		result = encoding.EncodeUvarintAscending(result, uint64(k.ItemID))
 	}
 
+	if k.DocID != "" {
+		result = append(result, '/')
+		result = append(result, []byte(k.DocID)...)
+	}
+
 	return result
 }
 
@@ -82,6 +101,9 @@ func (k ViewCacheKey) PrettyPrint() string {
 	if k.ItemID != 0 {
 		result = result + "/" + strconv.Itoa(int(k.ItemID))
 	}
+	if k.DocID != "" {
+		result = result + "/" + k.DocID
+	}
 
 	return result
 }
@@ -0,0 +1,96 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package keys
+
+import (
+	"strconv"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/internal/encoding"
+)
+
+// ViewCacheSourceKey is the key of an entry of the reverse index from the documents held by the items
+// of a view that is maintained incrementally, to those items.
+//
+// It is stored in the format `/collection/vs/[CollectionRootID]/[SourceDocID]/[ItemDocID]` and holds
+// no value.
+type ViewCacheSourceKey struct {
+	// CollectionShortID is the id of the Collection of the view.
+	CollectionShortID uint32
+
+	// SourceDocID is the ID of a document held by the View item.
+	SourceDocID string
+
+	// ItemDocID is the ID of the source document that the View item was produced from.
+	ItemDocID string
+}
+
+var _ Key = (*ViewCacheSourceKey)(nil)
+
+func NewViewCacheSourceColPrefix(collectionShortID uint32) ViewCacheSourceKey {
+	return ViewCacheSourceKey{
+		CollectionShortID: collectionShortID,
+	}
+}
+
+func NewViewCacheSourceKey(collectionShortID uint32, sourceDocID string, itemDocID string) ViewCacheSourceKey {
+	return ViewCacheSourceKey{
+		CollectionShortID: collectionShortID,
+		SourceDocID:       sourceDocID,
+		ItemDocID:         itemDocID,
+	}
+}
+
+func (k ViewCacheSourceKey) ToString() string {
+	return string(k.Bytes())
+}
+
+func (k ViewCacheSourceKey) Bytes() []byte {
+	result := []byte(COLLECTION_VIEW_SOURCES)
+
+	if k.CollectionShortID != 0 {
+		result = append(result, '/')
+		result = encoding.EncodeUvarintAscending(result, uint64(k.CollectionShortID))
+	}
+
+	if k.SourceDocID != "" {
+		result = append(result, '/')
+		result = append(result, []byte(k.SourceDocID)...)
+	}
+
+	if k.ItemDocID != "" {
+		result = append(result, '/')
+		result = append(result, []byte(k.ItemDocID)...)
+	}
+
+	return result
+}
+
+func (k ViewCacheSourceKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func (k ViewCacheSourceKey) PrettyPrint() string {
+	result := COLLECTION_VIEW_SOURCES
+
+	if k.CollectionShortID != 0 {
+		result = result + "/" + strconv.Itoa(int(k.CollectionShortID))
+	}
+	if k.SourceDocID != "" {
+		result = result + "/" + k.SourceDocID
+	}
+	if k.ItemDocID != "" {
+		result = result + "/" + k.ItemDocID
+	}
+
+	return result
+}
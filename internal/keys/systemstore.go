@@ -25,4 +25,8 @@ const (
 	COLLECTION_SEQ            = "/seq/collection"
 	INDEX_ID_SEQ              = "/seq/index"
 	FIELD_ID_SEQ              = "/seq/field"
+	ENCRYPTION_EPOCH          = "/encryption/epoch"
+	ENCRYPTION_TOPIC          = "/encryption/topic"
+	DOCUMENT_MIGRATION        = "/migration/document"
+	VIEW_STALE                = "/view/stale"
 )
@@ -0,0 +1,48 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package keys
+
+import (
+	ds "github.com/ipfs/go-datastore"
+)
+
+// DocumentMigrationKey is the key of an in progress migration of the stored documents of a
+// collection to a collection version.
+//
+// The value stored under this key is the state of the migration, including the DocID of the last
+// document processed by it.
+type DocumentMigrationKey struct {
+	CollectionVersionID string
+}
+
+var _ Key = (*DocumentMigrationKey)(nil)
+
+func NewDocumentMigrationKey(collectionVersionID string) DocumentMigrationKey {
+	return DocumentMigrationKey{CollectionVersionID: collectionVersionID}
+}
+
+func (k DocumentMigrationKey) ToString() string {
+	result := DOCUMENT_MIGRATION
+
+	if k.CollectionVersionID != "" {
+		result = result + "/" + k.CollectionVersionID
+	}
+
+	return result
+}
+
+func (k DocumentMigrationKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocumentMigrationKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package keys
+
+import (
+	ds "github.com/ipfs/go-datastore"
+	"github.com/sourcenetwork/immutable"
+)
+
+// EncryptionEpochKey is the key of the latest rotated encryption key of a document, or of a field
+// of a document that is encrypted individually.
+//
+// The value stored under this key is the CID of the encryption block holding the key.
+type EncryptionEpochKey struct {
+	DocID     string
+	FieldName immutable.Option[string]
+}
+
+var _ Key = (*EncryptionEpochKey)(nil)
+
+func NewEncryptionEpochKey(docID string, fieldName immutable.Option[string]) EncryptionEpochKey {
+	return EncryptionEpochKey{DocID: docID, FieldName: fieldName}
+}
+
+func (k EncryptionEpochKey) ToString() string {
+	result := ENCRYPTION_EPOCH
+
+	if k.DocID != "" {
+		result = result + "/" + k.DocID
+	}
+	if k.FieldName.HasValue() {
+		result = result + "/" + k.FieldName.Value()
+	}
+
+	return result
+}
+
+func (k EncryptionEpochKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k EncryptionEpochKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
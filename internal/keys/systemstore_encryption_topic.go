@@ -0,0 +1,47 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package keys
+
+import (
+	ds "github.com/ipfs/go-datastore"
+)
+
+// EncryptionTopicKey is the key of the group key currently used to encrypt the pubsub topic
+// payloads of a collection.
+//
+// The value stored under this key is the ID of the topic key.
+type EncryptionTopicKey struct {
+	CollectionID string
+}
+
+var _ Key = (*EncryptionTopicKey)(nil)
+
+func NewEncryptionTopicKey(collectionID string) EncryptionTopicKey {
+	return EncryptionTopicKey{CollectionID: collectionID}
+}
+
+func (k EncryptionTopicKey) ToString() string {
+	result := ENCRYPTION_TOPIC
+
+	if k.CollectionID != "" {
+		result = result + "/" + k.CollectionID
+	}
+
+	return result
+}
+
+func (k EncryptionTopicKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k EncryptionTopicKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package keys
+
+import (
+	ds "github.com/ipfs/go-datastore"
+)
+
+// ViewStaleKey marks the cache of an auto refreshed view as stale, following a failure to refresh it.
+//
+// It holds no value, and is removed once the cache of the view has been rebuilt.
+type ViewStaleKey struct {
+	CollectionID string
+}
+
+var _ Key = (*ViewStaleKey)(nil)
+
+func NewViewStaleKey(collectionID string) ViewStaleKey {
+	return ViewStaleKey{CollectionID: collectionID}
+}
+
+func (k ViewStaleKey) ToString() string {
+	result := VIEW_STALE
+
+	if k.CollectionID != "" {
+		result = result + "/" + k.CollectionID
+	}
+
+	return result
+}
+
+func (k ViewStaleKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k ViewStaleKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
@@ -13,10 +13,14 @@ package kms
 import (
 	"context"
 
+	"github.com/fxamacker/cbor/v2"
+	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
 	"github.com/ipld/go-ipld-prime/linking"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 
+	"github.com/sourcenetwork/defradb/errors"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 	"github.com/sourcenetwork/defradb/internal/datastore"
 )
@@ -29,6 +33,10 @@ func newIPLDEncryptionStorage(encstore datastore.Blockstore) *ipldEncStorage {
 	return &ipldEncStorage{encstore: encstore}
 }
 
+// get returns the encryption block with the given CID.
+//
+// Nil is returned if the block is not found, as the node may only hold the keys of some of the
+// epochs of a document.
 func (s *ipldEncStorage) get(ctx context.Context, cidBytes []byte) (*coreblock.Encryption, error) {
 	lsys := cidlink.DefaultLinkSystem()
 	lsys.SetReadStorage(s.encstore.AsIPLDStorage())
@@ -38,6 +46,14 @@ func (s *ipldEncStorage) get(ctx context.Context, cidBytes []byte) (*coreblock.E
 		return nil, err
 	}
 
+	hasBlock, err := s.encstore.Has(ctx, blockCid)
+	if err != nil {
+		return nil, err
+	}
+	if !hasBlock {
+		return nil, nil
+	}
+
 	nd, err := lsys.Load(linking.LinkContext{Ctx: ctx}, cidlink.Link{Cid: blockCid},
 		coreblock.EncryptionSchemaPrototype)
 	if err != nil {
@@ -64,3 +80,63 @@ func (s *ipldEncStorage) put(ctx context.Context, blockBytes []byte) ([]byte, er
 
 	return []byte(link.String()), nil
 }
+
+// getTopicKey returns the topic key with the given ID.
+//
+// If the key does not exist [ErrTopicKeyNotFound] is returned.
+func (s *ipldEncStorage) getTopicKey(ctx context.Context, keyID []byte) (*topicKey, error) {
+	_, keyCid, err := cid.CidFromBytes(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := s.encstore.Get(ctx, keyCid)
+	if errors.Is(err, ipld.ErrNotFound{}) {
+		return nil, NewErrTopicKeyNotFound(keyID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &topicKey{}
+	err = cbor.Unmarshal(block.RawData(), key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// putTopicKey stores the given topic key and returns its ID.
+func (s *ipldEncStorage) putTopicKey(ctx context.Context, key *topicKey) ([]byte, error) {
+	data, err := cbor.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	block := blocks.NewBlock(data)
+	err = s.encstore.Put(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	return block.Cid().Bytes(), nil
+}
+
+// verifyTopicKeyID returns an error if the given topic key does not hash to the given ID.
+func verifyTopicKeyID(key *topicKey, keyID []byte) error {
+	_, keyCid, err := cid.CidFromBytes(keyID)
+	if err != nil {
+		return err
+	}
+	data, err := cbor.Marshal(key)
+	if err != nil {
+		return err
+	}
+	actualCid, err := keyCid.Prefix().Sum(data)
+	if err != nil {
+		return err
+	}
+	if !actualCid.Equals(keyCid) {
+		return NewErrTopicKeyIDMismatch(keyID)
+	}
+	return nil
+}
@@ -11,17 +11,39 @@
 package kms
 
 import (
+	"github.com/ipfs/go-cid"
+
 	"github.com/sourcenetwork/defradb/errors"
 )
 
 const (
-	errUnknownKMSType string = "unknown KMS type"
+	errUnknownKMSType     string = "unknown KMS type"
+	errTopicKeyNotFound   string = "topic key not found"
+	errTopicKeyIDMismatch string = "topic key does not match the requested key ID"
 )
 
 var (
-	ErrUnknownKMSType = errors.New(errUnknownKMSType)
+	ErrUnknownKMSType     = errors.New(errUnknownKMSType)
+	ErrTopicKeyNotFound   = errors.New(errTopicKeyNotFound)
+	ErrTopicKeyIDMismatch = errors.New(errTopicKeyIDMismatch)
 )
 
 func NewErrUnknownKMSType(t ServiceType) error {
 	return errors.New(errUnknownKMSType, errors.NewKV("Type", t))
 }
+
+func NewErrTopicKeyNotFound(keyID []byte) error {
+	_, keyCid, err := cid.CidFromBytes(keyID)
+	if err != nil {
+		return errors.New(errTopicKeyNotFound)
+	}
+	return errors.New(errTopicKeyNotFound, errors.NewKV("KeyID", keyCid))
+}
+
+func NewErrTopicKeyIDMismatch(keyID []byte) error {
+	_, keyCid, err := cid.CidFromBytes(keyID)
+	if err != nil {
+		return errors.New(errTopicKeyIDMismatch)
+	}
+	return errors.New(errTopicKeyIDMismatch, errors.NewKV("KeyID", keyCid))
+}
@@ -15,10 +15,12 @@ import (
 	"context"
 	"crypto/ecdh"
 	"encoding/base64"
+	"sync"
 
 	"github.com/fxamacker/cbor/v2"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/corekv"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
 	"github.com/sourcenetwork/immutable"
 	grpcpeer "google.golang.org/grpc/peer"
@@ -40,7 +42,7 @@ const pubsubTopic = "encryption"
 
 type PubSubServer interface {
 	AddPubSubTopic(string, rpc.MessageHandler) error
-	SendPubSubMessage(context.Context, string, []byte) (<-chan rpc.Response, error)
+	SendPubSubMessage(context.Context, string, []byte, ...rpc.PublishOption) (<-chan rpc.Response, error)
 }
 
 type CollectionRetriever interface {
@@ -54,9 +56,19 @@ type pubSubService struct {
 	keyRequestedSub event.Subscription
 	eventBus        event.Bus
 	encStore        *ipldEncStorage
+	systemstore     corekv.ReaderWriter
 	documentACP     immutable.Option[dac.DocumentACP]
 	colRetriever    CollectionRetriever
 	nodeDID         string
+
+	// topicKeys maps collection IDs to the ID of the group key currently used
+	// to encrypt their topic payloads.
+	topicKeys   map[string][]byte
+	topicKeysMu sync.Mutex
+	// topicKeyPeers is the set of peers allowed to receive topic keys.
+	topicKeyPeers map[libpeer.ID]struct{}
+	// isPrivateNetwork is true if the node joined a private network.
+	isPrivateNetwork bool
 }
 
 var _ Service = (*pubSubService)(nil)
@@ -75,32 +87,47 @@ func (s *pubSubService) GetKeys(ctx context.Context, cids ...cidlink.Link) (*enc
 // NewPubSubService creates a new instance of the KMS service that is connected to the given PubSubServer,
 // event bus and encryption storage.
 //
-// The service will subscribe to the "encryption" topic on the PubSubServer and to the
-// "enc-keys-request" event on the event bus.
+// The service will subscribe to the "encryption" and "encryption-topic-keys" topics on the
+// PubSubServer and to the "enc-keys-request" event on the event bus.
+//
+// Topic keys will only be shared with the peers allowed by the given topic key access.
 func NewPubSubService(
 	ctx context.Context,
 	peerID libpeer.ID,
 	pubsub PubSubServer,
 	eventBus event.Bus,
 	encstore datastore.Blockstore,
+	systemstore corekv.ReaderWriter,
 	documentACP immutable.Option[dac.DocumentACP],
 	colRetriever CollectionRetriever,
 	nodeDID string,
+	topicKeyAccess TopicKeyAccess,
 ) (*pubSubService, error) {
 	s := &pubSubService{
-		ctx:          ctx,
-		peerID:       peerID,
-		pubsub:       pubsub,
-		eventBus:     eventBus,
-		encStore:     newIPLDEncryptionStorage(encstore),
-		documentACP:  documentACP,
-		colRetriever: colRetriever,
-		nodeDID:      nodeDID,
+		ctx:              ctx,
+		peerID:           peerID,
+		pubsub:           pubsub,
+		eventBus:         eventBus,
+		encStore:         newIPLDEncryptionStorage(encstore),
+		systemstore:      systemstore,
+		documentACP:      documentACP,
+		colRetriever:     colRetriever,
+		nodeDID:          nodeDID,
+		topicKeys:        make(map[string][]byte),
+		topicKeyPeers:    make(map[libpeer.ID]struct{}),
+		isPrivateNetwork: topicKeyAccess.PrivateNetwork,
+	}
+	for _, p := range topicKeyAccess.Peers {
+		s.topicKeyPeers[p] = struct{}{}
 	}
 	err := pubsub.AddPubSubTopic(pubsubTopic, s.handleRequestFromPeer)
 	if err != nil {
 		return nil, err
 	}
+	err = pubsub.AddPubSubTopic(topicKeysPubsubTopic, s.handleTopicKeyRequestFromPeer)
+	if err != nil {
+		return nil, err
+	}
 	s.keyRequestedSub, err = eventBus.Subscribe(encryption.RequestKeysEventName)
 	if err != nil {
 		return nil, err
@@ -381,6 +408,7 @@ func (s *pubSubService) doesIdentityHaveDocPermission(
 		s.documentACP.Value(),
 		collection,
 		acpTypes.DocumentReadPerm,
+		permission.OperationSync,
 		docID,
 	)
 }
@@ -0,0 +1,298 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/corekv"
+	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
+
+	"github.com/sourcenetwork/defradb/crypto"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+// topicKeysPubsubTopic is the pubsub topic used to exchange the group keys
+// that encrypt the payloads of document and collection topics.
+const topicKeysPubsubTopic = "encryption-topic-keys"
+
+// topicKeyRequestTimeout is the maximum amount of time to wait for a peer to
+// respond to a topic key request.
+const topicKeyRequestTimeout = 10 * time.Second
+
+// topicKey is the group key of a collection topic.
+type topicKey struct {
+	// CollectionID is the ID of the collection the key is used for.
+	CollectionID string
+	// Key is the AES-256 group key.
+	Key []byte
+}
+
+// TopicKeyAccess defines the peers allowed to receive the topic keys of a node.
+//
+// Topic keys are not shared with any peer by default.
+type TopicKeyAccess struct {
+	// Peers are the peers explicitly allowed to receive topic keys.
+	Peers []libpeer.ID
+	// PrivateNetwork is true if the node joined a private network.
+	//
+	// If set and no peers are explicitly allowed, any peer of the private network can receive
+	// topic keys, as it had to prove that it knows the network key to connect to the node.
+	PrivateNetwork bool
+}
+
+type fetchTopicKeyRequest struct {
+	KeyID              []byte
+	EphemeralPublicKey []byte
+}
+
+type fetchTopicKeyReply struct {
+	Block              []byte
+	EphemeralPublicKey []byte
+}
+
+// GetTopicKey returns the ID and value of the group key used to encrypt the payloads
+// published for the given collection.
+//
+// A new key is generated the first time a collection key is requested. The ID of the key is
+// persisted so that the same key is used after the node restarts.
+func (s *pubSubService) GetTopicKey(ctx context.Context, collectionID string) ([]byte, []byte, error) {
+	s.topicKeysMu.Lock()
+	defer s.topicKeysMu.Unlock()
+
+	keyID, err := s.getCurrentTopicKeyID(ctx, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if keyID != nil {
+		key, err := s.encStore.getTopicKey(ctx, keyID)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.topicKeys[collectionID] = keyID
+		return keyID, key.Key, nil
+	}
+
+	keyValue, err := crypto.GenerateAES256()
+	if err != nil {
+		return nil, nil, err
+	}
+	key := &topicKey{
+		CollectionID: collectionID,
+		Key:          keyValue,
+	}
+	keyID, err = s.encStore.putTopicKey(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = s.systemstore.Set(ctx, keys.NewEncryptionTopicKey(collectionID).Bytes(), keyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.topicKeys[collectionID] = keyID
+
+	return keyID, keyValue, nil
+}
+
+// getCurrentTopicKeyID returns the ID of the group key currently used for the given collection,
+// or nil if no key has been generated for it yet.
+func (s *pubSubService) getCurrentTopicKeyID(ctx context.Context, collectionID string) ([]byte, error) {
+	if keyID, ok := s.topicKeys[collectionID]; ok {
+		return keyID, nil
+	}
+	keyID, err := s.systemstore.Get(ctx, keys.NewEncryptionTopicKey(collectionID).Bytes())
+	if errors.Is(err, corekv.ErrNotFound) {
+		return nil, nil
+	}
+	return keyID, err
+}
+
+// GetTopicKeyByID returns the collection ID and value of the group key with the given ID.
+//
+// If the key is not found locally it is requested from the other peers on the network.
+func (s *pubSubService) GetTopicKeyByID(ctx context.Context, keyID []byte) (string, []byte, error) {
+	key, err := s.encStore.getTopicKey(ctx, keyID)
+	if err == nil {
+		return key.CollectionID, key.Key, nil
+	}
+	if !errors.Is(err, ErrTopicKeyNotFound) {
+		return "", nil, err
+	}
+
+	key, err = s.requestTopicKeyFromPeers(ctx, keyID)
+	if err != nil {
+		return "", nil, err
+	}
+	_, err = s.encStore.putTopicKey(ctx, key)
+	if err != nil {
+		return "", nil, err
+	}
+	return key.CollectionID, key.Key, nil
+}
+
+// requestTopicKeyFromPeers publishes a topic key request on the pubsub network and
+// waits for the first peer to respond with the key.
+func (s *pubSubService) requestTopicKeyFromPeers(ctx context.Context, keyID []byte) (*topicKey, error) {
+	ephPrivKey, err := crypto.GenerateX25519()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &fetchTopicKeyRequest{
+		KeyID:              keyID,
+		EphemeralPublicKey: ephPrivKey.PublicKey().Bytes(),
+	}
+	data, err := cbor.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap("failed to marshal pubsub message", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, topicKeyRequestTimeout)
+	defer cancel()
+
+	respChan, err := s.pubsub.SendPubSubMessage(ctx, topicKeysPubsubTopic, data, rpc.WithMultiResponse(true))
+	if err != nil {
+		return nil, errors.Wrap("failed publishing to topic keys thread", err)
+	}
+
+	// Peers that don't have the key reply with an empty response so we wait until
+	// one of them replies with the key or the request times out.
+	for resp := range respChan {
+		if resp.Err != nil {
+			continue
+		}
+		key, err := s.handleFetchTopicKeyResponse(resp, req, ephPrivKey)
+		if err != nil {
+			log.ErrorContextE(ctx, "Failed to handle topic key response", err)
+			continue
+		}
+		if key != nil {
+			return key, nil
+		}
+	}
+	return nil, NewErrTopicKeyNotFound(keyID)
+}
+
+func (s *pubSubService) handleFetchTopicKeyResponse(
+	resp rpc.Response,
+	req *fetchTopicKeyRequest,
+	privateKey *ecdh.PrivateKey,
+) (*topicKey, error) {
+	var keyResp fetchTopicKeyReply
+	if err := cbor.Unmarshal(resp.Data, &keyResp); err != nil {
+		return nil, err
+	}
+	if len(keyResp.Block) == 0 {
+		return nil, nil
+	}
+
+	decryptedData, err := crypto.DecryptECIES(
+		keyResp.Block,
+		privateKey,
+		crypto.WithAAD(makeTopicKeyAssociatedData(req, resp.From)),
+		crypto.WithPubKeyBytes(keyResp.EphemeralPublicKey),
+		crypto.WithPubKeyPrepended(false),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &topicKey{}
+	if err := cbor.Unmarshal(decryptedData, key); err != nil {
+		return nil, err
+	}
+	// The key ID is the CID of the key, so a peer can not reply with a key other than the
+	// one that was requested.
+	if err := verifyTopicKeyID(key, req.KeyID); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// handleTopicKeyRequestFromPeer handles incoming topic key requests from the pubsub network.
+//
+// The key is only shared with the requesting peer if it is allowed to receive topic keys.
+func (s *pubSubService) handleTopicKeyRequestFromPeer(peerID libpeer.ID, topic string, msg []byte) ([]byte, error) {
+	req := new(fetchTopicKeyRequest)
+	if err := cbor.Unmarshal(msg, req); err != nil {
+		log.ErrorContextE(s.ctx, "Failed to unmarshal pubsub message", err)
+		return nil, err
+	}
+
+	if !s.canReceiveTopicKeys(peerID) {
+		return cbor.Marshal(&fetchTopicKeyReply{})
+	}
+
+	key, err := s.encStore.getTopicKey(s.ctx, req.KeyID)
+	if errors.Is(err, ErrTopicKeyNotFound) {
+		return cbor.Marshal(&fetchTopicKeyReply{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes, err := cbor.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	reqEphPubKey, err := crypto.X25519PublicKeyFromBytes(req.EphemeralPublicKey)
+	if err != nil {
+		return nil, errors.Wrap("failed to unmarshal ephemeral public key", err)
+	}
+
+	privKey, err := crypto.GenerateX25519()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedBlock, err := crypto.EncryptECIES(
+		keyBytes,
+		reqEphPubKey,
+		crypto.WithAAD(makeTopicKeyAssociatedData(req, s.peerID)),
+		crypto.WithPrivKey(privKey),
+		crypto.WithPubKeyPrepended(false),
+	)
+	if err != nil {
+		return nil, errors.Wrap("failed to encrypt topic key for requester", err)
+	}
+
+	return cbor.Marshal(&fetchTopicKeyReply{
+		Block:              encryptedBlock,
+		EphemeralPublicKey: privKey.PublicKey().Bytes(),
+	})
+}
+
+// canReceiveTopicKeys returns true if the given peer is allowed to receive topic keys.
+//
+// If no peers have been explicitly allowed, only the peers of the private network the node
+// joined can receive them.
+func (s *pubSubService) canReceiveTopicKeys(peerID libpeer.ID) bool {
+	if len(s.topicKeyPeers) == 0 {
+		return s.isPrivateNetwork
+	}
+	_, ok := s.topicKeyPeers[peerID]
+	return ok
+}
+
+// makeTopicKeyAssociatedData creates the associated data for the topic key request
+func makeTopicKeyAssociatedData(req *fetchTopicKeyRequest, peerID libpeer.ID) []byte {
+	return encodeToBase64(bytes.Join([][]byte{
+		req.EphemeralPublicKey,
+		[]byte(peerID),
+	}, []byte{}))
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package lens
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errUnknownLensTransform       string = "unknown lens transform"
+	errMissingLensTransformArg    string = "missing lens transform argument"
+	errInvalidLensTransformArg    string = "invalid lens transform argument"
+	errInvalidLensTransformSource string = "lens transform can not be applied to field value"
+)
+
+var (
+	ErrUnknownLensTransform       = errors.New(errUnknownLensTransform)
+	ErrMissingLensTransformArg    = errors.New(errMissingLensTransformArg)
+	ErrInvalidLensTransformArg    = errors.New(errInvalidLensTransformArg)
+	ErrInvalidLensTransformSource = errors.New(errInvalidLensTransformSource)
+)
+
+// NewErrUnknownLensTransform returns an error indicating that the given path refers to a built in
+// transform that does not exist.
+func NewErrUnknownLensTransform(path string) error {
+	return errors.New(errUnknownLensTransform, errors.NewKV("Path", path))
+}
+
+// NewErrMissingLensTransformArg returns an error indicating that a required argument was not
+// provided to a built in transform.
+func NewErrMissingLensTransformArg(path string, name string) error {
+	return errors.New(
+		errMissingLensTransformArg,
+		errors.NewKV("Path", path),
+		errors.NewKV("Argument", name),
+	)
+}
+
+// NewErrInvalidLensTransformArg returns an error indicating that an argument provided to a built in
+// transform has an invalid value.
+func NewErrInvalidLensTransformArg(path string, name string, value any) error {
+	return errors.New(
+		errInvalidLensTransformArg,
+		errors.NewKV("Path", path),
+		errors.NewKV("Argument", name),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrInvalidLensTransformSource returns an error indicating that a built in transform could not
+// be applied to the value of the given field.
+func NewErrInvalidLensTransformSource(path string, field string, value any) error {
+	return errors.New(
+		errInvalidLensTransformSource,
+		errors.NewKV("Path", path),
+		errors.NewKV("Field", field),
+		errors.NewKV("Value", value),
+	)
+}
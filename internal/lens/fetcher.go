@@ -17,14 +17,17 @@ import (
 
 	"github.com/fxamacker/cbor/v2"
 
+	"github.com/sourcenetwork/corekv"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/acp/dac"
 	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db/description"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
 	"github.com/sourcenetwork/defradb/internal/db/id"
 	"github.com/sourcenetwork/defradb/internal/keys"
@@ -50,6 +53,12 @@ type lensedFetcher struct {
 
 	// If true there are migrations registered for the collection being fetched.
 	hasMigrations bool
+
+	// The names of fields that exist in other versions of the collection, but that have been
+	// removed from (or are yet to be added to) the target version.
+	//
+	// These are fetched so that they may be read by migrations, but must not be yielded.
+	removedFieldNames map[string]struct{}
 }
 
 var _ fetcher.Fetcher = (*lensedFetcher)(nil)
@@ -95,6 +104,7 @@ func (f *lensedFetcher) Init(
 	}
 	f.lens = new(ctx, f.registry, f.col.Schema().VersionID, history)
 	f.txn = txn
+	f.removedFieldNames = map[string]struct{}{}
 
 historyLoop:
 	for _, historyItem := range history {
@@ -111,10 +121,18 @@ historyLoop:
 
 	var innerFetcherFields []client.FieldDefinition
 	if f.hasMigrations {
+		removedFields, err := f.getRemovedFields(ctx, history)
+		if err != nil {
+			return err
+		}
+
 		// If there are migrations present, they may require fields that are not otherwise
 		// requested.  At the moment this means we need to pass in nil so that the underlying
-		// fetcher fetches everything.
-		innerFetcherFields = nil
+		// fetcher fetches everything, unless fields have been removed from the target version
+		// in which case they must be requested in addition to the target version's fields.
+		if len(removedFields) > 0 {
+			innerFetcherFields = append(defFields, removedFields...)
+		}
 	} else {
 		innerFetcherFields = fields
 	}
@@ -150,6 +168,12 @@ func (f *lensedFetcher) FetchNext(ctx context.Context) (fetcher.EncodedDocument,
 	if !f.hasMigrations || doc.SchemaVersionID() == f.targetVersionID {
 		// If there are no migrations registered for this schema, or if the document is already
 		// at the target schema version, no migration is required and we can return it early.
+		if len(f.removedFieldNames) > 0 {
+			return &prunedEncodedDocument{
+				EncodedDocument:   doc,
+				removedFieldNames: f.removedFieldNames,
+			}, execInfo, nil
+		}
 		return doc, execInfo, nil
 	}
 
@@ -199,6 +223,59 @@ func (f *lensedFetcher) Close() error {
 	return f.source.Close()
 }
 
+// getRemovedFields returns the fields that are present on other versions of the collection within
+// the given history, but that are not present on the target version.
+//
+// Values of these fields may still be held in the datastore, and may be required by migrations.
+func (f *lensedFetcher) getRemovedFields(
+	ctx context.Context,
+	history map[schemaVersionID]*targetedCollectionHistoryLink,
+) ([]client.FieldDefinition, error) {
+	removedFields := []client.FieldDefinition{}
+	for versionID, historyItem := range history {
+		if versionID == f.targetVersionID {
+			continue
+		}
+
+		var schema immutable.Option[client.SchemaDescription]
+		for _, colField := range historyItem.collection.Fields {
+			if colField.Kind.HasValue() {
+				// Local-only fields are never stored in the datastore.
+				continue
+			}
+			if _, ok := f.fieldDescriptionsByName[colField.Name]; ok {
+				continue
+			}
+			if _, ok := f.removedFieldNames[colField.Name]; ok {
+				continue
+			}
+
+			if !schema.HasValue() {
+				s, err := description.GetSchemaVersion(ctx, historyItem.collection.VersionID)
+				if err != nil {
+					if errors.Is(err, corekv.ErrNotFound) {
+						// The schema version may only be known via registered migrations,
+						// in which case the kinds of its fields are unknown.
+						break
+					}
+					return nil, err
+				}
+				schema = immutable.Some(s)
+			}
+
+			schemaField, ok := schema.Value().GetFieldByName(colField.Name)
+			if !ok {
+				continue
+			}
+
+			f.removedFieldNames[colField.Name] = struct{}{}
+			removedFields = append(removedFields, client.NewFieldDefinition(colField, schemaField))
+		}
+	}
+
+	return removedFields, nil
+}
+
 // encodedDocToLensDoc converts a [fetcher.EncodedDocument] to a LensDoc.
 func encodedDocToLensDoc(doc fetcher.EncodedDocument) (LensDoc, error) {
 	docAsMap := map[string]any{}
@@ -380,3 +457,27 @@ func (encdoc *lensEncodedDocument) Reset() {
 	encdoc.status = 0
 	encdoc.properties = map[client.FieldDefinition]any{}
 }
+
+// prunedEncodedDocument wraps an [fetcher.EncodedDocument] at the target version, hiding any
+// values of fields that have been removed from that version.
+type prunedEncodedDocument struct {
+	fetcher.EncodedDocument
+	removedFieldNames map[string]struct{}
+}
+
+var _ fetcher.EncodedDocument = (*prunedEncodedDocument)(nil)
+
+func (encdoc *prunedEncodedDocument) Properties(onlyFilterProps bool) (map[client.FieldDefinition]any, error) {
+	properties, err := encdoc.EncodedDocument.Properties(onlyFilterProps)
+	if err != nil {
+		return nil, err
+	}
+
+	for field := range properties {
+		if _, ok := encdoc.removedFieldNames[field.Name]; ok {
+			delete(properties, field)
+		}
+	}
+
+	return properties, nil
+}
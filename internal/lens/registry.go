@@ -12,6 +12,8 @@ package lens
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/sourcenetwork/immutable/enumerable"
 	"github.com/sourcenetwork/lens/host-go/config/model"
@@ -28,6 +30,37 @@ const DefaultPoolSize int = 5
 
 type LensRegistry struct {
 	repository repository.Repository
+
+	// The stages of the migrations that contain built in declarative transforms, by collection ID.
+	//
+	// Migrations formed purely of lens wasm modules are held by the repository under the collection ID
+	// and will not have an entry here.
+	stagesByID map[string][]migrationStage
+	stagesLock sync.RWMutex
+
+	// The stages set within writable transactions that have not been committed yet, by transaction ID
+	// then collection ID.
+	//
+	// They are only visible from within their transaction, and are applied to stagesByID once the
+	// transaction is committed. A nil entry marks a collection whose stages have been removed within
+	// the transaction.
+	txnStages map[uint64]map[string][]migrationStage
+	txnLock   sync.RWMutex
+}
+
+// migrationStage is a consecutive set of lens modules within a migration that are either all built in
+// declarative transforms, or all lens wasm modules.
+type migrationStage struct {
+	// The built in transforms of this stage, and their inverses (in reverse order).
+	//
+	// These will be empty if this is a wasm stage.
+	transforms        []nativeTransform
+	inverseTransforms []nativeTransform
+
+	// The ID under which the lens wasm modules of this stage are held by the repository.
+	//
+	// This will be empty if this is a native stage.
+	repositoryID string
 }
 
 var _ client.LensRegistry = (*LensRegistry)(nil)
@@ -38,6 +71,8 @@ func NewRegistry(
 ) *LensRegistry {
 	return &LensRegistry{
 		repository: repository.NewRepository(poolSize, runtime),
+		stagesByID: map[string][]migrationStage{},
+		txnStages:  map[uint64]map[string][]migrationStage{},
 	}
 }
 
@@ -46,7 +81,55 @@ func (r *LensRegistry) Init(txnSource client.TxnSource) {
 }
 
 func (r *LensRegistry) SetMigration(ctx context.Context, collectionID string, cfg model.Lens) error {
-	return r.repository.Add(ctx, collectionID, cfg)
+	hasNativeTransforms := false
+	for _, moduleCfg := range cfg.Lenses {
+		if isNativeTransform(moduleCfg) {
+			hasNativeTransforms = true
+			break
+		}
+	}
+
+	if !hasNativeTransforms {
+		err := r.repository.Add(ctx, collectionID, cfg)
+		if err != nil {
+			return err
+		}
+
+		r.setStages(ctx, collectionID, nil)
+		return nil
+	}
+
+	stages := []migrationStage{}
+	for i := 0; i < len(cfg.Lenses); {
+		if isNativeTransform(cfg.Lenses[i]) {
+			stage := migrationStage{}
+			for ; i < len(cfg.Lenses) && isNativeTransform(cfg.Lenses[i]); i++ {
+				transform, inverse, err := newNativeTransforms(cfg.Lenses[i])
+				if err != nil {
+					return err
+				}
+				stage.transforms = append(stage.transforms, transform)
+				stage.inverseTransforms = append([]nativeTransform{inverse}, stage.inverseTransforms...)
+			}
+			stages = append(stages, stage)
+			continue
+		}
+
+		wasmCfg := model.Lens{}
+		for ; i < len(cfg.Lenses) && !isNativeTransform(cfg.Lenses[i]); i++ {
+			wasmCfg.Lenses = append(wasmCfg.Lenses, cfg.Lenses[i])
+		}
+
+		repositoryID := fmt.Sprintf("%s/%v", collectionID, len(stages))
+		err := r.repository.Add(ctx, repositoryID, wasmCfg)
+		if err != nil {
+			return err
+		}
+		stages = append(stages, migrationStage{repositoryID: repositoryID})
+	}
+
+	r.setStages(ctx, collectionID, stages)
+	return nil
 }
 
 func (r *LensRegistry) ReloadLenses(ctx context.Context) error {
@@ -84,7 +167,25 @@ func (r *LensRegistry) MigrateUp(
 	src enumerable.Enumerable[LensDoc],
 	collectionID string,
 ) (enumerable.Enumerable[map[string]any], error) {
-	return r.repository.Transform(ctx, src, collectionID)
+	stages, ok := r.getStages(ctx, collectionID)
+	if !ok {
+		return r.repository.Transform(ctx, src, collectionID)
+	}
+
+	var err error
+	for _, stage := range stages {
+		if stage.repositoryID == "" {
+			src = newNativeTransformEnumerable(src, stage.transforms)
+			continue
+		}
+
+		src, err = r.repository.Transform(ctx, src, stage.repositoryID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return src, nil
 }
 
 func (r *LensRegistry) MigrateDown(
@@ -92,7 +193,100 @@ func (r *LensRegistry) MigrateDown(
 	src enumerable.Enumerable[LensDoc],
 	collectionID string,
 ) (enumerable.Enumerable[map[string]any], error) {
-	return r.repository.Inverse(ctx, src, collectionID)
+	stages, ok := r.getStages(ctx, collectionID)
+	if !ok {
+		return r.repository.Inverse(ctx, src, collectionID)
+	}
+
+	var err error
+	for i := len(stages) - 1; i >= 0; i-- {
+		stage := stages[i]
+		if stage.repositoryID == "" {
+			src = newNativeTransformEnumerable(src, stage.inverseTransforms)
+			continue
+		}
+
+		src, err = r.repository.Inverse(ctx, src, stage.repositoryID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return src, nil
+}
+
+// setStages sets the stages of the given collection, removing them if nil.
+//
+// If the context holds a transaction, the stages are only set once it is committed.
+func (r *LensRegistry) setStages(ctx context.Context, collectionID string, stages []migrationStage) {
+	txn, ok := datastore.CtxTryGetTxn(ctx)
+	if !ok {
+		r.stagesLock.Lock()
+		applyStages(r.stagesByID, collectionID, stages)
+		r.stagesLock.Unlock()
+		return
+	}
+
+	r.txnLock.Lock()
+	defer r.txnLock.Unlock()
+
+	staged, ok := r.txnStages[txn.ID()]
+	if !ok {
+		staged = map[string][]migrationStage{}
+		r.txnStages[txn.ID()] = staged
+
+		txn.OnSuccess(func() {
+			r.txnLock.Lock()
+			delete(r.txnStages, txn.ID())
+			r.txnLock.Unlock()
+
+			r.stagesLock.Lock()
+			for collectionID, stages := range staged {
+				applyStages(r.stagesByID, collectionID, stages)
+			}
+			r.stagesLock.Unlock()
+		})
+		txn.OnError(func() {
+			r.discardTxnStages(txn.ID())
+		})
+		txn.OnDiscard(func() {
+			r.discardTxnStages(txn.ID())
+		})
+	}
+	staged[collectionID] = stages
+}
+
+func (r *LensRegistry) discardTxnStages(txnID uint64) {
+	r.txnLock.Lock()
+	delete(r.txnStages, txnID)
+	r.txnLock.Unlock()
+}
+
+// getStages returns the stages of the given collection, including the ones set within the
+// transaction of the context.
+func (r *LensRegistry) getStages(ctx context.Context, collectionID string) ([]migrationStage, bool) {
+	if txn, ok := datastore.CtxTryGetTxn(ctx); ok {
+		r.txnLock.RLock()
+		stages, ok := r.txnStages[txn.ID()][collectionID]
+		r.txnLock.RUnlock()
+		if ok {
+			return stages, stages != nil
+		}
+	}
+
+	r.stagesLock.RLock()
+	defer r.stagesLock.RUnlock()
+
+	stages, ok := r.stagesByID[collectionID]
+	return stages, ok
+}
+
+func applyStages(stagesByID map[string][]migrationStage, collectionID string, stages []migrationStage) {
+	if stages == nil {
+		delete(stagesByID, collectionID)
+	} else {
+		stagesByID[collectionID] = stages
+	}
 }
 
 type txnSource struct {
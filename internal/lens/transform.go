@@ -0,0 +1,412 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package lens
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/sourcenetwork/immutable/enumerable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// nativeTransform is a built in declarative transform, executed natively instead of within a
+// lens wasm module.
+//
+// It mutates the given document in place.
+type nativeTransform func(doc LensDoc) error
+
+// isNativeTransform returns true if the given module refers to a built in declarative transform.
+func isNativeTransform(moduleCfg model.LensModule) bool {
+	return strings.HasPrefix(moduleCfg.Path, client.LensTransformPathPrefix)
+}
+
+// newNativeTransforms returns the forward and inverse transforms for the given module
+// configuration.
+//
+// If the module is flagged as inversed, the returned transforms will be swapped.
+func newNativeTransforms(moduleCfg model.LensModule) (nativeTransform, nativeTransform, error) {
+	args := transformArgs{
+		path:      moduleCfg.Path,
+		arguments: moduleCfg.Arguments,
+	}
+
+	var forward, inverse nativeTransform
+	var err error
+	switch moduleCfg.Path {
+	case client.LensTransformRename:
+		forward, inverse, err = newRenameTransforms(args)
+	case client.LensTransformSetDefault:
+		forward, inverse, err = newSetDefaultTransforms(args)
+	case client.LensTransformCopy:
+		forward, inverse, err = newCopyTransforms(args)
+	case client.LensTransformSplit:
+		forward, inverse, err = newSplitTransforms(args)
+	case client.LensTransformConcat:
+		forward, inverse, err = newConcatTransforms(args)
+	case client.LensTransformConvert:
+		forward, inverse, err = newConvertTransforms(args)
+	default:
+		return nil, nil, NewErrUnknownLensTransform(moduleCfg.Path)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if moduleCfg.Inverse {
+		return inverse, forward, nil
+	}
+	return forward, inverse, nil
+}
+
+func newRenameTransforms(args transformArgs) (nativeTransform, nativeTransform, error) {
+	src, err := args.getString("src")
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := args.getString("dst")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return moveField(src, dst), moveField(dst, src), nil
+}
+
+func moveField(src string, dst string) nativeTransform {
+	return func(doc LensDoc) error {
+		if value, ok := doc[src]; ok {
+			doc[dst] = value
+			delete(doc, src)
+		}
+		return nil
+	}
+}
+
+func newSetDefaultTransforms(args transformArgs) (nativeTransform, nativeTransform, error) {
+	dst, err := args.getString("dst")
+	if err != nil {
+		return nil, nil, err
+	}
+	value, ok := args.arguments["value"]
+	if !ok {
+		return nil, nil, NewErrMissingLensTransformArg(args.path, "value")
+	}
+
+	forward := func(doc LensDoc) error {
+		if existing, ok := doc[dst]; !ok || existing == nil {
+			doc[dst] = value
+		}
+		return nil
+	}
+	return forward, removeField(dst), nil
+}
+
+func newCopyTransforms(args transformArgs) (nativeTransform, nativeTransform, error) {
+	src, err := args.getString("src")
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := args.getString("dst")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forward := func(doc LensDoc) error {
+		if value, ok := doc[src]; ok {
+			doc[dst] = value
+		}
+		return nil
+	}
+	return forward, removeField(dst), nil
+}
+
+func removeField(name string) nativeTransform {
+	return func(doc LensDoc) error {
+		delete(doc, name)
+		return nil
+	}
+}
+
+func newSplitTransforms(args transformArgs) (nativeTransform, nativeTransform, error) {
+	src, err := args.getString("src")
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := args.getStringArray("dst")
+	if err != nil {
+		return nil, nil, err
+	}
+	separator, err := args.getString("separator")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	split, concat := splitTransforms(args.path, src, dst, separator)
+	return split, concat, nil
+}
+
+func newConcatTransforms(args transformArgs) (nativeTransform, nativeTransform, error) {
+	src, err := args.getStringArray("src")
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := args.getString("dst")
+	if err != nil {
+		return nil, nil, err
+	}
+	separator, err := args.getString("separator")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	split, concat := splitTransforms(args.path, dst, src, separator)
+	return concat, split, nil
+}
+
+// splitTransforms returns a transform that splits the whole field into the part fields, and
+// a transform that concatenates the part fields into the whole field.
+func splitTransforms(
+	path string,
+	whole string,
+	parts []string,
+	separator string,
+) (nativeTransform, nativeTransform) {
+	split := func(doc LensDoc) error {
+		value, ok := doc[whole]
+		if !ok || value == nil {
+			return nil
+		}
+		str, ok := value.(string)
+		if !ok {
+			return NewErrInvalidLensTransformSource(path, whole, value)
+		}
+
+		values := strings.SplitN(str, separator, len(parts))
+		for i, field := range parts {
+			if i < len(values) {
+				doc[field] = values[i]
+			} else {
+				doc[field] = nil
+			}
+		}
+		return nil
+	}
+
+	concat := func(doc LensDoc) error {
+		values := make([]string, 0, len(parts))
+		hasValue := false
+		for _, field := range parts {
+			value := doc[field]
+			if value == nil {
+				values = append(values, "")
+				continue
+			}
+			str, ok := value.(string)
+			if !ok {
+				return NewErrInvalidLensTransformSource(path, field, value)
+			}
+			values = append(values, str)
+			hasValue = true
+		}
+
+		if hasValue {
+			doc[whole] = strings.Join(values, separator)
+		}
+		return nil
+	}
+
+	return split, concat
+}
+
+func newConvertTransforms(args transformArgs) (nativeTransform, nativeTransform, error) {
+	src, err := args.getString("src")
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := args.getOptionalString("dst", src)
+	if err != nil {
+		return nil, nil, err
+	}
+	multiplier, err := args.getOptionalNumber("multiplier", 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if multiplier == 0 {
+		// The conversion would not be reversible.
+		return nil, nil, NewErrInvalidLensTransformArg(args.path, "multiplier", multiplier)
+	}
+	offset, err := args.getOptionalNumber("offset", 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forward := convertField(args.path, src, dst, func(v float64) float64 { return v*multiplier + offset })
+	inverse := convertField(args.path, dst, src, func(v float64) float64 { return (v - offset) / multiplier })
+	return forward, inverse, nil
+}
+
+func convertField(path string, src string, dst string, convert func(float64) float64) nativeTransform {
+	return func(doc LensDoc) error {
+		value, ok := doc[src]
+		if !ok || value == nil {
+			return nil
+		}
+		number, ok := toFloat64(value)
+		if !ok {
+			return NewErrInvalidLensTransformSource(path, src, value)
+		}
+		doc[dst] = convert(number)
+		return nil
+	}
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// transformArgs provides typed access to the arguments of a built in transform.
+type transformArgs struct {
+	path      string
+	arguments map[string]any
+}
+
+func (a transformArgs) getString(name string) (string, error) {
+	value, ok := a.arguments[name]
+	if !ok {
+		return "", NewErrMissingLensTransformArg(a.path, name)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", NewErrInvalidLensTransformArg(a.path, name, value)
+	}
+	return str, nil
+}
+
+func (a transformArgs) getOptionalString(name string, defaultValue string) (string, error) {
+	if _, ok := a.arguments[name]; !ok {
+		return defaultValue, nil
+	}
+	return a.getString(name)
+}
+
+func (a transformArgs) getStringArray(name string) ([]string, error) {
+	value, ok := a.arguments[name]
+	if !ok {
+		return nil, NewErrMissingLensTransformArg(a.path, name)
+	}
+
+	var result []string
+	switch v := value.(type) {
+	case []string:
+		result = v
+	case []any:
+		result = make([]string, len(v))
+		for i, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, NewErrInvalidLensTransformArg(a.path, name, value)
+			}
+			result[i] = str
+		}
+	default:
+		return nil, NewErrInvalidLensTransformArg(a.path, name, value)
+	}
+
+	if len(result) == 0 {
+		return nil, NewErrInvalidLensTransformArg(a.path, name, value)
+	}
+	return result, nil
+}
+
+func (a transformArgs) getOptionalNumber(name string, defaultValue float64) (float64, error) {
+	value, ok := a.arguments[name]
+	if !ok {
+		return defaultValue, nil
+	}
+	number, ok := toFloat64(value)
+	if !ok {
+		return 0, NewErrInvalidLensTransformArg(a.path, name, value)
+	}
+	return number, nil
+}
+
+// nativeTransformEnumerable feeds the documents yielded by its source through a set of
+// built in transforms.
+type nativeTransformEnumerable struct {
+	source     enumerable.Enumerable[LensDoc]
+	transforms []nativeTransform
+	value      LensDoc
+}
+
+var _ enumerable.Enumerable[LensDoc] = (*nativeTransformEnumerable)(nil)
+
+func newNativeTransformEnumerable(
+	source enumerable.Enumerable[LensDoc],
+	transforms []nativeTransform,
+) *nativeTransformEnumerable {
+	return &nativeTransformEnumerable{
+		source:     source,
+		transforms: transforms,
+	}
+}
+
+func (e *nativeTransformEnumerable) Next() (bool, error) {
+	hasNext, err := e.source.Next()
+	if err != nil || !hasNext {
+		return false, err
+	}
+
+	value, err := e.source.Value()
+	if err != nil {
+		return false, err
+	}
+
+	// The source document must not be mutated, as consumers may compare it to the
+	// transformed document.
+	doc := maps.Clone(value)
+	for _, transform := range e.transforms {
+		err = transform(doc)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	e.value = doc
+	return true, nil
+}
+
+func (e *nativeTransformEnumerable) Value() (LensDoc, error) {
+	return e.value, nil
+}
+
+func (e *nativeTransformEnumerable) Reset() {
+	e.value = nil
+	e.source.Reset()
+}
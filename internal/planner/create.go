@@ -39,6 +39,9 @@ type createNode struct {
 	input []map[string]any
 	docs  []*client.Document
 
+	// The many-to-many relation links requested by the input of each document.
+	links [][]relationLinks
+
 	didCreate bool
 
 	results planNode
@@ -83,8 +86,15 @@ func documentsToDocIDs(docs ...*client.Document) []string {
 
 func (n *createNode) Start() error {
 	n.docs = make([]*client.Document, len(n.input))
+	n.links = make([][]relationLinks, len(n.input))
 
 	for i, input := range n.input {
+		input, links, err := n.p.extractRelationLinks(n.collection, input)
+		if err != nil {
+			return err
+		}
+		n.links[i] = links
+
 		doc, err := client.NewDocFromMap(input, n.collection.Definition())
 		if err != nil {
 			return err
@@ -104,6 +114,13 @@ func (n *createNode) Next() (bool, error) {
 			return false, err
 		}
 
+		for i, doc := range n.docs {
+			err = n.p.applyRelationLinks(doc.ID().String(), n.links[i])
+			if err != nil {
+				return false, err
+			}
+		}
+
 		prefixes, err := n.docIDsToPrefixes(documentsToDocIDs(n.docs...), n.collection.Version())
 		if err != nil {
 			return false, err
@@ -33,6 +33,7 @@ type explainablePlanNode interface {
 // Compile time check for all planNodes that should be explainable (satisfy explainablePlanNode).
 var (
 	_ explainablePlanNode = (*averageNode)(nil)
+	_ explainablePlanNode = (*cachedViewFetcher)(nil)
 	_ explainablePlanNode = (*countNode)(nil)
 	_ explainablePlanNode = (*createNode)(nil)
 	_ explainablePlanNode = (*dagScanNode)(nil)
@@ -62,6 +63,7 @@ const (
 	updateInputLabel    = "update"
 	fieldNameLabel      = "fieldName"
 	filterLabel         = "filter"
+	indexLabel          = "index"
 	joinRootLabel       = "root"
 	joinSubTypeLabel    = "subType"
 	limitLabel          = "limit"
@@ -148,6 +150,26 @@ func buildDebugExplainGraph(source planNode) (map[string]any, error) {
 		nodeLabelTitle := strcase.ToLowerCamel(node.Kind())
 		explainGraph[nodeLabelTitle] = explainGraphBuilder
 
+	case *typeJoinManyToMany:
+		var explainGraphBuilder = map[string]any{}
+
+		indexJoinRootExplainGraph, err := buildDebugExplainGraph(node.parentPlan)
+		if err != nil {
+			return nil, err
+		}
+		// Add the explaination of the rest of the explain graph under the "root" graph.
+		explainGraphBuilder[joinRootLabel] = indexJoinRootExplainGraph
+
+		indexJoinSubTypeExplainGraph, err := buildDebugExplainGraph(node.childPlan)
+		if err != nil {
+			return nil, err
+		}
+		// Add the explaination of the rest of the explain graph under the "subType" graph.
+		explainGraphBuilder[joinSubTypeLabel] = indexJoinSubTypeExplainGraph
+
+		nodeLabelTitle := strcase.ToLowerCamel(node.Kind())
+		explainGraph[nodeLabelTitle] = explainGraphBuilder
+
 	default:
 		var explainGraphBuilder = map[string]any{}
 
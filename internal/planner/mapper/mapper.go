@@ -958,6 +958,10 @@ func getTopLevelInfo(
 			}
 
 			mapping.Add(int(fieldShortID), f.Name)
+
+			if enumKind, ok := f.Kind.(*client.EnumKind); ok {
+				mapping.SetEnumValues(int(fieldShortID), enumKind.Values)
+			}
 		}
 
 		// Setting the type name must be done after adding the fields, as
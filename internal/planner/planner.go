@@ -22,6 +22,7 @@ import (
 	"github.com/sourcenetwork/defradb/internal/connor"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/planner/filter"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
@@ -109,17 +110,22 @@ func New(
 }
 
 func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error) {
+	// The documents fetched by the plan are read on behalf of the mutation.
 	switch stmt.Type {
 	case mapper.CreateObjects:
+		p.ctx = permission.WithOperation(p.ctx, permission.OperationCreate)
 		return p.CreateDocs(stmt)
 
 	case mapper.UpdateObjects:
+		p.ctx = permission.WithOperation(p.ctx, permission.OperationUpdate)
 		return p.UpdateDocs(stmt)
 
 	case mapper.DeleteObjects:
+		p.ctx = permission.WithOperation(p.ctx, permission.OperationDelete)
 		return p.DeleteDocs(stmt)
 
 	case mapper.UpsertObjects:
+		p.ctx = permission.WithOperation(p.ctx, permission.OperationUpdate)
 		return p.UpsertDocs(stmt)
 
 	default:
@@ -273,6 +279,8 @@ func (p *Planner) expandTypeIndexJoinPlan(plan *typeIndexJoin, parentPlan *selec
 		return p.expandTypeJoin(&node.invertibleTypeJoin, parentPlan)
 	case *typeJoinMany:
 		return p.expandTypeJoin(&node.invertibleTypeJoin, parentPlan)
+	case *typeJoinManyToMany:
+		return p.expandPlan(node.childPlan, parentPlan)
 	}
 	return client.NewErrUnhandledType("join plan", plan.joinPlan)
 }
@@ -311,6 +319,8 @@ func isOrderedByIndex(plan planNode) bool {
 			scan = getNode[*scanNode](j.getFirstSide().plan)
 		} else if j, ok := typeJoin.joinPlan.(*typeJoinMany); ok {
 			scan = getNode[*scanNode](j.getFirstSide().plan)
+		} else if j, ok := typeJoin.joinPlan.(*typeJoinManyToMany); ok {
+			scan = getNode[*scanNode](j.parentPlan)
 		}
 	} else {
 		scan = getNode[*scanNode](plan)
@@ -548,6 +558,8 @@ func (p *Planner) walkAndReplacePlan(planNode, target, replace planNode) error {
 		node.replaceRoot(replace)
 	case *typeJoinMany:
 		node.replaceRoot(replace)
+	case *typeJoinManyToMany:
+		node.replaceRoot(replace)
 	case *pipeNode:
 		/* Do nothing - pipe nodes should not be replaced */
 	// @todo: add more nodes that apply here
@@ -0,0 +1,193 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+// relationLinks holds the documents to link to, and unlink from, a document through one of its
+// many-to-many relation fields.
+type relationLinks struct {
+	// The many-to-many relation field of the mutated document.
+	field client.FieldDefinition
+	// The field forming the other side of the relation.
+	otherField client.FieldDefinition
+	// The join collection holding the links of the relation.
+	joinCol client.Collection
+
+	link   []string
+	unlink []string
+}
+
+// extractRelationLinks returns a copy of the given mutation input without its many-to-many relation
+// fields, along with the links requested through those fields.
+func (p *Planner) extractRelationLinks(
+	col client.Collection,
+	input map[string]any,
+) (map[string]any, []relationLinks, error) {
+	result := make(map[string]any, len(input))
+	links := []relationLinks{}
+
+	for name, value := range input {
+		field, ok := col.Definition().GetFieldByName(name)
+		if !ok || field.Kind == nil || !field.Kind.IsObject() || !field.Kind.IsArray() {
+			result[name] = value
+			continue
+		}
+
+		otherDef, ok, err := client.GetDefinitionFromStore(p.ctx, p.db, col.Definition(), field.Kind)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			result[name] = value
+			continue
+		}
+
+		otherField, ok := client.GetManyToManyRelationField(col.Definition(), field, otherDef)
+		if !ok {
+			// Leave the field in the input so that the usual secondary relation error is returned.
+			result[name] = value
+			continue
+		}
+
+		joinCol, err := p.db.GetCollectionByName(p.ctx, client.JoinCollectionName(field.RelationName))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fieldLinks := relationLinks{
+			field:      field,
+			otherField: otherField,
+			joinCol:    joinCol,
+		}
+
+		linkInput, ok := value.(map[string]any)
+		if !ok {
+			if value != nil {
+				return nil, nil, client.NewErrUnexpectedType[map[string]any](name, value)
+			}
+			continue
+		}
+
+		fieldLinks.link, err = relationLinkIDs(name, linkInput[request.LinkInput])
+		if err != nil {
+			return nil, nil, err
+		}
+		fieldLinks.unlink, err = relationLinkIDs(name, linkInput[request.UnlinkInput])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		links = append(links, fieldLinks)
+	}
+
+	return result, links, nil
+}
+
+// relationLinkIDs returns the document IDs held by the given link input value.
+func relationLinkIDs(fieldName string, value any) ([]string, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, nil
+
+	case []string:
+		return typedValue, nil
+
+	case []any:
+		ids := make([]string, len(typedValue))
+		for i, item := range typedValue {
+			id, ok := item.(string)
+			if !ok {
+				return nil, client.NewErrUnexpectedType[string](fieldName, item)
+			}
+			ids[i] = id
+		}
+		return ids, nil
+
+	default:
+		return nil, client.NewErrUnexpectedType[[]any](fieldName, value)
+	}
+}
+
+// applyRelationLinks links and unlinks the document with the given ID to and from the documents
+// requested by the given links.
+//
+// Documents are linked before they are unlinked.
+func (p *Planner) applyRelationLinks(docID string, links []relationLinks) error {
+	for _, fieldLinks := range links {
+		for _, targetID := range fieldLinks.link {
+			err := p.setRelationLink(fieldLinks, docID, targetID, true)
+			if err != nil {
+				return err
+			}
+		}
+		for _, targetID := range fieldLinks.unlink {
+			err := p.setRelationLink(fieldLinks, docID, targetID, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setRelationLink sets whether the given host and target documents are linked or not.
+//
+// The link documents are never deleted, as a deleted document may not be recreated. Each pair of
+// documents is instead held by a single link document with an ID derived from the IDs of the pair,
+// which records whether they are currently linked.
+func (p *Planner) setRelationLink(links relationLinks, hostID string, targetID string, linked bool) error {
+	// The join collection field named after a side of the relation references the documents that
+	// side points to.
+	doc, err := client.NewDocFromMap(
+		map[string]any{
+			links.field.Name + request.RelatedObjectID:      targetID,
+			links.otherField.Name + request.RelatedObjectID: hostID,
+			client.JoinCollectionLinkedFieldName:            true,
+		},
+		links.joinCol.Definition(),
+	)
+	if err != nil {
+		return err
+	}
+
+	exists, err := links.joinCol.Exists(p.ctx, doc.ID())
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if !linked {
+			return nil
+		}
+		return links.joinCol.Create(p.ctx, doc)
+	}
+
+	existing, err := links.joinCol.Get(p.ctx, doc.ID(), false)
+	if err != nil {
+		return err
+	}
+
+	currentValue, err := existing.Get(client.JoinCollectionLinkedFieldName)
+	if err == nil && currentValue == linked {
+		return nil
+	}
+
+	err = existing.Set(client.JoinCollectionLinkedFieldName, linked)
+	if err != nil {
+		return err
+	}
+
+	return links.joinCol.Update(p.ctx, existing)
+}
@@ -91,7 +91,22 @@ func (p *Planner) makeTypeIndexJoin(
 	}
 
 	if typeFieldDesc.Kind.IsArray() {
-		joinPlan, err = p.makeTypeJoinMany(parent, source, subType)
+		var subCol client.Collection
+		subCol, err = p.db.GetCollectionByName(p.ctx, subType.CollectionName)
+		if err != nil {
+			return nil, err
+		}
+
+		childRelFieldDef, isManyToMany := client.GetManyToManyRelationField(
+			parent.collection.Definition(),
+			typeFieldDesc,
+			subCol.Definition(),
+		)
+		if isManyToMany {
+			joinPlan, err = p.makeTypeJoinManyToMany(parent, source, subType, typeFieldDesc, childRelFieldDef, subCol)
+		} else {
+			joinPlan, err = p.makeTypeJoinMany(parent, source, subType)
+		}
 	} else {
 		joinPlan, err = p.makeTypeJoinOne(parent, source, subType)
 	}
@@ -182,6 +197,14 @@ func (n *typeIndexJoin) simpleExplain() (map[string]any, error) {
 	case *typeJoinMany:
 		err = addExplainData(&joinType.invertibleTypeJoin)
 
+	case *typeJoinManyToMany:
+		simpleExplainMap[joinRootLabel] = immutable.Some(joinType.childRelFieldDef.Name)
+		simpleExplainMap[joinSubTypeNameLabel] = joinType.relFieldDef.Name
+
+		var subTypeExplainGraph map[string]any
+		subTypeExplainGraph, err = buildSimpleExplainGraph(joinType.childPlan)
+		simpleExplainMap[joinSubTypeLabel] = subTypeExplainGraph
+
 	default:
 		err = client.NewErrUnhandledType("join plan", n.joinPlan)
 	}
@@ -207,6 +230,9 @@ func (n *typeIndexJoin) Explain(explainType request.ExplainType) (map[string]any
 		if joinOne, isJoinOne := n.joinPlan.(*typeJoinOne); isJoinOne {
 			subScan = getNode[*scanNode](joinOne.childSide.plan)
 		}
+		if joinManyToMany, isJoinManyToMany := n.joinPlan.(*typeJoinManyToMany); isJoinManyToMany {
+			subScan = getNode[*scanNode](joinManyToMany.childPlan)
+		}
 		if subScan != nil {
 			subScanExplain, err := subScan.Explain(explainType)
 			if err != nil {
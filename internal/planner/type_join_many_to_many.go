@@ -0,0 +1,251 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/keys"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// typeJoinManyToMany is the plan node for a type index join across a many-to-many relation.
+//
+// Neither side of a many-to-many relation holds the IDs of the documents it is related to, the
+// links between them are held by the join collection of the relation. For each parent document,
+// the links referencing it are scanned using the link index of the join collection, and the
+// linked child documents are then fetched by ID.
+type typeJoinManyToMany struct {
+	documentIterator
+	docMapper
+
+	p *Planner
+
+	parentPlan planNode
+	childPlan  planNode
+
+	// The scan of the join collection, yielding the links of the relation.
+	linkScan *scanNode
+
+	// The relation field of the parent collection, that child documents are joined to.
+	relFieldDef client.FieldDefinition
+	// The relation field of the child collection, forming the other side of the relation.
+	childRelFieldDef client.FieldDefinition
+
+	relFieldMapIndex int
+
+	childCol client.Collection
+
+	// The name of the link field referencing the parent documents.
+	linkParentIDFieldName string
+
+	linkParentIDFieldMapIndex int
+	linkChildIDFieldMapIndex  int
+	linkLinkedFieldMapIndex   int
+}
+
+func (p *Planner) makeTypeJoinManyToMany(
+	parent *selectNode,
+	sourcePlan planNode,
+	subSelect *mapper.Select,
+	relFieldDef client.FieldDefinition,
+	childRelFieldDef client.FieldDefinition,
+	childCol client.Collection,
+) (*typeJoinManyToMany, error) {
+	prepareScanNodeFilterForTypeJoin(parent, sourcePlan, subSelect)
+
+	childPlan, err := p.Select(subSelect)
+	if err != nil {
+		return nil, err
+	}
+
+	// The join collection has a field named after each side of the relation, referencing
+	// the documents that side of the relation points to.
+	linkChildIDFieldName := relFieldDef.Name + request.RelatedObjectID
+	linkParentIDFieldName := childRelFieldDef.Name + request.RelatedObjectID
+
+	linkSelect, err := mapper.ToSelect(p.ctx, p.db, mapper.ObjectSelection, &request.Select{
+		Field: request.Field{
+			Name: client.JoinCollectionName(relFieldDef.RelationName),
+		},
+		ChildSelect: request.ChildSelect{
+			Fields: []request.Selection{
+				&request.Field{Name: linkParentIDFieldName},
+				&request.Field{Name: linkChildIDFieldName},
+				&request.Field{Name: client.JoinCollectionLinkedFieldName},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	linkScan, err := p.Scan(linkSelect)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typeJoinManyToMany{
+		docMapper:                 docMapper{parent.documentMapping},
+		p:                         p,
+		parentPlan:                sourcePlan,
+		childPlan:                 childPlan,
+		linkScan:                  linkScan,
+		relFieldDef:               relFieldDef,
+		childRelFieldDef:          childRelFieldDef,
+		relFieldMapIndex:          subSelect.Index,
+		childCol:                  childCol,
+		linkParentIDFieldName:     linkParentIDFieldName,
+		linkParentIDFieldMapIndex: linkSelect.DocumentMapping.FirstIndexOfName(linkParentIDFieldName),
+		linkChildIDFieldMapIndex:  linkSelect.DocumentMapping.FirstIndexOfName(linkChildIDFieldName),
+		linkLinkedFieldMapIndex:   linkSelect.DocumentMapping.FirstIndexOfName(client.JoinCollectionLinkedFieldName),
+	}, nil
+}
+
+func (n *typeJoinManyToMany) Kind() string {
+	return "typeJoinManyToMany"
+}
+
+func (n *typeJoinManyToMany) Init() error {
+	if err := n.childPlan.Init(); err != nil {
+		return err
+	}
+	return n.parentPlan.Init()
+}
+
+func (n *typeJoinManyToMany) Start() error {
+	if err := n.childPlan.Start(); err != nil {
+		return err
+	}
+	return n.parentPlan.Start()
+}
+
+func (n *typeJoinManyToMany) Prefixes(prefixes []keys.Walkable) {
+	n.parentPlan.Prefixes(prefixes)
+}
+
+func (n *typeJoinManyToMany) Next() (bool, error) {
+	hasValue, err := n.parentPlan.Next()
+	if err != nil || !hasValue {
+		return false, err
+	}
+
+	parentDoc := n.parentPlan.Value()
+
+	childIDs, err := n.fetchLinkedIDs(parentDoc.GetID())
+	if err != nil {
+		return false, err
+	}
+
+	childDocs := []core.Doc{}
+	if len(childIDs) > 0 {
+		childDocs, err = n.fetchChildDocs(childIDs)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	parentDoc.Fields[n.relFieldMapIndex] = childDocs
+	n.currentValue = parentDoc
+
+	return true, nil
+}
+
+// fetchLinkedIDs returns the IDs of the child documents currently linked to the parent document
+// with the given ID.
+func (n *typeJoinManyToMany) fetchLinkedIDs(parentID string) ([]string, error) {
+	n.linkScan.filter = addFilterOnIDField(nil, n.linkParentIDFieldMapIndex, parentID)
+	n.linkScan.index = findIndexByFieldName(n.linkScan.col, n.linkParentIDFieldName)
+	n.linkScan.initFetcher(immutable.None[string]())
+
+	if err := n.linkScan.Init(); err != nil {
+		return nil, NewErrSubTypeInit(err)
+	}
+
+	childIDs := []string{}
+	for {
+		hasValue, err := n.linkScan.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !hasValue {
+			break
+		}
+
+		link := n.linkScan.Value()
+		if linked, _ := link.Fields[n.linkLinkedFieldMapIndex].(bool); !linked {
+			// The documents were linked at some point, but have since been unlinked.
+			continue
+		}
+
+		childID, _ := link.Fields[n.linkChildIDFieldMapIndex].(string)
+		if childID != "" {
+			childIDs = append(childIDs, childID)
+		}
+	}
+
+	return childIDs, n.linkScan.fetcher.Close()
+}
+
+// fetchChildDocs returns the child documents with the given IDs, along with their sub documents.
+//
+// Documents that do not exist, or that do not match the filter of the child plan, are not returned.
+func (n *typeJoinManyToMany) fetchChildDocs(childIDs []string) ([]core.Doc, error) {
+	shortID, err := id.GetShortCollectionID(n.p.ctx, n.childCol.Version().CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]keys.Walkable, len(childIDs))
+	for i, childID := range childIDs {
+		prefixes[i] = keys.DataStoreKey{
+			CollectionShortID: shortID,
+			DocID:             childID,
+		}
+	}
+
+	n.childPlan.Prefixes(prefixes)
+	if err := n.childPlan.Init(); err != nil {
+		return nil, NewErrSubTypeInit(err)
+	}
+
+	childDocs := []core.Doc{}
+	for {
+		hasValue, err := n.childPlan.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !hasValue {
+			break
+		}
+
+		childDocs = append(childDocs, n.childPlan.Value())
+	}
+
+	return childDocs, nil
+}
+
+func (n *typeJoinManyToMany) Close() error {
+	if err := n.parentPlan.Close(); err != nil {
+		return err
+	}
+	return n.childPlan.Close()
+}
+
+func (n *typeJoinManyToMany) Source() planNode { return n.parentPlan }
+
+func (n *typeJoinManyToMany) replaceRoot(node planNode) {
+	n.parentPlan = node
+}
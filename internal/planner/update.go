@@ -52,6 +52,11 @@ func (n *updateNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
 	if n.isUpdating {
+		input, links, err := n.p.extractRelationLinks(n.collection, n.input)
+		if err != nil {
+			return false, err
+		}
+
 		for {
 			next, err := n.results.Next()
 			if err != nil {
@@ -71,7 +76,7 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
-			for k, v := range n.input {
+			for k, v := range input {
 				if err := doc.Set(k, v); err != nil {
 					return false, err
 				}
@@ -80,6 +85,10 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
+			err = n.p.applyRelationLinks(doc.ID().String(), links)
+			if err != nil {
+				return false, err
+			}
 
 			n.execInfo.updates++
 		}
@@ -87,7 +96,7 @@ func (n *updateNode) Next() (bool, error) {
 
 		// Re-init the results node, so that they can be properly yielded with the updated
 		// values, as well as any formatting (e.g. aggregates, groupings, etc)
-		err := n.results.Init()
+		err = n.results.Init()
 		if err != nil {
 			return false, err
 		}
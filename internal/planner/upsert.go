@@ -56,7 +56,11 @@ func (n *upsertNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
-			for k, v := range n.updateInput {
+			input, links, err := n.p.extractRelationLinks(n.collection, n.updateInput)
+			if err != nil {
+				return false, err
+			}
+			for k, v := range input {
 				if err := doc.Set(k, v); err != nil {
 					return false, err
 				}
@@ -65,8 +69,16 @@ func (n *upsertNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
+			err = n.p.applyRelationLinks(doc.ID().String(), links)
+			if err != nil {
+				return false, err
+			}
 		} else {
-			doc, err := client.NewDocFromMap(n.createInput, n.collection.Definition())
+			input, links, err := n.p.extractRelationLinks(n.collection, n.createInput)
+			if err != nil {
+				return false, err
+			}
+			doc, err := client.NewDocFromMap(input, n.collection.Definition())
 			if err != nil {
 				return false, err
 			}
@@ -74,6 +86,10 @@ func (n *upsertNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
+			err = n.p.applyRelationLinks(doc.ID().String(), links)
+			if err != nil {
+				return false, err
+			}
 
 			prefixes, err := n.docIDsToPrefixes(documentsToDocIDs(doc), n.collection.Version())
 			if err != nil {
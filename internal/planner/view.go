@@ -11,12 +11,23 @@
 package planner
 
 import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+
 	"github.com/sourcenetwork/corekv"
+	"github.com/sourcenetwork/immutable"
 
+	acpTypes "github.com/sourcenetwork/defradb/acp/types"
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/connor"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/datastore"
 	"github.com/sourcenetwork/defradb/internal/db/id"
+	"github.com/sourcenetwork/defradb/internal/db/permission"
 	"github.com/sourcenetwork/defradb/internal/keys"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 )
@@ -31,18 +42,29 @@ type viewNode struct {
 
 	// This is cached as a boolean to save rediscovering this in the main Next/Value iteration loop
 	hasTransform bool
+
+	// This is cached as a boolean to save rediscovering this in the main Next/Value iteration loop
+	mapsToDocuments bool
 }
 
 func (p *Planner) View(query *mapper.Select, col client.Collection) (planNode, error) {
 	// For now, we assume a single source.  This will need to change if/when we support multiple sources
 	querySource := (col.Version().Sources[0].(*client.QuerySource))
 	hasTransform := querySource.Transform.HasValue()
+	mapsToDocuments := ViewItemsMapToDocuments(querySource)
 
 	var source planNode
-	if col.Version().IsMaterialized {
-		source = p.newCachedViewFetcher(col.Definition(), query.DocumentMapping)
+	if col.Version().IsMaterialized && !isViewCacheBypassed(p.ctx, col.Version().CollectionID) {
+		source = p.newCachedViewFetcher(col, query)
 	} else {
-		m, err := mapper.ToSelect(p.ctx, p.db, mapper.ObjectSelection, &querySource.Query)
+		baseQuery := querySource.Query
+		if query.DocIDs.HasValue() && mapsToDocuments {
+			// The view items map one to one to the documents yielded by the base query, so the
+			// requested DocIDs can be pushed down to it.
+			baseQuery.DocIDs = immutable.Some(intersectDocIDs(baseQuery.DocIDs, query.DocIDs.Value()))
+		}
+
+		m, err := mapper.ToSelect(p.ctx, p.db, mapper.ObjectSelection, &baseQuery)
 		if err != nil {
 			return nil, err
 		}
@@ -58,16 +80,62 @@ func (p *Planner) View(query *mapper.Select, col client.Collection) (planNode, e
 	}
 
 	viewNode := &viewNode{
-		p:            p,
-		desc:         col.Version(),
-		source:       source,
-		docMapper:    docMapper{query.DocumentMapping},
-		hasTransform: hasTransform,
+		p:               p,
+		desc:            col.Version(),
+		source:          source,
+		docMapper:       docMapper{query.DocumentMapping},
+		hasTransform:    hasTransform,
+		mapsToDocuments: mapsToDocuments,
 	}
 
 	return viewNode, nil
 }
 
+// viewCacheBypassContextKey is the key type for the bypassed view cache context value.
+type viewCacheBypassContextKey struct{}
+
+// WithViewCacheBypassed returns a new context in which the view with the given collection ID is
+// queried from its source instead of its cache, for example in order to rebuild the cache.
+func WithViewCacheBypassed(ctx context.Context, collectionID string) context.Context {
+	return context.WithValue(ctx, viewCacheBypassContextKey{}, collectionID)
+}
+
+func isViewCacheBypassed(ctx context.Context, collectionID string) bool {
+	bypassedID, ok := ctx.Value(viewCacheBypassContextKey{}).(string)
+	return ok && bypassedID == collectionID
+}
+
+// ViewItemsMapToDocuments returns true if each item yielded by the given view source corresponds
+// to a single document yielded by the root of its query, sharing the ID of that document.
+func ViewItemsMapToDocuments(source *client.QuerySource) bool {
+	query := source.Query
+	return !source.Transform.HasValue() &&
+		!query.Limit.HasValue() &&
+		!query.Offset.HasValue() &&
+		!query.GroupBy.HasValue() &&
+		!query.CID.HasValue()
+}
+
+// intersectDocIDs returns the given DocIDs, restricted to the existing DocIDs if any are set.
+func intersectDocIDs(existing immutable.Option[[]string], docIDs []string) []string {
+	if !existing.HasValue() {
+		return docIDs
+	}
+
+	existingSet := make(map[string]struct{}, len(existing.Value()))
+	for _, docID := range existing.Value() {
+		existingSet[docID] = struct{}{}
+	}
+
+	result := []string{}
+	for _, docID := range docIDs {
+		if _, ok := existingSet[docID]; ok {
+			result = append(result, docID)
+		}
+	}
+	return result
+}
+
 func (n *viewNode) Init() error {
 	return n.source.Init()
 }
@@ -89,7 +157,10 @@ func (n *viewNode) Value() core.Doc {
 	// involved, if the the view is materialized, or if any kind of operation is performed on the result
 	// of the query (such as a filter or aggregate in the user-request), so we must convert the returned
 	// documents to the request mapping
-	return convertBetweenMaps(n.source.DocumentMap(), n.documentMapping, n.source.Value())
+	//
+	// Items that map to a single source document share its ID, this allows them to be requested,
+	// and cached, by DocID.
+	return convertBetweenMaps(n.source.DocumentMap(), n.documentMapping, n.source.Value(), n.mapsToDocuments)
 }
 
 func (n *viewNode) Source() planNode {
@@ -111,7 +182,15 @@ func (n *viewNode) Close() error {
 	return nil
 }
 
-func convertBetweenMaps(srcMap *core.DocumentMapping, dstMap *core.DocumentMapping, src core.Doc) core.Doc {
+// convertBetweenMaps converts the given document from the source mapping to the destination mapping.
+//
+// If copyIDs is true, the IDs of the source document and its children are copied to the result.
+func convertBetweenMaps(
+	srcMap *core.DocumentMapping,
+	dstMap *core.DocumentMapping,
+	src core.Doc,
+	copyIDs bool,
+) core.Doc {
 	dst := dstMap.NewDoc()
 
 	srcRenderKeysByIndex := map[int]string{}
@@ -148,12 +227,22 @@ func convertBetweenMaps(srcMap *core.DocumentMapping, dstMap *core.DocumentMappi
 
 					switch inner := src.Fields[srcIndex].(type) {
 					case core.Doc:
-						srcValue = convertBetweenMaps(srcMap.ChildMappings[srcIndex], dstMap.ChildMappings[dstIndex], inner)
+						srcValue = convertBetweenMaps(
+							srcMap.ChildMappings[srcIndex],
+							dstMap.ChildMappings[dstIndex],
+							inner,
+							copyIDs,
+						)
 
 					case []core.Doc:
 						dstInners := make([]core.Doc, len(inner))
 						for i, srcInnerDoc := range inner {
-							dstInners[i] = convertBetweenMaps(srcMap.ChildMappings[srcIndex], dstMap.ChildMappings[dstIndex], srcInnerDoc)
+							dstInners[i] = convertBetweenMaps(
+								srcMap.ChildMappings[srcIndex],
+								dstMap.ChildMappings[dstIndex],
+								srcInnerDoc,
+								copyIDs,
+							)
 						}
 						srcValue = dstInners
 					}
@@ -166,31 +255,106 @@ func convertBetweenMaps(srcMap *core.DocumentMapping, dstMap *core.DocumentMappi
 		}
 	}
 
+	if copyIDs && len(src.Fields) > core.DocIDFieldIndex && len(dst.Fields) > core.DocIDFieldIndex {
+		dst.SetID(src.GetID())
+	}
+
 	return dst
 }
 
 // cachedViewFetcher is a planner node that fetches view items from a materialized cache.
+//
+// Access to the cache of a permissioned view is controlled by the policy of the view, the items are
+// not fetched if the identity does not have the read permission on the view.
 type cachedViewFetcher struct {
 	docMapper
 	documentIterator
 
-	def client.CollectionDefinition
+	col client.Collection
 	p   *Planner
 
+	// index is the index used to find the items that may match the filter of the request, if any.
+	index immutable.Option[client.IndexDescription]
+
+	// indexValues are the values of the first field of the index that the requested items may hold.
+	indexValues []any
+
 	queryResults corekv.Iterator
+
+	// itemKeys holds the keys of the items found using the index that are yet to be fetched.
+	itemKeys [][]byte
+
+	execInfo cachedViewExecInfo
+}
+
+type cachedViewExecInfo struct {
+	// Total number of times cachedViewFetcher was executed.
+	iterations uint64
+
+	// Total number of index entries read to find the items.
+	indexFetches uint64
 }
 
 var _ planNode = (*cachedViewFetcher)(nil)
 
 func (p *Planner) newCachedViewFetcher(
-	def client.CollectionDefinition,
-	mapping *core.DocumentMapping,
+	col client.Collection,
+	query *mapper.Select,
 ) *cachedViewFetcher {
+	index, indexValues := findViewIndexLookup(col, query.Filter)
 	return &cachedViewFetcher{
-		def:       def,
-		p:         p,
-		docMapper: docMapper{mapping},
+		col:         col,
+		p:           p,
+		index:       index,
+		indexValues: indexValues,
+		docMapper:   docMapper{query.DocumentMapping},
+	}
+}
+
+// findViewIndexLookup returns the index that may be used to find the items of the given view that
+// match the given filter, along with the values of the first indexed field that the items may hold.
+//
+// Only equality conditions on the first field of an index, at the top level of the filter, are supported.
+func findViewIndexLookup(
+	col client.Collection,
+	filter *mapper.Filter,
+) (immutable.Option[client.IndexDescription], []any) {
+	if filter == nil {
+		return immutable.None[client.IndexDescription](), nil
+	}
+
+	indexes := slices.Clone(col.Version().Indexes)
+	slices.SortFunc(indexes, func(a, b client.IndexDescription) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, index := range indexes {
+		if index.Blind {
+			// The values held by blind indexes are not known to the planner.
+			continue
+		}
+
+		field, ok := col.Definition().GetFieldByName(index.Fields[0].Name)
+		if !ok || field.Kind.IsArray() || field.Kind == client.FieldKind_NILLABLE_JSON {
+			// The elements of arrays and json are indexed individually, so they can not
+			// be looked up by equality of the whole value.
+			continue
+		}
+
+		conditions, ok := filter.ExternalConditions[field.Name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if value, ok := conditions[connor.EqualOp]; ok {
+			return immutable.Some(index), []any{value}
+		}
+		if values, ok := conditions[connor.InOp].([]any); ok {
+			return immutable.Some(index), values
+		}
 	}
+
+	return immutable.None[client.IndexDescription](), nil
 }
 
 func (n *cachedViewFetcher) Init() error {
@@ -201,11 +365,25 @@ func (n *cachedViewFetcher) Init() error {
 		}
 		n.queryResults = nil
 	}
+	n.itemKeys = nil
 
-	shortID, err := id.GetShortCollectionID(n.p.ctx, n.def.Version.CollectionID)
+	hasAccess, err := n.checkAccess()
 	if err != nil {
 		return err
 	}
+	if !hasAccess {
+		return nil
+	}
+
+	shortID, err := id.GetShortCollectionID(n.p.ctx, n.col.Version().CollectionID)
+	if err != nil {
+		return err
+	}
+
+	if n.index.HasValue() {
+		return n.initItemKeysFromIndex(shortID)
+	}
+
 	txn := datastore.CtxMustGetTxn(n.p.ctx)
 	iter, err := txn.Datastore().Iterator(n.p.ctx, corekv.IterOptions{
 		Prefix: keys.NewViewCacheColPrefix(shortID).Bytes(),
@@ -218,6 +396,102 @@ func (n *cachedViewFetcher) Init() error {
 	return nil
 }
 
+// checkAccess returns true if the identity may read the items of the view.
+//
+// Permissioned views are registered with acp as an object, with the collectionID as the objectID.
+func (n *cachedViewFetcher) checkAccess() (bool, error) {
+	if !n.p.documentACP.HasValue() {
+		return true, nil
+	}
+
+	return permission.CheckAccessOfDocOnCollectionWithACP(
+		n.p.ctx,
+		n.p.identity,
+		n.p.documentACP.Value(),
+		n.col,
+		acpTypes.DocumentReadPerm,
+		permission.OperationFromContext(n.p.ctx),
+		n.col.Version().CollectionID,
+	)
+}
+
+// initItemKeysFromIndex reads the keys of the items that hold any of the index values from the index.
+//
+// The keys are sorted so that the items are yielded in the same order as they would be without the index.
+func (n *cachedViewFetcher) initItemKeysFromIndex(shortID uint32) error {
+	txn := datastore.CtxMustGetTxn(n.p.ctx)
+	index := n.index.Value()
+
+	field, _ := n.col.Definition().GetFieldByName(index.Fields[0].Name)
+	itemKeys := [][]byte{}
+	for _, value := range n.indexValues {
+		normalValue, err := newViewIndexValue(n.col.Definition(), field, value)
+		if err != nil {
+			return err
+		}
+
+		prefix := keys.NewIndexDataStoreKey(
+			shortID,
+			index.ID,
+			[]keys.IndexedField{{Value: normalValue, Descending: index.Fields[0].Descending}},
+		)
+		iter, err := txn.Datastore().Iterator(n.p.ctx, corekv.IterOptions{
+			Prefix: append(prefix.Bytes(), '/'),
+		})
+		if err != nil {
+			return err
+		}
+
+		for {
+			hasNext, err := iter.Next()
+			if err != nil {
+				return errors.Join(err, iter.Close())
+			}
+			if !hasNext {
+				break
+			}
+			n.execInfo.indexFetches++
+
+			itemKey, err := iter.Value()
+			if err != nil {
+				return errors.Join(err, iter.Close())
+			}
+			itemKeys = append(itemKeys, bytes.Clone(itemKey))
+		}
+
+		err = iter.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	slices.SortFunc(itemKeys, bytes.Compare)
+	n.itemKeys = slices.CompactFunc(itemKeys, bytes.Equal)
+	return nil
+}
+
+// newViewIndexValue converts the given filter value to the kind of the given field, as it is held
+// by the index.
+func newViewIndexValue(def client.CollectionDefinition, field client.FieldDefinition, value any) (client.NormalValue, error) {
+	if value == nil {
+		return client.NewNormalNil(field.Kind)
+	}
+
+	doc, err := client.NewDocFromMap(map[string]any{field.Name: value}, def)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldValue, err := doc.TryGetValue(field.Name)
+	if err != nil {
+		return nil, err
+	}
+	if fieldValue == nil || fieldValue.Value() == nil {
+		return client.NewNormalNil(field.Kind)
+	}
+	return fieldValue.NormalValue(), nil
+}
+
 func (n *cachedViewFetcher) Start() error {
 	return nil
 }
@@ -227,13 +501,10 @@ func (n *cachedViewFetcher) Prefixes(prefixes []keys.Walkable) {
 }
 
 func (n *cachedViewFetcher) Next() (bool, error) {
-	hasNext, err := n.queryResults.Next()
-	if !hasNext || err != nil {
-		return false, err
-	}
+	n.execInfo.iterations++
 
-	value, err := n.queryResults.Value()
-	if err != nil {
+	value, hasValue, err := n.nextItem()
+	if !hasValue || err != nil {
 		return false, err
 	}
 
@@ -245,6 +516,35 @@ func (n *cachedViewFetcher) Next() (bool, error) {
 	return true, nil
 }
 
+// nextItem returns the serialized value of the next item, either from the cache or from the
+// items found using the index.
+func (n *cachedViewFetcher) nextItem() ([]byte, bool, error) {
+	if n.queryResults == nil {
+		if len(n.itemKeys) == 0 {
+			return nil, false, nil
+		}
+
+		txn := datastore.CtxMustGetTxn(n.p.ctx)
+		value, err := txn.Datastore().Get(n.p.ctx, n.itemKeys[0])
+		if err != nil {
+			return nil, false, err
+		}
+		n.itemKeys = n.itemKeys[1:]
+		return value, true, nil
+	}
+
+	hasNext, err := n.queryResults.Next()
+	if !hasNext || err != nil {
+		return nil, false, err
+	}
+
+	value, err := n.queryResults.Value()
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
 func (n *cachedViewFetcher) Source() planNode {
 	return nil
 }
@@ -253,6 +553,42 @@ func (n *cachedViewFetcher) Kind() string {
 	return "cachedViewFetcher"
 }
 
+func (n *cachedViewFetcher) simpleExplain() map[string]any {
+	simpleExplainMap := map[string]any{
+		collectionNameLabel: n.col.Name(),
+		collectionIDLabel:   n.col.Version().VersionID,
+	}
+
+	if n.index.HasValue() {
+		simpleExplainMap[indexLabel] = n.index.Value().Name
+	} else {
+		simpleExplainMap[indexLabel] = nil
+	}
+
+	return simpleExplainMap
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *cachedViewFetcher) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain(), nil
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":   n.execInfo.iterations,
+			"indexFetches": n.execInfo.indexFetches,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
 func (n *cachedViewFetcher) Close() error {
+	if n.queryResults == nil {
+		return nil
+	}
 	return n.queryResults.Close()
 }
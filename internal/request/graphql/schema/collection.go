@@ -88,11 +88,20 @@ func fromAst(doc *ast.Document) (
 	results := []core.Collection{}
 	cTypeByFieldNameByObjName := map[string]map[string]client.CType{}
 
+	// Enums may be declared anywhere within the document, so they must be gathered
+	// before any of the object definitions are processed.
+	enumsByName := map[string]*client.EnumKind{}
+	for _, def := range doc.Definitions {
+		if enumDef, ok := def.(*ast.EnumDefinition); ok {
+			enumsByName[enumDef.Name.Value] = enumFromAST(enumDef)
+		}
+	}
+
 	for _, def := range doc.Definitions {
 		switch defType := def.(type) {
 		case *ast.ObjectDefinition:
 			td := newObjectDefinition(defType)
-			result, err := fromAstDefinition(td, cTypeByFieldNameByObjName)
+			result, err := fromAstDefinition(td, enumsByName, cTypeByFieldNameByObjName)
 			if err != nil {
 				return nil, err
 			}
@@ -101,7 +110,7 @@ func fromAst(doc *ast.Document) (
 
 		case *ast.InterfaceDefinition:
 			td := newInterfaceDefinition(defType)
-			result, err := fromAstDefinition(td, cTypeByFieldNameByObjName)
+			result, err := fromAstDefinition(td, enumsByName, cTypeByFieldNameByObjName)
 			if err != nil {
 				return nil, err
 			}
@@ -122,12 +131,20 @@ func fromAst(doc *ast.Document) (
 		return nil, err
 	}
 
-	return results, nil
+	// The links of many-to-many relations are held by join collections, these can only be
+	// generated once both sides of each relation are known.
+	joinCollections, err := joinCollectionsFromRelations(results)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(results, joinCollections...), nil
 }
 
 // fromAstDefinition parses a AST object definition into a set of collection versions.
 func fromAstDefinition(
 	def *typeDefinition,
+	enumsByName map[string]*client.EnumKind,
 	cTypeByFieldNameByObjName map[string]map[string]client.CType,
 ) (core.Collection, error) {
 	schemaFieldDescriptions := []client.SchemaFieldDescription{
@@ -151,6 +168,7 @@ func fromAstDefinition(
 		tmpSchemaFieldDescriptions, tmpCollectionFieldDescriptions, err := fieldsFromAST(
 			field,
 			def.Name.Value,
+			enumsByName,
 			cTypeByFieldNameByObjName,
 		)
 		if err != nil {
@@ -199,7 +217,9 @@ func fromAstDefinition(
 	})
 
 	isMaterialized := immutable.None[bool]()
+	var isAutoRefreshed bool
 	var isBranchable bool
+	var isEncrypted bool
 	for _, directive := range def.Directives {
 		switch directive.Name.Value {
 		case types.IndexDirectiveLabel:
@@ -217,18 +237,20 @@ func fromAstDefinition(
 			policyDescription = immutable.Some(policy)
 
 		case types.MaterializedDirectiveLabel:
-			if isMaterialized.Value() {
-				continue
-			}
-
 			explicitIsMaterialized := immutable.None[bool]()
 			for _, arg := range directive.Arguments {
-				if arg.Name.Value == types.MaterializedDirectivePropIf {
+				switch arg.Name.Value {
+				case types.MaterializedDirectivePropIf:
 					explicitIsMaterialized = immutable.Some(arg.Value.GetValue().(bool))
-					break
+				case types.MaterializedDirectivePropAutoRefresh:
+					isAutoRefreshed = isAutoRefreshed || arg.Value.GetValue().(bool)
 				}
 			}
 
+			if isMaterialized.Value() {
+				continue
+			}
+
 			if explicitIsMaterialized.HasValue() {
 				isMaterialized = immutable.Some(isMaterialized.Value() || explicitIsMaterialized.Value())
 			} else {
@@ -250,6 +272,9 @@ func fromAstDefinition(
 			}
 
 			isBranchable = !explicitIsBranchable.HasValue() || explicitIsBranchable.Value()
+
+		case types.EncryptedDirectiveLabel:
+			isEncrypted = true
 		}
 	}
 
@@ -260,8 +285,10 @@ func fromAstDefinition(
 				Policy:           policyDescription,
 				Fields:           collectionFieldDescriptions,
 				IsMaterialized:   !isMaterialized.HasValue() || isMaterialized.Value(),
+				IsAutoRefreshed:  isAutoRefreshed,
 				IsBranchable:     isBranchable,
 				IsEmbeddedOnly:   def.IsInterface,
+				IsEncrypted:      isEncrypted,
 				IsActive:         true,
 				VectorEmbeddings: vectorEmbeddings,
 			},
@@ -296,6 +323,7 @@ func IsValidIndexName(name string) bool {
 func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (client.IndexCreateRequest, error) {
 	var name string
 	var unique bool
+	var blind bool
 
 	var direction *ast.EnumValue
 	var includes *ast.ListValue
@@ -333,6 +361,13 @@ func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (clie
 			}
 			unique = uniqueVal.Value
 
+		case types.IndexDirectivePropBlind:
+			blindVal, ok := arg.Value.(*ast.BooleanValue)
+			if !ok {
+				return client.IndexCreateRequest{}, ErrIndexWithInvalidArg
+			}
+			blind = blindVal.Value
+
 		default:
 			return client.IndexCreateRequest{}, ErrIndexWithUnknownArg
 		}
@@ -375,6 +410,7 @@ func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (clie
 		Name:   name,
 		Fields: fields,
 		Unique: unique,
+		Blind:  blind,
 	}, nil
 }
 
@@ -475,9 +511,10 @@ func defaultFromAST(
 func fieldsFromAST(
 	field *ast.FieldDefinition,
 	hostObjectName string,
+	enumsByName map[string]*client.EnumKind,
 	cTypeByFieldNameByObjName map[string]map[string]client.CType,
 ) ([]client.SchemaFieldDescription, []client.CollectionFieldDescription, error) {
-	kind, err := astTypeToKind(hostObjectName, field)
+	kind, err := astTypeToKind(hostObjectName, field, enumsByName)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -496,6 +533,8 @@ func fieldsFromAST(
 
 	var defaultValue any
 	var constraints constraintDescription
+	var isEncrypted bool
+	computed := immutable.None[client.ComputedFieldDescription]()
 	for _, directive := range field.Directives {
 		switch directive.Name.Value {
 		case types.DefaultDirectiveLabel:
@@ -508,6 +547,14 @@ func fieldsFromAST(
 			if err != nil {
 				return nil, nil, err
 			}
+		case types.EncryptedDirectiveLabel:
+			isEncrypted = true
+		case types.ComputedDirectiveLabel:
+			computedDesc, err := computedFromAST(field, directive)
+			if err != nil {
+				return nil, nil, err
+			}
+			computed = immutable.Some(computedDesc)
 		}
 	}
 
@@ -520,6 +567,11 @@ func fieldsFromAST(
 			return nil, nil, err
 		}
 
+		onDelete, err := getRelationDeleteAction(field)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		if kind.IsArray() {
 			collectionFieldDescriptions = append(
 				collectionFieldDescriptions,
@@ -527,6 +579,9 @@ func fieldsFromAST(
 					Name:         field.Name.Value,
 					Kind:         immutable.Some(kind),
 					RelationName: immutable.Some(relationName),
+					IsEncrypted:  isEncrypted,
+					OnDelete:     onDelete,
+					Computed:     computed,
 				},
 			)
 		} else {
@@ -547,6 +602,9 @@ func fieldsFromAST(
 					Name:         field.Name.Value,
 					Kind:         immutable.Some(kind),
 					RelationName: immutable.Some(relationName),
+					IsEncrypted:  isEncrypted,
+					OnDelete:     onDelete,
+					Computed:     computed,
 				},
 			)
 
@@ -579,6 +637,9 @@ func fieldsFromAST(
 				Name:         field.Name.Value,
 				DefaultValue: defaultValue,
 				Size:         constraints.Size,
+				Constraints:  constraints.Constraints,
+				IsEncrypted:  isEncrypted,
+				Computed:     computed,
 			},
 		)
 	}
@@ -604,6 +665,18 @@ func policyFromAST(directive *ast.Directive) (client.PolicyDescription, error) {
 				return client.PolicyDescription{}, ErrPolicyInvalidResourceProp
 			}
 			policyDesc.ResourceName = policyResourceProp.Value
+		case types.PolicySchemaDirectivePropRelations:
+			policyRelationsProp, ok := arg.Value.(*ast.ListValue)
+			if !ok {
+				return client.PolicyDescription{}, ErrPolicyInvalidRelationsProp
+			}
+			for _, value := range policyRelationsProp.Values {
+				fieldRelation, err := policyFieldRelationFromAST(value)
+				if err != nil {
+					return client.PolicyDescription{}, err
+				}
+				policyDesc.FieldRelations = append(policyDesc.FieldRelations, fieldRelation)
+			}
 		default:
 			return client.PolicyDescription{}, ErrPolicyWithUnknownArg
 		}
@@ -611,6 +684,34 @@ func policyFromAST(directive *ast.Directive) (client.PolicyDescription, error) {
 	return policyDesc, nil
 }
 
+func policyFieldRelationFromAST(value ast.Value) (client.PolicyFieldRelation, error) {
+	argTypeObject, ok := value.(*ast.ObjectValue)
+	if !ok {
+		return client.PolicyFieldRelation{}, ErrPolicyInvalidRelationsProp
+	}
+
+	fieldRelation := client.PolicyFieldRelation{}
+	for _, field := range argTypeObject.Fields {
+		fieldVal, ok := field.Value.(*ast.StringValue)
+		if !ok {
+			return client.PolicyFieldRelation{}, ErrPolicyInvalidRelationsProp
+		}
+		switch field.Name.Value {
+		case types.PolicyRelationPropField:
+			fieldRelation.FieldName = fieldVal.Value
+		case types.PolicyRelationPropRelation:
+			fieldRelation.Relation = fieldVal.Value
+		default:
+			return client.PolicyFieldRelation{}, ErrPolicyWithUnknownArg
+		}
+	}
+
+	if fieldRelation.FieldName == "" || fieldRelation.Relation == "" {
+		return client.PolicyFieldRelation{}, ErrPolicyInvalidRelationsProp
+	}
+	return fieldRelation, nil
+}
+
 func vectorEmbeddingFromAST(
 	directive *ast.Directive,
 	fieldDef *ast.FieldDefinition,
@@ -641,7 +742,38 @@ func vectorEmbeddingFromAST(
 }
 
 type constraintDescription struct {
-	Size int
+	Size        int
+	Constraints client.FieldConstraints
+}
+
+// computedFromAST returns the computed field description declared by the given @computed directive.
+//
+// The expression is validated along with the rest of the collection definition.
+func computedFromAST(field *ast.FieldDefinition, directive *ast.Directive) (client.ComputedFieldDescription, error) {
+	computed := client.ComputedFieldDescription{
+		IsStored: true,
+	}
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.ComputedDirectivePropExpr:
+			expression, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return client.ComputedFieldDescription{}, NewErrComputedInvalidArgument(field.Name.Value, arg.Name.Value)
+			}
+			computed.Expression = expression.Value
+
+		case types.ComputedDirectivePropStored:
+			isStored, ok := arg.Value.(*ast.BooleanValue)
+			if !ok {
+				return client.ComputedFieldDescription{}, NewErrComputedInvalidArgument(field.Name.Value, arg.Name.Value)
+			}
+			computed.IsStored = isStored.Value
+
+		default:
+			return client.ComputedFieldDescription{}, NewErrComputedInvalidArgument(field.Name.Value, arg.Name.Value)
+		}
+	}
+	return computed, nil
 }
 
 func contraintsFromAST(kind client.FieldKind, directive *ast.Directive) (constraintDescription, error) {
@@ -657,11 +789,92 @@ func contraintsFromAST(kind client.FieldKind, directive *ast.Directive) (constra
 				return constraintDescription{}, err
 			}
 			constraints.Size = size
+
+		case types.ConstraintsDirectivePropMin:
+			minValue, err := constraintFloatFromAST(arg)
+			if err != nil {
+				return constraintDescription{}, err
+			}
+			constraints.Constraints.Min = immutable.Some(minValue)
+
+		case types.ConstraintsDirectivePropMax:
+			maxValue, err := constraintFloatFromAST(arg)
+			if err != nil {
+				return constraintDescription{}, err
+			}
+			constraints.Constraints.Max = immutable.Some(maxValue)
+
+		case types.ConstraintsDirectivePropMinLength:
+			minLength, err := constraintIntFromAST(arg)
+			if err != nil {
+				return constraintDescription{}, err
+			}
+			constraints.Constraints.MinLength = immutable.Some(minLength)
+
+		case types.ConstraintsDirectivePropMaxLength:
+			maxLength, err := constraintIntFromAST(arg)
+			if err != nil {
+				return constraintDescription{}, err
+			}
+			constraints.Constraints.MaxLength = immutable.Some(maxLength)
+
+		case types.ConstraintsDirectivePropPattern:
+			pattern, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return constraintDescription{}, NewErrConstraintsInvalidArgument(arg.Name.Value)
+			}
+			constraints.Constraints.Pattern = immutable.Some(pattern.Value)
+
+		case types.ConstraintsDirectivePropOneOf:
+			list, ok := arg.Value.(*ast.ListValue)
+			if !ok {
+				return constraintDescription{}, NewErrConstraintsInvalidArgument(arg.Name.Value)
+			}
+			for _, value := range list.Values {
+				switch typedValue := value.(type) {
+				case *ast.StringValue:
+					constraints.Constraints.OneOf = append(constraints.Constraints.OneOf, typedValue.Value)
+				case *ast.IntValue:
+					intValue, err := strconv.ParseInt(typedValue.Value, 10, 64)
+					if err != nil {
+						return constraintDescription{}, err
+					}
+					constraints.Constraints.OneOf = append(constraints.Constraints.OneOf, intValue)
+				default:
+					return constraintDescription{}, NewErrConstraintsInvalidArgument(arg.Name.Value)
+				}
+			}
+
+		case types.ConstraintsDirectivePropNotNull:
+			notNull, ok := arg.Value.(*ast.BooleanValue)
+			if !ok {
+				return constraintDescription{}, NewErrConstraintsInvalidArgument(arg.Name.Value)
+			}
+			constraints.Constraints.NotNull = notNull.Value
 		}
 	}
 	return constraints, nil
 }
 
+func constraintFloatFromAST(arg *ast.Argument) (float64, error) {
+	switch value := arg.Value.(type) {
+	case *ast.IntValue:
+		return strconv.ParseFloat(value.Value, 64)
+	case *ast.FloatValue:
+		return strconv.ParseFloat(value.Value, 64)
+	default:
+		return 0, NewErrConstraintsInvalidArgument(arg.Name.Value)
+	}
+}
+
+func constraintIntFromAST(arg *ast.Argument) (int, error) {
+	value, ok := arg.Value.(*ast.IntValue)
+	if !ok {
+		return 0, NewErrConstraintsInvalidArgument(arg.Name.Value)
+	}
+	return strconv.Atoi(value.Value)
+}
+
 func setCRDTType(field *ast.FieldDefinition, kind client.FieldKind) (client.CType, error) {
 	if directive, exists := findDirective(field, "crdt"); exists {
 		for _, arg := range directive.Arguments {
@@ -687,12 +900,26 @@ func setCRDTType(field *ast.FieldDefinition, kind client.FieldKind) (client.CTyp
 		return client.LWW_REGISTER, nil
 	}
 
+	if _, ok := kind.(*client.EnumKind); ok {
+		return client.LWW_REGISTER, nil
+	}
+
 	return defaultCRDTForFieldKind[kind], nil
 }
 
+// enumFromAST returns the enum kind declared by the given AST enum definition.
+func enumFromAST(def *ast.EnumDefinition) *client.EnumKind {
+	values := make([]string, len(def.Values))
+	for i, value := range def.Values {
+		values[i] = value.Name.Value
+	}
+	return client.NewEnumKind(def.Name.Value, values)
+}
+
 func astTypeToKind(
 	hostObjectName string,
 	field *ast.FieldDefinition,
+	enumsByName map[string]*client.EnumKind,
 ) (client.FieldKind, error) {
 	switch astTypeVal := field.Type.(type) {
 	case *ast.List:
@@ -726,7 +953,11 @@ func astTypeToKind(
 			case typeString:
 				return client.FieldKind_NILLABLE_STRING_ARRAY, nil
 			default:
-				return client.NewNamedKind(astTypeVal.Type.(*ast.Named).Name.Value, true), nil
+				typeName := astTypeVal.Type.(*ast.Named).Name.Value
+				if _, isEnum := enumsByName[typeName]; isEnum {
+					return client.FieldKind_None, NewErrEnumArrayNotSupported(field.Name.Value, typeName)
+				}
+				return client.NewNamedKind(typeName, true), nil
 			}
 		}
 
@@ -751,6 +982,9 @@ func astTypeToKind(
 		case typeJSON:
 			return client.FieldKind_NILLABLE_JSON, nil
 		default:
+			if enumKind, isEnum := enumsByName[astTypeVal.Name.Value]; isEnum {
+				return enumKind, nil
+			}
 			return client.NewNamedKind(astTypeVal.Name.Value, false), nil
 		}
 
@@ -785,7 +1019,7 @@ func getRelationshipName(
 	for _, directive := range field.Directives {
 		if directive.Name.Value == "relation" {
 			for _, argument := range directive.Arguments {
-				if argument.Name.Value == "name" {
+				if argument.Name.Value == types.RelationDirectivePropName {
 					name, isString := argument.Value.GetValue().(string)
 					if !isString {
 						return "", client.NewErrUnexpectedType[string]("Relationship name", argument.Value.GetValue())
@@ -800,6 +1034,32 @@ func getRelationshipName(
 	return genRelationName(hostName, targetName)
 }
 
+// Gets the action applied to the documents referencing a deleted document through the given field.
+// Will return [client.RelationDeleteNone] if no action is specified.
+func getRelationDeleteAction(field *ast.FieldDefinition) (client.RelationDeleteAction, error) {
+	directive, exists := findDirective(field, types.RelationLabel)
+	if !exists {
+		return client.RelationDeleteNone, nil
+	}
+
+	for _, argument := range directive.Arguments {
+		if argument.Name.Value != types.RelationDirectivePropOnDelete {
+			continue
+		}
+		actionString, isString := argument.Value.GetValue().(string)
+		if !isString {
+			return 0, NewErrInvalidRelationDeleteAction(field.Name.Value, argument.Value.GetValue())
+		}
+		action, isAction := types.RelationDeleteActionEnum().ParseValue(actionString).(client.RelationDeleteAction)
+		if !isAction {
+			return 0, NewErrInvalidRelationDeleteAction(field.Name.Value, actionString)
+		}
+		return action, nil
+	}
+
+	return client.RelationDeleteNone, nil
+}
+
 func genRelationName(t1, t2 string) (string, error) {
 	if t1 == "" || t2 == "" {
 		return "", client.NewErrUninitializeProperty("genRelationName", "relation types")
@@ -911,3 +1171,180 @@ func finalizeRelations(
 
 	return nil
 }
+
+// joinCollectionsFromRelations returns the join collections holding the links of the many-to-many
+// relations between the given collections.
+//
+// A relation is many-to-many if the fields on both sides of it are arrays.
+func joinCollectionsFromRelations(results []core.Collection) ([]core.Collection, error) {
+	joinCollections := []core.Collection{}
+	joinedRelations := map[string]struct{}{}
+
+	for _, result := range results {
+		if result.Definition.Version.IsEmbeddedOnly {
+			continue
+		}
+
+		for _, field := range result.Definition.Version.Fields {
+			if !field.Kind.HasValue() || !field.RelationName.HasValue() {
+				continue
+			}
+
+			namedKind, ok := field.Kind.Value().(*client.NamedKind)
+			if !ok || !namedKind.IsArray() {
+				continue
+			}
+
+			relationName := field.RelationName.Value()
+			if _, ok := joinedRelations[relationName]; ok {
+				// The join collection has already been generated from the other side of the relation.
+				continue
+			}
+
+			var otherField immutable.Option[client.CollectionFieldDescription]
+			for _, otherDef := range results {
+				if otherDef.Definition.Version.Name != namedKind.Name || otherDef.Definition.Version.IsEmbeddedOnly {
+					continue
+				}
+
+				otherFieldDescription, ok := otherDef.Definition.Version.GetFieldByRelation(
+					relationName,
+					result.Definition.Version.Name,
+					field.Name,
+				)
+				if ok && otherFieldDescription.Kind.HasValue() && otherFieldDescription.Kind.Value().IsArray() {
+					otherField = immutable.Some(otherFieldDescription)
+				}
+				break
+			}
+
+			if !otherField.HasValue() {
+				continue
+			}
+
+			if field.Name == otherField.Value().Name ||
+				field.Name == client.JoinCollectionLinkedFieldName ||
+				otherField.Value().Name == client.JoinCollectionLinkedFieldName {
+				return nil, NewErrManyToManyFieldNameConflict(relationName, field.Name, otherField.Value().Name)
+			}
+
+			joinedRelations[relationName] = struct{}{}
+			joinCollections = append(
+				joinCollections,
+				newJoinCollection(
+					relationName,
+					// The field named after each side of the relation references the documents that
+					// side of the relation points to.
+					joinSide{fieldName: field.Name, targetName: namedKind.Name},
+					joinSide{fieldName: otherField.Value().Name, targetName: result.Definition.Version.Name},
+				),
+			)
+		}
+	}
+
+	return joinCollections, nil
+}
+
+// joinSide describes a side of a many-to-many relation held by a join collection.
+type joinSide struct {
+	// The name of the field on this side of the relation.
+	fieldName string
+	// The name of the collection this side of the relation points to.
+	targetName string
+}
+
+// newJoinCollection returns the join collection holding the links of the many-to-many relation
+// with the given name.
+//
+// Each link is a document referencing a document of each side of the relation, and recording whether
+// they are currently linked or not.  Links are never deleted so that documents may be linked again
+// after they have been unlinked.
+func newJoinCollection(relationName string, sides ...joinSide) core.Collection {
+	name := client.JoinCollectionName(relationName)
+
+	schemaFieldDescriptions := []client.SchemaFieldDescription{
+		{
+			Name: request.DocIDFieldName,
+			Kind: client.FieldKind_DocID,
+			Typ:  client.NONE_CRDT,
+		},
+	}
+	collectionFieldDescriptions := []client.CollectionFieldDescription{
+		{
+			Name: request.DocIDFieldName,
+		},
+	}
+	indexes := []client.IndexCreateRequest{}
+
+	for _, side := range sides {
+		idFieldName := side.fieldName + request.RelatedObjectID
+		sideRelationName := fmt.Sprintf("%s_%s", relationName, side.fieldName)
+		kind := client.NewNamedKind(side.targetName, false)
+
+		schemaFieldDescriptions = append(
+			schemaFieldDescriptions,
+			client.SchemaFieldDescription{
+				Name: side.fieldName,
+				Kind: kind,
+				Typ:  defaultCRDTForFieldKind[client.FieldKind_DocID],
+			},
+			client.SchemaFieldDescription{
+				Name: idFieldName,
+				Kind: client.FieldKind_DocID,
+				Typ:  defaultCRDTForFieldKind[client.FieldKind_DocID],
+			},
+		)
+
+		collectionFieldDescriptions = append(
+			collectionFieldDescriptions,
+			client.CollectionFieldDescription{
+				Name:         side.fieldName,
+				Kind:         immutable.Some[client.FieldKind](kind),
+				RelationName: immutable.Some(sideRelationName),
+			},
+			client.CollectionFieldDescription{
+				Name:         idFieldName,
+				Kind:         immutable.Some[client.FieldKind](client.FieldKind_DocID),
+				RelationName: immutable.Some(sideRelationName),
+			},
+		)
+
+		// The links are looked up by the document on either side of the relation.
+		indexes = append(indexes, client.IndexCreateRequest{
+			Fields: []client.IndexedFieldDescription{{Name: idFieldName}},
+		})
+	}
+
+	schemaFieldDescriptions = append(schemaFieldDescriptions, client.SchemaFieldDescription{
+		Name: client.JoinCollectionLinkedFieldName,
+		Kind: client.FieldKind_NILLABLE_BOOL,
+		Typ:  defaultCRDTForFieldKind[client.FieldKind_NILLABLE_BOOL],
+	})
+	collectionFieldDescriptions = append(collectionFieldDescriptions, client.CollectionFieldDescription{
+		Name: client.JoinCollectionLinkedFieldName,
+	})
+
+	sort.Slice(schemaFieldDescriptions[1:], func(i, j int) bool {
+		return schemaFieldDescriptions[i+1].Name < schemaFieldDescriptions[j+1].Name
+	})
+	sort.Slice(collectionFieldDescriptions[1:], func(i, j int) bool {
+		return collectionFieldDescriptions[i+1].Name < collectionFieldDescriptions[j+1].Name
+	})
+
+	return core.Collection{
+		Definition: client.CollectionDefinition{
+			Version: client.CollectionVersion{
+				Name:           name,
+				Fields:         collectionFieldDescriptions,
+				IsMaterialized: true,
+				IsJoin:         true,
+				IsActive:       true,
+			},
+			Schema: client.SchemaDescription{
+				Name:   name,
+				Fields: schemaFieldDescriptions,
+			},
+		},
+		CreateIndexes: indexes,
+	}
+}
@@ -33,12 +33,19 @@ const (
 	errPolicyUnknownArgument         string = "policy with unknown argument"
 	errPolicyInvalidIDProp           string = "policy directive with invalid id property"
 	errPolicyInvalidResourceProp     string = "policy directive with invalid resource property"
+	errPolicyInvalidRelationsProp    string = "policy directive with invalid relations property"
 	errDefaultValueType              string = "default value type must match field type"
 	errDefaultValueNotAllowed        string = "default value is not allowed for this field type"
 	errDefaultValueInvalid           string = "default value is invalid"
 	errDefaultValueOneArg            string = "default value must specify one argument"
 	errFieldTypeNotSpecified         string = "field type not specified"
 	errInvalidTypeForContraint       string = "size constraint can only be applied to array fields"
+	errManyToManyFieldNameConflict   string = "the fields of a many-to-many relation must have distinct names, " +
+		"and may not be named `linked`"
+	errInvalidRelationDeleteAction string = "relation with invalid onDelete action"
+	errConstraintsInvalidArgument  string = "constraints with invalid argument"
+	errEnumArrayNotSupported       string = "array fields of enum types are not supported"
+	errComputedInvalidArgument     string = "computed with invalid argument"
 )
 
 var (
@@ -58,15 +65,21 @@ var (
 	ErrMultipleRelationPrimaries     = errors.New("relation can only have a single field set as primary")
 	// NonNull is the literal name of the GQL type, so we have to disable the linter
 	//nolint:revive
-	ErrNonNullNotSupported       = errors.New("NonNull fields are not currently supported")
-	ErrIndexMissingFields        = errors.New(errIndexMissingFields)
-	ErrIndexWithUnknownArg       = errors.New(errIndexUnknownArgument)
-	ErrIndexWithInvalidArg       = errors.New(errIndexInvalidArgument)
-	ErrPolicyWithUnknownArg      = errors.New(errPolicyUnknownArgument)
-	ErrPolicyInvalidIDProp       = errors.New(errPolicyInvalidIDProp)
-	ErrPolicyInvalidResourceProp = errors.New(errPolicyInvalidResourceProp)
-	ErrFieldTypeNotSpecified     = errors.New(errFieldTypeNotSpecified)
-	ErrInvalidTypeForContraint   = errors.New(errInvalidTypeForContraint)
+	ErrNonNullNotSupported         = errors.New("NonNull fields are not currently supported")
+	ErrIndexMissingFields          = errors.New(errIndexMissingFields)
+	ErrIndexWithUnknownArg         = errors.New(errIndexUnknownArgument)
+	ErrIndexWithInvalidArg         = errors.New(errIndexInvalidArgument)
+	ErrPolicyWithUnknownArg        = errors.New(errPolicyUnknownArgument)
+	ErrPolicyInvalidIDProp         = errors.New(errPolicyInvalidIDProp)
+	ErrPolicyInvalidResourceProp   = errors.New(errPolicyInvalidResourceProp)
+	ErrPolicyInvalidRelationsProp  = errors.New(errPolicyInvalidRelationsProp)
+	ErrFieldTypeNotSpecified       = errors.New(errFieldTypeNotSpecified)
+	ErrInvalidTypeForContraint     = errors.New(errInvalidTypeForContraint)
+	ErrManyToManyFieldNameConflict = errors.New(errManyToManyFieldNameConflict)
+	ErrInvalidRelationDeleteAction = errors.New(errInvalidRelationDeleteAction)
+	ErrConstraintsInvalidArgument  = errors.New(errConstraintsInvalidArgument)
+	ErrEnumArrayNotSupported       = errors.New(errEnumArrayNotSupported)
+	ErrComputedInvalidArgument     = errors.New(errComputedInvalidArgument)
 )
 
 func NewErrDuplicateField(objectName, fieldName string) error {
@@ -134,6 +147,14 @@ func NewErrTypeNotFound(typeName string) error {
 	)
 }
 
+func NewErrEnumArrayNotSupported(fieldName string, enumName string) error {
+	return errors.New(
+		errEnumArrayNotSupported,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Enum", enumName),
+	)
+}
+
 func NewErrNonNullForTypeNotSupported(typeName string) error {
 	return errors.New(
 		errNonNullForTypeNotSupported,
@@ -191,3 +212,32 @@ func NewErrFieldTypeNotSpecified(objectName, fieldName string) error {
 func NewErrInvalidTypeForContraint(actual client.FieldKind) error {
 	return errors.New(errInvalidTypeForContraint, errors.NewKV("Actual", actual.String()))
 }
+
+func NewErrManyToManyFieldNameConflict(relationName, fieldName, otherFieldName string) error {
+	return errors.New(
+		errManyToManyFieldNameConflict,
+		errors.NewKV("Relation", relationName),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("OtherField", otherFieldName),
+	)
+}
+
+func NewErrInvalidRelationDeleteAction(fieldName string, action any) error {
+	return errors.New(
+		errInvalidRelationDeleteAction,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Action", action),
+	)
+}
+
+func NewErrConstraintsInvalidArgument(argName string) error {
+	return errors.New(errConstraintsInvalidArgument, errors.NewKV("Argument", argName))
+}
+
+func NewErrComputedInvalidArgument(fieldName, argName string) error {
+	return errors.New(
+		errComputedInvalidArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
@@ -13,6 +13,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	gql "github.com/sourcenetwork/graphql-go"
@@ -92,6 +93,11 @@ func (g *Generator) Generate(ctx context.Context, collections []client.Collectio
 // generate generates the query-op and mutation-op type definitions from
 // the given CollectionVersions.
 func (g *Generator) generate(ctx context.Context, collections []client.CollectionDefinition) ([]*gql.Object, error) {
+	// build the enum types used by the collection fields
+	err := g.buildEnumTypes(collections)
+	if err != nil {
+		return nil, err
+	}
 	// build base types
 	defs, err := g.buildTypes(collections)
 	if err != nil {
@@ -434,6 +440,12 @@ func (g *Generator) buildTypes(
 	objs := make([]*gql.Object, 0)
 
 	for _, collection := range collections {
+		if collection.Version.IsJoin {
+			// The documents of join collections are managed through the many-to-many relation
+			// fields of the collections they link, they may not be queried directly.
+			continue
+		}
+
 		fieldDescriptions := collection.GetFields()
 		isQuerySource := len(collection.Version.QuerySources()) > 0
 		isViewObject := collection.Version.IsEmbeddedOnly || isQuerySource
@@ -488,16 +500,22 @@ func (g *Generator) buildTypes(
 						ttype = gql.NewList(ttype)
 					}
 				} else {
-					var ok bool
-					ttype, ok = fieldKindToGQLType[field.Kind]
-					if !ok {
-						return nil, NewErrTypeNotFound(field.Kind.String())
+					var err error
+					ttype, err = g.gqlTypeForFieldKind(field.Kind)
+					if err != nil {
+						return nil, err
 					}
 				}
 
+				var description string
+				if colField, ok := collection.Version.GetFieldByName(field.Name); ok {
+					description = constraintsDescription(colField.Constraints)
+				}
+
 				fields[field.Name] = &gql.Field{
-					Name: field.Name,
-					Type: ttype,
+					Name:        field.Name,
+					Description: description,
+					Type:        ttype,
 				}
 			}
 
@@ -540,12 +558,91 @@ func (g *Generator) buildTypes(
 	return objs, nil
 }
 
+// buildEnumTypes creates the enum types, and their filter operator blocks, for
+// the enum fields of the given collections.
+//
+// Enum values are represented by their ordinals, which are converted to and from
+// the value names by the generated types.
+func (g *Generator) buildEnumTypes(collections []client.CollectionDefinition) error {
+	typeMap := g.manager.schema.TypeMap()
+
+	for _, collection := range collections {
+		for _, field := range collection.GetFields() {
+			enumKind, ok := field.Kind.(*client.EnumKind)
+			if !ok {
+				continue
+			}
+
+			if existingType, ok := typeMap[enumKind.Name]; ok {
+				existingEnum, isEnum := existingType.(*gql.Enum)
+				if !isEnum || !enumTypeMatchesKind(existingEnum, enumKind) {
+					return NewErrSchemaTypeAlreadyExist(enumKind.Name)
+				}
+				continue
+			}
+
+			values := gql.EnumValueConfigMap{}
+			for i, value := range enumKind.Values {
+				values[value] = &gql.EnumValueConfig{Value: int64(i)}
+			}
+
+			enumType := gql.NewEnum(gql.EnumConfig{
+				Name:   enumKind.Name,
+				Values: values,
+			})
+			if enumType.Error() != nil {
+				return enumType.Error()
+			}
+			operatorBlock := schemaTypes.EnumOperatorBlock(enumType)
+
+			typeMap[enumType.Name()] = enumType
+			typeMap[operatorBlock.Name()] = operatorBlock
+		}
+	}
+
+	return nil
+}
+
+// enumTypeMatchesKind returns true if the given enum type holds exactly the values of the
+// given enum kind.
+func enumTypeMatchesKind(enumType *gql.Enum, kind *client.EnumKind) bool {
+	if len(enumType.Values()) != len(kind.Values) {
+		return false
+	}
+	for _, value := range enumType.Values() {
+		ordinal, ok := kind.Ordinal(value.Name)
+		if !ok || value.Value != ordinal {
+			return false
+		}
+	}
+	return true
+}
+
+// gqlTypeForFieldKind returns the GQL type of fields of the given, non-relation, kind.
+func (g *Generator) gqlTypeForFieldKind(kind client.FieldKind) (gql.Type, error) {
+	if enumKind, ok := kind.(*client.EnumKind); ok {
+		enumType, ok := g.manager.schema.TypeMap()[enumKind.Name]
+		if !ok {
+			return nil, NewErrTypeNotFound(enumKind.Name)
+		}
+		return enumType, nil
+	}
+
+	ttype, ok := fieldKindToGQLType[kind]
+	if !ok {
+		return nil, NewErrTypeNotFound(kind.String())
+	}
+	return ttype, nil
+}
+
 // buildMutationInputTypes creates the input object types
 // for collection create and update mutation operations.
 func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefinition) error {
+	definitionCache := client.NewDefinitionCache(collections)
+
 	for _, collection := range collections {
-		if collection.Version.IsEmbeddedOnly {
-			// Users cannot mutate documents through embedded collections, so we
+		if collection.Version.IsEmbeddedOnly || collection.Version.IsJoin {
+			// Users cannot mutate documents through embedded or join collections, so we
 			// have no need to build mutation input types for this collection.
 			continue
 		}
@@ -574,6 +671,23 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 					continue
 				}
 
+				if field.IsComputed {
+					// The values of computed fields are derived from the other fields
+					// of the document, they cannot be set by the user.
+					continue
+				}
+
+				if otherDef, ok := client.GetDefinition(definitionCache, collection, field.Kind); ok {
+					if _, ok := client.GetManyToManyRelationField(collection, field, otherDef); ok {
+						// Many-to-many relations may be mutated from either side, documents are
+						// linked and unlinked through the relation field.
+						fields[field.Name] = &gql.InputObjectFieldConfig{
+							Type: g.manager.schema.TypeMap()[schemaTypes.RelationLinkInputName],
+						}
+						continue
+					}
+				}
+
 				if field.Kind == client.FieldKind_DocID && strings.HasSuffix(field.Name, request.RelatedObjectID) {
 					objFieldName := strings.TrimSuffix(field.Name, request.RelatedObjectID)
 					ofd, exists := collection.GetFieldByName(objFieldName)
@@ -596,10 +710,10 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 						ttype = gql.ID
 					}
 				} else {
-					var ok bool
-					ttype, ok = fieldKindToGQLType[field.Kind]
-					if !ok {
-						return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
+					var err error
+					ttype, err = g.gqlTypeForFieldKind(field.Kind)
+					if err != nil {
+						return nil, err
 					}
 				}
 
@@ -1496,3 +1610,70 @@ resp := db.queryEngine.Execute(ctx, q, qplan)
 
 
 */
+
+// constraintsDescription returns the given field constraints as they would be declared using
+// the @constraints directive, so that they may be discovered through introspection.
+//
+// An empty string is returned if no constraint has been set.
+func constraintsDescription(constraints client.FieldConstraints) string {
+	if constraints.IsEmpty() {
+		return ""
+	}
+
+	args := []string{}
+	if constraints.Min.HasValue() {
+		args = append(args, fmt.Sprintf(
+			"%s: %s",
+			schemaTypes.ConstraintsDirectivePropMin,
+			strconv.FormatFloat(constraints.Min.Value(), 'g', -1, 64),
+		))
+	}
+	if constraints.Max.HasValue() {
+		args = append(args, fmt.Sprintf(
+			"%s: %s",
+			schemaTypes.ConstraintsDirectivePropMax,
+			strconv.FormatFloat(constraints.Max.Value(), 'g', -1, 64),
+		))
+	}
+	if constraints.MinLength.HasValue() {
+		args = append(args, fmt.Sprintf(
+			"%s: %d",
+			schemaTypes.ConstraintsDirectivePropMinLength,
+			constraints.MinLength.Value(),
+		))
+	}
+	if constraints.MaxLength.HasValue() {
+		args = append(args, fmt.Sprintf(
+			"%s: %d",
+			schemaTypes.ConstraintsDirectivePropMaxLength,
+			constraints.MaxLength.Value(),
+		))
+	}
+	if constraints.Pattern.HasValue() {
+		args = append(args, fmt.Sprintf(
+			"%s: %s",
+			schemaTypes.ConstraintsDirectivePropPattern,
+			strconv.Quote(constraints.Pattern.Value()),
+		))
+	}
+	if len(constraints.OneOf) > 0 {
+		values := make([]string, len(constraints.OneOf))
+		for i, value := range constraints.OneOf {
+			if str, ok := value.(string); ok {
+				values[i] = strconv.Quote(str)
+			} else {
+				values[i] = fmt.Sprint(value)
+			}
+		}
+		args = append(args, fmt.Sprintf(
+			"%s: [%s]",
+			schemaTypes.ConstraintsDirectivePropOneOf,
+			strings.Join(values, ", "),
+		))
+	}
+	if constraints.NotNull {
+		args = append(args, fmt.Sprintf("%s: true", schemaTypes.ConstraintsDirectivePropNotNull))
+	}
+
+	return fmt.Sprintf("@%s(%s)", schemaTypes.ConstraintsDirectiveLabel, strings.Join(args, ", "))
+}
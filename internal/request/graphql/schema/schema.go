@@ -21,12 +21,15 @@ func defaultSchema() (gql.Schema, error) {
 	orderEnum := types.OrderingEnum()
 	crdtEnum := types.CRDTEnum()
 	explainEnum := types.ExplainEnum()
+	relationDeleteActionEnum := types.RelationDeleteActionEnum()
 
 	commitLinkObject := types.CommitLinkObject()
 	commitObject := types.CommitObject(commitLinkObject)
 	commitsOrderArg := types.CommitsOrderArg(orderEnum)
 
 	indexFieldInput := types.IndexFieldInputObject(orderEnum)
+	policyRelationInput := types.PolicyRelationInputObject()
+	relationLinkInput := types.RelationLinkInputObject()
 
 	return gql.NewSchema(gql.SchemaConfig{
 		Types: defaultTypes(
@@ -36,11 +39,21 @@ func defaultSchema() (gql.Schema, error) {
 			orderEnum,
 			crdtEnum,
 			explainEnum,
+			relationDeleteActionEnum,
 			indexFieldInput,
+			policyRelationInput,
+			relationLinkInput,
+		),
+		Query:    defaultQueryType(commitObject, commitsOrderArg),
+		Mutation: defaultMutationType(),
+		Directives: defaultDirectivesType(
+			crdtEnum,
+			explainEnum,
+			orderEnum,
+			relationDeleteActionEnum,
+			indexFieldInput,
+			policyRelationInput,
 		),
-		Query:        defaultQueryType(commitObject, commitsOrderArg),
-		Mutation:     defaultMutationType(),
-		Directives:   defaultDirectivesType(crdtEnum, explainEnum, orderEnum, indexFieldInput),
 		Subscription: defaultSubscriptionType(),
 	})
 }
@@ -94,20 +107,24 @@ func defaultDirectivesType(
 	crdtEnum *gql.Enum,
 	explainEnum *gql.Enum,
 	orderEnum *gql.Enum,
+	relationDeleteActionEnum *gql.Enum,
 	indexFieldInput *gql.InputObject,
+	policyRelationInput *gql.InputObject,
 ) []*gql.Directive {
 	return []*gql.Directive{
 		types.CRDTFieldDirective(crdtEnum),
 		types.DefaultDirective(),
 		types.ExplainDirective(explainEnum),
-		types.PolicyDirective(),
+		types.PolicyDirective(policyRelationInput),
 		types.IndexDirective(orderEnum, indexFieldInput),
 		types.PrimaryDirective(),
-		types.RelationDirective(),
+		types.RelationDirective(relationDeleteActionEnum),
 		types.MaterializedDirective(),
 		types.BranchableDirective(),
 		types.VectorEmbeddingDirective(),
 		types.ConstraintsDirective(),
+		types.EncryptedDirective(),
+		types.ComputedDirective(),
 	}
 }
 
@@ -134,7 +151,10 @@ func defaultTypes(
 	orderEnum *gql.Enum,
 	crdtEnum *gql.Enum,
 	explainEnum *gql.Enum,
+	relationDeleteActionEnum *gql.Enum,
 	indexFieldInput *gql.InputObject,
+	policyRelationInput *gql.InputObject,
+	relationLinkInput *gql.InputObject,
 ) []gql.Type {
 	blobScalarType := types.BlobScalarType()
 	jsonScalarType := types.JSONScalarType()
@@ -213,7 +233,10 @@ func defaultTypes(
 
 		crdtEnum,
 		explainEnum,
+		relationDeleteActionEnum,
 
 		indexFieldInput,
+		policyRelationInput,
+		relationLinkInput,
 	}
 }
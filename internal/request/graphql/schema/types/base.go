@@ -11,6 +11,8 @@
 package types
 
 import (
+	"fmt"
+
 	gql "github.com/sourcenetwork/graphql-go"
 )
 
@@ -772,3 +774,50 @@ func IDOperatorBlock() *gql.InputObject {
 		},
 	})
 }
+
+// EnumOperatorBlock filter block for fields of the given enum type.
+//
+// Enum values are ordered by their declaration order.
+func EnumOperatorBlock(enumType *gql.Enum) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name: enumType.Name() + "OperatorBlock",
+		Description: fmt.Sprintf(
+			"These are the set of filter operators available for use when filtering on %s values.",
+			enumType.Name(),
+		),
+		Fields: gql.InputObjectConfigFieldMap{
+			"_eq": &gql.InputObjectFieldConfig{
+				Description: eqOperatorDescription,
+				Type:        enumType,
+			},
+			"_ne": &gql.InputObjectFieldConfig{
+				Description: neOperatorDescription,
+				Type:        enumType,
+			},
+			"_gt": &gql.InputObjectFieldConfig{
+				Description: gtOperatorDescription,
+				Type:        enumType,
+			},
+			"_ge": &gql.InputObjectFieldConfig{
+				Description: geOperatorDescription,
+				Type:        enumType,
+			},
+			"_lt": &gql.InputObjectFieldConfig{
+				Description: ltOperatorDescription,
+				Type:        enumType,
+			},
+			"_le": &gql.InputObjectFieldConfig{
+				Description: leOperatorDescription,
+				Type:        enumType,
+			},
+			"_in": &gql.InputObjectFieldConfig{
+				Description: inOperatorDescription,
+				Type:        gql.NewList(enumType),
+			},
+			"_nin": &gql.InputObjectFieldConfig{
+				Description: ninOperatorDescription,
+				Type:        gql.NewList(enumType),
+			},
+		},
+	})
+}
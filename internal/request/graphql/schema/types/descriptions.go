@@ -261,6 +261,24 @@ Allows the explicit definition of a field's CRDT type. By default it is defined
 `
 	constraintsDirectiveDescription string = `
 Set constrains on a field.`
+	encryptedDirectiveDescription string = `
+Indicate that the documents of a collection, or the values of a field, are always encrypted.
+ When used on an object, documents are created as if the 'encrypt' argument was given.
+ When used on a field, documents are created as if the field was given in the 'encryptFields'
+ argument. Encrypted fields may not be indexed.
+`
+	computedDirectiveDescription string = `
+Indicate that the value of a field is computed from the other fields of the document using the
+ given expression. Computed fields may not be set directly.
+`
+	computedDirectiveExprArgDescription string = `
+The expression computing the value of the field, for example "concat(first, ' ', last)" or
+ "qty * price".
+`
+	computedDirectiveStoredArgDescription string = `
+Whether the value is computed when the document is written and stored with it, or computed each
+ time the document is read. Defaults to true, only stored computed fields may be indexed.
+`
 	embeddingDirectiveDescription string = `
 Indicate that a [float!] type is used to store embeddings.
 `
@@ -273,5 +291,25 @@ Allows the explicit definition of relationship attributes instead of using the s
 `
 	relationDirectiveNameArgDescription string = `
 Explicitly define the name of the relationship instead of using the system generated defaults.
+`
+	relationDirectiveOnDeleteArgDescription string = `
+Define what happens to the documents referencing a document through this field when the
+ referenced document is deleted. May only be set on the primary side of a relation.
+`
+	relationDeleteActionEnumDescription string = `
+One of the possible actions applied to the documents referencing a deleted document.
+`
+	relationDeleteCascadeDescription string = `
+Delete the referencing documents along with the referenced document.
+`
+	relationDeleteRestrictDescription string = `
+Prevent the deletion of a document that is referenced by another document.
+`
+	relationDeleteSetNullDescription string = `
+Clear the reference held by the referencing documents.
+`
+	relationLinkInputDescription string = `
+Used to link and unlink documents through a many-to-many relation field. Links are added
+ before they are removed, so an ID given to both 'link' and 'unlink' ends up unlinked.
 `
 )
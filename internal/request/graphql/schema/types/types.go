@@ -14,6 +14,7 @@ import (
 	gql "github.com/sourcenetwork/graphql-go"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 )
 
 const (
@@ -26,11 +27,25 @@ const (
 	ExplainArgExecute  string = "execute"
 	ExplainArgDebug    string = "debug"
 
+	RelationDirectivePropName     = "name"
+	RelationDirectivePropOnDelete = "onDelete"
+
 	CRDTDirectiveLabel    = "crdt"
 	CRDTDirectivePropType = "type"
 
-	ConstraintsDirectiveLabel    = "constraints"
-	ConstraintsDirectivePropSize = "size"
+	ConstraintsDirectiveLabel         = "constraints"
+	ConstraintsDirectivePropSize      = "size"
+	ConstraintsDirectivePropMin       = "min"
+	ConstraintsDirectivePropMax       = "max"
+	ConstraintsDirectivePropMinLength = "minLength"
+	ConstraintsDirectivePropMaxLength = "maxLength"
+	ConstraintsDirectivePropPattern   = "pattern"
+	ConstraintsDirectivePropOneOf     = "oneOf"
+	ConstraintsDirectivePropNotNull   = "notNull"
+
+	ComputedDirectiveLabel      = "computed"
+	ComputedDirectivePropExpr   = "expr"
+	ComputedDirectivePropStored = "stored"
 
 	VectorEmbeddingDirectiveLabel        = "embedding"
 	VectorEmbeddingDirectivePropProvider = "provider"
@@ -39,13 +54,20 @@ const (
 	VectorEmbeddingDirectivePropFields   = "fields"
 	VectorEmbeddingDirectivePropTemplate = "template"
 
-	PolicySchemaDirectiveLabel        = "policy"
-	PolicySchemaDirectivePropID       = "id"
-	PolicySchemaDirectivePropResource = "resource"
+	PolicySchemaDirectiveLabel         = "policy"
+	PolicySchemaDirectivePropID        = "id"
+	PolicySchemaDirectivePropResource  = "resource"
+	PolicySchemaDirectivePropRelations = "relations"
+
+	RelationLinkInputName = "RelationLinkInput"
+
+	PolicyRelationPropField    = "field"
+	PolicyRelationPropRelation = "relation"
 
 	IndexDirectiveLabel         = "index"
 	IndexDirectivePropName      = "name"
 	IndexDirectivePropUnique    = "unique"
+	IndexDirectivePropBlind     = "blind"
 	IndexDirectivePropDirection = "direction"
 	IndexDirectivePropIncludes  = "includes"
 
@@ -63,12 +85,15 @@ const (
 	DefaultDirectivePropJSON     = "json"
 	DefaultDirectivePropBlob     = "blob"
 
-	MaterializedDirectiveLabel  = "materialized"
-	MaterializedDirectivePropIf = "if"
+	MaterializedDirectiveLabel           = "materialized"
+	MaterializedDirectivePropIf          = "if"
+	MaterializedDirectivePropAutoRefresh = "autoRefresh"
 
 	BranchableDirectiveLabel  = "branchable"
 	BranchableDirectivePropIf = "if"
 
+	EncryptedDirectiveLabel = "encrypted"
+
 	FieldOrderASC  = "ASC"
 	FieldOrderDESC = "DESC"
 
@@ -175,7 +200,41 @@ func ExplainDirective(explainEnum *gql.Enum) *gql.Directive {
 	})
 }
 
-func PolicyDirective() *gql.Directive {
+func PolicyRelationInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        "PolicyRelation",
+		Description: "Used to derive a relation of the policy resource from a field.",
+		Fields: gql.InputObjectConfigFieldMap{
+			PolicyRelationPropField: &gql.InputObjectFieldConfig{
+				Type: gql.String,
+			},
+			PolicyRelationPropRelation: &gql.InputObjectFieldConfig{
+				Type: gql.String,
+			},
+		},
+	})
+}
+
+// RelationLinkInputObject returns the input object used to link and unlink documents through
+// a many-to-many relation field in create and update mutations.
+func RelationLinkInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        RelationLinkInputName,
+		Description: relationLinkInputDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			request.LinkInput: &gql.InputObjectFieldConfig{
+				Description: "The IDs of the documents to link to the mutated document.",
+				Type:        gql.NewList(gql.NewNonNull(gql.ID)),
+			},
+			request.UnlinkInput: &gql.InputObjectFieldConfig{
+				Description: "The IDs of the documents to unlink from the mutated document.",
+				Type:        gql.NewList(gql.NewNonNull(gql.ID)),
+			},
+		},
+	})
+}
+
+func PolicyDirective(policyRelationInputObject *gql.InputObject) *gql.Directive {
 	return gql.NewDirective(gql.DirectiveConfig{
 		Name:        PolicySchemaDirectiveLabel,
 		Description: "@policy is a directive that can be used to link a policy on a collection type.",
@@ -186,6 +245,13 @@ func PolicyDirective() *gql.Directive {
 			PolicySchemaDirectivePropResource: &gql.ArgumentConfig{
 				Type: gql.String,
 			},
+			PolicySchemaDirectivePropRelations: &gql.ArgumentConfig{
+				Description: `Sets the relations that are derived from fields.
+
+	The actors (DIDs) held in the field of a document are given the relation
+	with the document, and kept in sync as the document changes.`,
+				Type: gql.NewList(policyRelationInputObject),
+			},
 		},
 		Locations: []string{
 			gql.DirectiveLocationObject,
@@ -221,6 +287,13 @@ func IndexDirective(orderingEnum *gql.Enum, indexFieldInputObject *gql.InputObje
 				Description: "Makes the index unique.",
 				Type:        gql.Boolean,
 			},
+			IndexDirectivePropBlind: &gql.ArgumentConfig{
+				Description: `Makes the index a blind index.
+
+	A blind index stores a keyed hash of the values instead of the values themselves,
+	so that encrypted fields can be filtered by equality.`,
+				Type: gql.Boolean,
+			},
 			IndexDirectivePropDirection: &gql.ArgumentConfig{
 				Description: `Sets the default index ordering for all fields.
 				
@@ -248,11 +321,16 @@ func MaterializedDirective() *gql.Directive {
 		Name: MaterializedDirectiveLabel,
 		Description: `@materialized is a directive that specifies whether a collection is cached or not.
  It will default to true if ommited.  If multiple @materialized directives are provided, they will aggregated
- with OR logic (if any are true, the collection will be cached).`,
+ with OR logic (if any are true, the collection will be cached).  If autoRefresh is true, the cache
+ will be kept up to date automatically as the source documents change, on behalf of the node identity.
+ Views with a policy may not be auto refreshed.`,
 		Args: gql.FieldConfigArgument{
 			MaterializedDirectivePropIf: &gql.ArgumentConfig{
 				Type: gql.Boolean,
 			},
+			MaterializedDirectivePropAutoRefresh: &gql.ArgumentConfig{
+				Type: gql.Boolean,
+			},
 		},
 		Locations: []string{
 			gql.DirectiveLocationObject,
@@ -345,6 +423,68 @@ func ConstraintsDirective() *gql.Directive {
 				Type:        gql.Int,
 				Description: "The size constraint for array fields.",
 			},
+			ConstraintsDirectivePropMin: &gql.ArgumentConfig{
+				Type:        gql.Float,
+				Description: "The minimum, inclusive, value of numeric fields.",
+			},
+			ConstraintsDirectivePropMax: &gql.ArgumentConfig{
+				Type:        gql.Float,
+				Description: "The maximum, inclusive, value of numeric fields.",
+			},
+			ConstraintsDirectivePropMinLength: &gql.ArgumentConfig{
+				Type:        gql.Int,
+				Description: "The minimum length of string fields.",
+			},
+			ConstraintsDirectivePropMaxLength: &gql.ArgumentConfig{
+				Type:        gql.Int,
+				Description: "The maximum length of string fields.",
+			},
+			ConstraintsDirectivePropPattern: &gql.ArgumentConfig{
+				Type:        gql.String,
+				Description: "A regular expression that the values of string fields must match.",
+			},
+			ConstraintsDirectivePropOneOf: &gql.ArgumentConfig{
+				Type:        gql.NewList(gql.NewNonNull(JSONScalarType())),
+				Description: "The values that string or integer fields may hold.",
+			},
+			ConstraintsDirectivePropNotNull: &gql.ArgumentConfig{
+				Type:        gql.Boolean,
+				Description: "Whether the field must always hold a value.",
+			},
+		},
+	})
+}
+
+// EncryptedDirective @encrypted is used to define the encryption of a collection or of a field.
+func EncryptedDirective() *gql.Directive {
+	return gql.NewDirective(gql.DirectiveConfig{
+		Name:        EncryptedDirectiveLabel,
+		Description: encryptedDirectiveDescription,
+		Locations: []string{
+			gql.DirectiveLocationObject,
+			gql.DirectiveLocationFieldDefinition,
+		},
+	})
+}
+
+// ComputedDirective @computed is used to define a field computed from the other fields of the document.
+func ComputedDirective() *gql.Directive {
+	return gql.NewDirective(gql.DirectiveConfig{
+		Name:        ComputedDirectiveLabel,
+		Description: computedDirectiveDescription,
+		Locations: []string{
+			gql.DirectiveLocationFieldDefinition,
+		},
+		Args: gql.FieldConfigArgument{
+			ComputedDirectivePropExpr: &gql.ArgumentConfig{
+				Type:        gql.NewNonNull(gql.String),
+				Description: computedDirectiveExprArgDescription,
+			},
+			ComputedDirectivePropStored: &gql.ArgumentConfig{
+				Type:         gql.Boolean,
+				Description:  computedDirectiveStoredArgDescription,
+				DefaultValue: true,
+			},
 		},
 	})
 }
@@ -394,19 +534,46 @@ func PrimaryDirective() *gql.Directive {
 	})
 }
 
+// RelationDeleteActionEnum is an enum for the onDelete argument of the @relation directive.
+func RelationDeleteActionEnum() *gql.Enum {
+	return gql.NewEnum(gql.EnumConfig{
+		Name:        "RelationDeleteAction",
+		Description: relationDeleteActionEnumDescription,
+		Values: gql.EnumValueConfigMap{
+			client.RelationDeleteCascade.String(): &gql.EnumValueConfig{
+				Value:       client.RelationDeleteCascade,
+				Description: relationDeleteCascadeDescription,
+			},
+			client.RelationDeleteRestrict.String(): &gql.EnumValueConfig{
+				Value:       client.RelationDeleteRestrict,
+				Description: relationDeleteRestrictDescription,
+			},
+			client.RelationDeleteSetNull.String(): &gql.EnumValueConfig{
+				Value:       client.RelationDeleteSetNull,
+				Description: relationDeleteSetNullDescription,
+			},
+		},
+	})
+}
+
 // RelationDirective @relation is used to explicitly define
 // the attributes of a relationship, specifically, the name
 // if you don't want to use the default generated relationship
-// name.
-func RelationDirective() *gql.Directive {
+// name, and what happens to the documents referencing a
+// deleted document.
+func RelationDirective(relationDeleteActionEnum *gql.Enum) *gql.Directive {
 	return gql.NewDirective(gql.DirectiveConfig{
 		Name:        RelationLabel,
 		Description: relationDirectiveDescription,
 		Args: gql.FieldConfigArgument{
-			"name": &gql.ArgumentConfig{
+			RelationDirectivePropName: &gql.ArgumentConfig{
 				Description: relationDirectiveNameArgDescription,
 				Type:        gql.String,
 			},
+			RelationDirectivePropOnDelete: &gql.ArgumentConfig{
+				Description: relationDirectiveOnDeleteArgDescription,
+				Type:        relationDeleteActionEnum,
+			},
 		},
 		Locations: []string{
 			gql.DirectiveLocationFieldDefinition,
@@ -39,34 +39,37 @@ func NewClient(n *node.Node) *Client {
 
 func (c *Client) JSValue() js.Value {
 	return js.ValueOf(map[string]any{
-		"addSchema":                  goji.Async(c.addSchema),
-		"patchSchema":                goji.Async(c.patchSchema),
-		"patchCollection":            goji.Async(c.patchCollection),
-		"setActiveSchemaVersion":     goji.Async(c.setActiveSchemaVersion),
-		"addView":                    goji.Async(c.addView),
-		"refreshViews":               goji.Async(c.refreshViews),
-		"setMigration":               goji.Async(c.setMigration),
-		"lensRegistry":               goji.Async(c.lensRegistry),
-		"getCollectionByName":        goji.Async(c.getCollectionByName),
-		"getCollections":             goji.Async(c.getCollections),
-		"getSchemaByVersionID":       goji.Async(c.getSchemaByVersionID),
-		"getSchemas":                 goji.Async(c.getSchemas),
-		"getAllIndexes":              goji.Async(c.getAllIndexes),
-		"execRequest":                goji.Async(c.execRequest),
-		"addDACPolicy":               goji.Async(c.addDACPolicy),
-		"verifyDACAccess":            goji.Async(c.verifyDACAccess),
-		"addDACActorRelationship":    goji.Async(c.addDACActorRelationship),
-		"deleteDACActorRelationship": goji.Async(c.deleteDACActorRelationship),
-		"getNACStatus":               goji.Async(c.getNACStatus),
-		"reEnableNAC":                goji.Async(c.reEnableNAC),
-		"disableNAC":                 goji.Async(c.disableNAC),
-		"addNACActorRelationship":    goji.Async(c.addNACActorRelationship),
-		"deleteNACActorRelationship": goji.Async(c.deleteNACActorRelationship),
-		"getNodeIdentity":            goji.Async(c.getNodeIdentity),
-		"newTxn":                     goji.Async(c.newTxn),
-		"newConcurrentTxn":           goji.Async(c.newConcurrentTxn),
-		"verifySignature":            goji.Async(c.verifySignature),
-		"close":                      goji.Async(c.close),
+		"addSchema":                   goji.Async(c.addSchema),
+		"patchSchema":                 goji.Async(c.patchSchema),
+		"patchCollection":             goji.Async(c.patchCollection),
+		"setActiveSchemaVersion":      goji.Async(c.setActiveSchemaVersion),
+		"addView":                     goji.Async(c.addView),
+		"refreshViews":                goji.Async(c.refreshViews),
+		"setMigration":                goji.Async(c.setMigration),
+		"lensRegistry":                goji.Async(c.lensRegistry),
+		"getCollectionByName":         goji.Async(c.getCollectionByName),
+		"getCollections":              goji.Async(c.getCollections),
+		"getSchemaByVersionID":        goji.Async(c.getSchemaByVersionID),
+		"getSchemas":                  goji.Async(c.getSchemas),
+		"getAllIndexes":               goji.Async(c.getAllIndexes),
+		"execRequest":                 goji.Async(c.execRequest),
+		"addDACPolicy":                goji.Async(c.addDACPolicy),
+		"verifyDACAccess":             goji.Async(c.verifyDACAccess),
+		"addDACActorRelationship":     goji.Async(c.addDACActorRelationship),
+		"deleteDACActorRelationship":  goji.Async(c.deleteDACActorRelationship),
+		"addDACActorRelationships":    goji.Async(c.addDACActorRelationships),
+		"deleteDACActorRelationships": goji.Async(c.deleteDACActorRelationships),
+		"getACPAuditLog":              goji.Async(c.getACPAuditLog),
+		"getNACStatus":                goji.Async(c.getNACStatus),
+		"reEnableNAC":                 goji.Async(c.reEnableNAC),
+		"disableNAC":                  goji.Async(c.disableNAC),
+		"addNACActorRelationship":     goji.Async(c.addNACActorRelationship),
+		"deleteNACActorRelationship":  goji.Async(c.deleteNACActorRelationship),
+		"getNodeIdentity":             goji.Async(c.getNodeIdentity),
+		"newTxn":                      goji.Async(c.newTxn),
+		"newConcurrentTxn":            goji.Async(c.newConcurrentTxn),
+		"verifySignature":             goji.Async(c.verifySignature),
+		"close":                       goji.Async(c.close),
 	})
 }
 
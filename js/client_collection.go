@@ -31,24 +31,26 @@ func newCollection(col client.Collection, txns *sync.Map) js.Value {
 		txns: txns,
 	}
 	return js.ValueOf(map[string]any{
-		"name":             goji.Async(c.name),
-		"versionID":        goji.Async(c.versionID),
-		"version":          goji.Async(c.version),
-		"schemaRoot":       goji.Async(c.schemaRoot),
-		"definition":       goji.Async(c.definition),
-		"schema":           goji.Async(c.schema),
-		"create":           goji.Async(c.create),
-		"createMany":       goji.Async(c.createMany),
-		"update":           goji.Async(c.update),
-		"delete":           goji.Async(c.delete),
-		"exists":           goji.Async(c.exists),
-		"updateWithFilter": goji.Async(c.updateWithFilter),
-		"deleteWithFilter": goji.Async(c.deleteWithFilter),
-		"get":              goji.Async(c.get),
-		"getAllDocIDs":     goji.Async(c.getAllDocIDs),
-		"createIndex":      goji.Async(c.createIndex),
-		"dropIndex":        goji.Async(c.dropIndex),
-		"getIndexes":       goji.Async(c.getIndexes),
+		"name":                goji.Async(c.name),
+		"versionID":           goji.Async(c.versionID),
+		"version":             goji.Async(c.version),
+		"schemaRoot":          goji.Async(c.schemaRoot),
+		"definition":          goji.Async(c.definition),
+		"schema":              goji.Async(c.schema),
+		"create":              goji.Async(c.create),
+		"createMany":          goji.Async(c.createMany),
+		"update":              goji.Async(c.update),
+		"delete":              goji.Async(c.delete),
+		"exists":              goji.Async(c.exists),
+		"rotateEncryptionKey": goji.Async(c.rotateEncryptionKey),
+		"migrateDocuments":    goji.Async(c.migrateDocuments),
+		"updateWithFilter":    goji.Async(c.updateWithFilter),
+		"deleteWithFilter":    goji.Async(c.deleteWithFilter),
+		"get":                 goji.Async(c.get),
+		"getAllDocIDs":        goji.Async(c.getAllDocIDs),
+		"createIndex":         goji.Async(c.createIndex),
+		"dropIndex":           goji.Async(c.dropIndex),
+		"getIndexes":          goji.Async(c.getIndexes),
 	})
 }
 
@@ -211,6 +213,48 @@ func (c *clientCollection) exists(this js.Value, args []js.Value) (js.Value, err
 	return js.ValueOf(exists), nil
 }
 
+func (c *clientCollection) rotateEncryptionKey(this js.Value, args []js.Value) (js.Value, error) {
+	docIDString, err := stringArg(args, 0, "docID")
+	if err != nil {
+		return js.Undefined(), err
+	}
+	var rotationOptions client.EncryptionKeyRotationOptions
+	if err := structArg(args, 1, "options", &rotationOptions); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 2, c.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	docID, err := client.NewDocIDFromString(docIDString)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	err = c.col.RotateEncryptionKey(
+		ctx,
+		docID,
+		client.RotateKeyOfFields(rotationOptions.Fields),
+		client.RotateKeyWithReEncryption(rotationOptions.ReEncrypt),
+	)
+	return js.Undefined(), err
+}
+
+func (c *clientCollection) migrateDocuments(this js.Value, args []js.Value) (js.Value, error) {
+	batchSize, err := intArg(args, 0, "batchSize")
+	if err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, c.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	progress, err := c.col.MigrateDocuments(ctx, batchSize)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(progress)
+}
+
 func (c *clientCollection) updateWithFilter(this js.Value, args []js.Value) (js.Value, error) {
 	filter, err := stringArg(args, 0, "filter")
 	if err != nil {
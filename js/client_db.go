@@ -18,6 +18,7 @@ import (
 	"syscall/js"
 
 	acpTypes "github.com/sourcenetwork/defradb/acp/types"
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/goji"
 )
@@ -94,6 +95,54 @@ func (c *Client) deleteDACActorRelationship(this js.Value, args []js.Value) (js.
 	return goji.MarshalJS(res)
 }
 
+func (c *Client) addDACActorRelationships(this js.Value, args []js.Value) (js.Value, error) {
+	var batch client.DACActorRelationshipBatch
+	if err := structArg(args, 0, "batch", &batch); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, c.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	res, err := c.node.DB.AddDACActorRelationships(ctx, batch)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(res)
+}
+
+func (c *Client) deleteDACActorRelationships(this js.Value, args []js.Value) (js.Value, error) {
+	var batch client.DACActorRelationshipBatch
+	if err := structArg(args, 0, "batch", &batch); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, c.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	res, err := c.node.DB.DeleteDACActorRelationships(ctx, batch)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(res)
+}
+
+func (c *Client) getACPAuditLog(this js.Value, args []js.Value) (js.Value, error) {
+	var query client.ACPAuditLogQuery
+	if err := structArg(args, 0, "query", &query); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, c.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	res, err := c.node.DB.GetACPAuditLog(ctx, query)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(res)
+}
+
 func (c *Client) verifyDACAccess(this js.Value, args []js.Value) (js.Value, error) {
 	permission, err := stringArg(args, 0, "permission")
 	if err != nil {
@@ -35,33 +35,36 @@ type transaction struct {
 func newTransaction(txn client.Txn, txns *sync.Map) js.Value {
 	wrapper := &transaction{txn, txns}
 	return js.ValueOf(map[string]any{
-		"id":                         txn.ID(),
-		"commit":                     goji.Async(wrapper.commit),
-		"discard":                    goji.Async(wrapper.discard),
-		"addSchema":                  goji.Async(wrapper.addSchema),
-		"patchSchema":                goji.Async(wrapper.patchSchema),
-		"patchCollection":            goji.Async(wrapper.patchCollection),
-		"setActiveSchemaVersion":     goji.Async(wrapper.setActiveSchemaVersion),
-		"addView":                    goji.Async(wrapper.addView),
-		"refreshViews":               goji.Async(wrapper.refreshViews),
-		"setMigration":               goji.Async(wrapper.setMigration),
-		"lensRegistry":               goji.Async(wrapper.lensRegistry),
-		"getCollectionByName":        goji.Async(wrapper.getCollectionByName),
-		"getCollections":             goji.Async(wrapper.getCollections),
-		"getSchemaByVersionID":       goji.Async(wrapper.getSchemaByVersionID),
-		"getSchemas":                 goji.Async(wrapper.getSchemas),
-		"getAllIndexes":              goji.Async(wrapper.getAllIndexes),
-		"execRequest":                goji.Async(wrapper.execRequest),
-		"addDACPolicy":               goji.Async(wrapper.addDACPolicy),
-		"addDACActorRelationship":    goji.Async(wrapper.addDACActorRelationship),
-		"deleteDACActorRelationship": goji.Async(wrapper.deleteDACActorRelationship),
-		"getNACStatus":               goji.Async(wrapper.getNACStatus),
-		"reEnableNAC":                goji.Async(wrapper.reEnableNAC),
-		"disableNAC":                 goji.Async(wrapper.disableNAC),
-		"addNACActorRelationship":    goji.Async(wrapper.addNACActorRelationship),
-		"deleteNACActorRelationship": goji.Async(wrapper.deleteNACActorRelationship),
-		"getNodeIdentity":            goji.Async(wrapper.getNodeIdentity),
-		"verifySignature":            goji.Async(wrapper.verifySignature),
+		"id":                          txn.ID(),
+		"commit":                      goji.Async(wrapper.commit),
+		"discard":                     goji.Async(wrapper.discard),
+		"addSchema":                   goji.Async(wrapper.addSchema),
+		"patchSchema":                 goji.Async(wrapper.patchSchema),
+		"patchCollection":             goji.Async(wrapper.patchCollection),
+		"setActiveSchemaVersion":      goji.Async(wrapper.setActiveSchemaVersion),
+		"addView":                     goji.Async(wrapper.addView),
+		"refreshViews":                goji.Async(wrapper.refreshViews),
+		"setMigration":                goji.Async(wrapper.setMigration),
+		"lensRegistry":                goji.Async(wrapper.lensRegistry),
+		"getCollectionByName":         goji.Async(wrapper.getCollectionByName),
+		"getCollections":              goji.Async(wrapper.getCollections),
+		"getSchemaByVersionID":        goji.Async(wrapper.getSchemaByVersionID),
+		"getSchemas":                  goji.Async(wrapper.getSchemas),
+		"getAllIndexes":               goji.Async(wrapper.getAllIndexes),
+		"execRequest":                 goji.Async(wrapper.execRequest),
+		"addDACPolicy":                goji.Async(wrapper.addDACPolicy),
+		"addDACActorRelationship":     goji.Async(wrapper.addDACActorRelationship),
+		"deleteDACActorRelationship":  goji.Async(wrapper.deleteDACActorRelationship),
+		"addDACActorRelationships":    goji.Async(wrapper.addDACActorRelationships),
+		"deleteDACActorRelationships": goji.Async(wrapper.deleteDACActorRelationships),
+		"getACPAuditLog":              goji.Async(wrapper.getACPAuditLog),
+		"getNACStatus":                goji.Async(wrapper.getNACStatus),
+		"reEnableNAC":                 goji.Async(wrapper.reEnableNAC),
+		"disableNAC":                  goji.Async(wrapper.disableNAC),
+		"addNACActorRelationship":     goji.Async(wrapper.addNACActorRelationship),
+		"deleteNACActorRelationship":  goji.Async(wrapper.deleteNACActorRelationship),
+		"getNodeIdentity":             goji.Async(wrapper.getNodeIdentity),
+		"verifySignature":             goji.Async(wrapper.verifySignature),
 	})
 }
 
@@ -382,6 +385,54 @@ func (t *transaction) deleteDACActorRelationship(this js.Value, args []js.Value)
 	return goji.MarshalJS(res)
 }
 
+func (t *transaction) addDACActorRelationships(this js.Value, args []js.Value) (js.Value, error) {
+	var batch client.DACActorRelationshipBatch
+	if err := structArg(args, 0, "batch", &batch); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, t.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	res, err := t.txn.AddDACActorRelationships(ctx, batch)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(res)
+}
+
+func (t *transaction) deleteDACActorRelationships(this js.Value, args []js.Value) (js.Value, error) {
+	var batch client.DACActorRelationshipBatch
+	if err := structArg(args, 0, "batch", &batch); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, t.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	res, err := t.txn.DeleteDACActorRelationships(ctx, batch)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(res)
+}
+
+func (t *transaction) getACPAuditLog(this js.Value, args []js.Value) (js.Value, error) {
+	var query client.ACPAuditLogQuery
+	if err := structArg(args, 0, "query", &query); err != nil {
+		return js.Undefined(), err
+	}
+	ctx, err := contextArg(args, 1, t.txns)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	res, err := t.txn.GetACPAuditLog(ctx, query)
+	if err != nil {
+		return js.Undefined(), err
+	}
+	return goji.MarshalJS(res)
+}
+
 func (t *transaction) getNACStatus(this js.Value, args []js.Value) (js.Value, error) {
 	ctx, err := contextArg(args, 0, t.txns)
 	if err != nil {
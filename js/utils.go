@@ -76,11 +76,16 @@ func contextArg(args []js.Value, index int, txns *sync.Map) (context.Context, er
 	if err != nil {
 		return ctx, err
 	}
+	capability, err := contextCapabilityArg(args[index])
+	if err != nil {
+		return ctx, err
+	}
 	txn, err := contextTransactionArg(args[index], txns)
 	if err != nil {
 		return ctx, err
 	}
 	ctx = acpIdentity.WithContext(ctx, identity)
+	ctx = acpIdentity.WithCapability(ctx, capability)
 	ctx = db.InitContext(ctx, txn)
 	return ctx, nil
 }
@@ -97,6 +102,18 @@ func contextTransactionArg(value js.Value, txns *sync.Map) (client.Txn, error) {
 	return txn.(client.Txn), nil //nolint:forcetypeassert
 }
 
+func contextCapabilityArg(value js.Value) (immutable.Option[*acpIdentity.CapabilityToken], error) {
+	token := value.Get("capability")
+	if token.Type() != js.TypeString {
+		return immutable.None[*acpIdentity.CapabilityToken](), nil
+	}
+	capability, err := acpIdentity.ParseCapabilityToken([]byte(token.String()))
+	if err != nil {
+		return immutable.None[*acpIdentity.CapabilityToken](), err
+	}
+	return immutable.Some(capability), nil
+}
+
 func contextIdentityArg(value js.Value) (immutable.Option[acpIdentity.Identity], error) {
 	full_ident := value.Get("full_identity")
 	if full_ident.Type() == js.TypeString {
@@ -0,0 +1,156 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/fluent/qp"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/multiformats/go-varint"
+)
+
+// carVersion is the version of the CAR format written and read by bundles.
+//
+// https://ipld.io/specs/transport/car/carv1/
+const carVersion = 1
+
+// maxCARSectionSize is the maximum size of a CAR section.
+//
+// It prevents a corrupted or malicious bundle from making us allocate unbounded memory.
+const maxCARSectionSize = 32 << 20
+
+// writeCARHeader writes a CARv1 header with the given root to the writer.
+func writeCARHeader(w io.Writer, root cid.Cid) error {
+	header, err := qp.BuildMap(basicnode.Prototype.Any, 2, func(ma datamodel.MapAssembler) {
+		qp.MapEntry(ma, "roots", qp.List(1, func(la datamodel.ListAssembler) {
+			qp.ListEntry(la, qp.Link(cidlink.Link{Cid: root}))
+		}))
+		qp.MapEntry(ma, "version", qp.Int(carVersion))
+	})
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	err = dagcbor.Encode(header, &buf)
+	if err != nil {
+		return err
+	}
+	return writeCARSection(w, buf.Bytes())
+}
+
+// writeCARBlock writes a block section to the writer.
+func writeCARBlock(w io.Writer, c cid.Cid, data []byte) error {
+	return writeCARSection(w, append(c.Bytes(), data...))
+}
+
+func writeCARSection(w io.Writer, data []byte) error {
+	_, err := w.Write(varint.ToUvarint(uint64(len(data))))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readCARHeader reads a CARv1 header from the reader and returns its single root.
+func readCARHeader(r *bufio.Reader) (cid.Cid, error) {
+	data, err := readCARSection(r)
+	if err != nil {
+		return cid.Undef, err
+	}
+	nb := basicnode.Prototype.Any.NewBuilder()
+	err = dagcbor.Decode(nb, bytes.NewReader(data))
+	if err != nil {
+		return cid.Undef, err
+	}
+	header := nb.Build()
+
+	versionNode, err := header.LookupByString("version")
+	if err != nil {
+		return cid.Undef, err
+	}
+	version, err := versionNode.AsInt()
+	if err != nil {
+		return cid.Undef, err
+	}
+	if version != carVersion {
+		return cid.Undef, NewErrUnsupportedBundleVersion(version)
+	}
+
+	rootsNode, err := header.LookupByString("roots")
+	if err != nil {
+		return cid.Undef, err
+	}
+	if rootsNode.Length() != 1 {
+		return cid.Undef, ErrInvalidBundleRoots
+	}
+	rootNode, err := rootsNode.LookupByIndex(0)
+	if err != nil {
+		return cid.Undef, err
+	}
+	root, err := rootNode.AsLink()
+	if err != nil {
+		return cid.Undef, err
+	}
+	rootLink, ok := root.(cidlink.Link)
+	if !ok {
+		return cid.Undef, ErrInvalidBundleRoots
+	}
+	return rootLink.Cid, nil
+}
+
+// readCARBlock reads the next block section from the reader.
+//
+// The block data is verified against its CID. io.EOF is returned once
+// there are no more sections to read.
+func readCARBlock(r *bufio.Reader) (cid.Cid, []byte, error) {
+	data, err := readCARSection(r)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	n, c, err := cid.CidFromBytes(data)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	blockData := data[n:]
+
+	expected, err := c.Prefix().Sum(blockData)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	if !expected.Equals(c) {
+		return cid.Undef, nil, NewErrBundleBlockHashMismatch(c)
+	}
+	return c, blockData, nil
+}
+
+func readCARSection(r *bufio.Reader) ([]byte, error) {
+	size, err := varint.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxCARSectionSize {
+		return nil, NewErrBundleSectionTooLarge(size)
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCAR_WriteAndRead(t *testing.T) {
+	prefix := cid.NewPrefixV1(cid.Raw, mh.SHA2_256)
+	rootData := []byte("root")
+	root, err := prefix.Sum(rootData)
+	require.NoError(t, err)
+	blockData := []byte("block")
+	block, err := prefix.Sum(blockData)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCARHeader(&buf, root))
+	require.NoError(t, writeCARBlock(&buf, root, rootData))
+	require.NoError(t, writeCARBlock(&buf, block, blockData))
+
+	r := bufio.NewReader(&buf)
+	readRoot, err := readCARHeader(r)
+	require.NoError(t, err)
+	require.Equal(t, root, readRoot)
+
+	c, data, err := readCARBlock(r)
+	require.NoError(t, err)
+	require.Equal(t, root, c)
+	require.Equal(t, rootData, data)
+
+	c, data, err = readCARBlock(r)
+	require.NoError(t, err)
+	require.Equal(t, block, c)
+	require.Equal(t, blockData, data)
+
+	_, _, err = readCARBlock(r)
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestCAR_ReadBlockWithInvalidData_Error(t *testing.T) {
+	c, err := cid.NewPrefixV1(cid.Raw, mh.SHA2_256).Sum([]byte("block"))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCARBlock(&buf, c, []byte("tampered")))
+
+	_, _, err = readCARBlock(bufio.NewReader(&buf))
+	require.ErrorIs(t, err, ErrBundleBlockHashMismatch)
+}
@@ -50,6 +50,17 @@ func (s *server) pushLog(evt event.Update, pid peer.ID) (err error) {
 	ctx, cancel := context.WithTimeout(s.peer.ctx, PushTimeout)
 	defer cancel()
 
+	release, err := s.peer.scheduler.acquire(withTransferPeer(ctx, pid))
+	if err != nil {
+		return NewErrPushLog(err)
+	}
+	defer release()
+
+	err = s.peer.scheduler.waitBandwidth(ctx, len(evt.Block))
+	if err != nil {
+		return NewErrPushLog(err)
+	}
+
 	req := pushLogRequest{
 		DocID:        evt.DocID,
 		CID:          evt.Cid.Bytes(),
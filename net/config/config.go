@@ -28,14 +28,51 @@ type Options struct {
 	GRPCDialOptions   []grpc.DialOption
 	BootstrapPeers    []string
 	RetryIntervals    []time.Duration
+	// PrivateNetworkKey is the libp2p pre-shared key (PSK) of the private network to join.
+	//
+	// Only peers configured with the same key will be able to connect to each other.
+	PrivateNetworkKey []byte
+	// EnableTopicEncryption enables the encryption of the payloads published to
+	// document and collection pubsub topics.
+	//
+	// The names of the topics are not encrypted, so the IDs of the documents with
+	// a document topic remain visible to the other peers of the swarm.
+	EnableTopicEncryption bool
+	// EnableMDNS enables the discovery of peers on the local network using mDNS.
+	EnableMDNS bool
+	// MaxTransfers is the maximum number of concurrent block transfers. Zero means no limit.
+	MaxTransfers int
+	// MaxTransfersPerPeer is the maximum number of concurrent block transfers with a single peer.
+	// Zero means no limit.
+	MaxTransfersPerPeer int
+	// MaxTransferBandwidth is the maximum number of block bytes transferred per second.
+	// Zero means no limit.
+	MaxTransferBandwidth int
+	// MaxBlockSize is the maximum size in bytes of the blocks pushed by other peers.
+	// Zero means no limit.
+	MaxBlockSize int
+	// PeerMessageRate is the maximum number of push and pubsub messages per second accepted
+	// from a single peer. Zero means no limit.
+	PeerMessageRate int
+	// BanThreshold is the misbehaviour score at which a peer is temporarily banned.
+	// Zero disables bans.
+	BanThreshold int
+	// BanDuration is the amount of time a misbehaving peer is banned for.
+	BanDuration time.Duration
 }
 
 // DefaultOptions returns the default net options.
 func DefaultOptions() *Options {
 	return &Options{
-		ListenAddresses: []string{"/ip4/0.0.0.0/tcp/9171"},
-		EnablePubSub:    true,
-		EnableRelay:     false,
+		ListenAddresses:     []string{"/ip4/0.0.0.0/tcp/9171"},
+		EnablePubSub:        true,
+		EnableRelay:         false,
+		MaxTransfers:        64,
+		MaxTransfersPerPeer: 16,
+		MaxBlockSize:        4 << 20,
+		PeerMessageRate:     1000,
+		BanThreshold:        100,
+		BanDuration:         10 * time.Minute,
 		RetryIntervals: []time.Duration{
 			// exponential backoff retry intervals
 			time.Second * 30,
@@ -93,3 +130,75 @@ func WithRetryInterval(interval []time.Duration) NodeOpt {
 		}
 	}
 }
+
+// WithPrivateNetworkKey sets the pre-shared key of the private network to join.
+//
+// The key must be 32 bytes long.
+func WithPrivateNetworkKey(psk []byte) NodeOpt {
+	return func(opt *Options) {
+		opt.PrivateNetworkKey = psk
+	}
+}
+
+// WithEnableTopicEncryption enables the encryption of pubsub topic payloads.
+func WithEnableTopicEncryption(enable bool) NodeOpt {
+	return func(opt *Options) {
+		opt.EnableTopicEncryption = enable
+	}
+}
+
+// WithEnableMDNS enables the local network peer discovery.
+func WithEnableMDNS(enable bool) NodeOpt {
+	return func(opt *Options) {
+		opt.EnableMDNS = enable
+	}
+}
+
+// WithMaxTransfers sets the maximum number of concurrent block transfers.
+func WithMaxTransfers(max int) NodeOpt {
+	return func(opt *Options) {
+		opt.MaxTransfers = max
+	}
+}
+
+// WithMaxTransfersPerPeer sets the maximum number of concurrent block transfers with a single peer.
+func WithMaxTransfersPerPeer(max int) NodeOpt {
+	return func(opt *Options) {
+		opt.MaxTransfersPerPeer = max
+	}
+}
+
+// WithMaxTransferBandwidth sets the maximum number of block bytes transferred per second.
+func WithMaxTransferBandwidth(bytesPerSecond int) NodeOpt {
+	return func(opt *Options) {
+		opt.MaxTransferBandwidth = bytesPerSecond
+	}
+}
+
+// WithMaxBlockSize sets the maximum size in bytes of the blocks pushed by other peers.
+func WithMaxBlockSize(size int) NodeOpt {
+	return func(opt *Options) {
+		opt.MaxBlockSize = size
+	}
+}
+
+// WithPeerMessageRate sets the maximum number of messages per second accepted from a single peer.
+func WithPeerMessageRate(messagesPerSecond int) NodeOpt {
+	return func(opt *Options) {
+		opt.PeerMessageRate = messagesPerSecond
+	}
+}
+
+// WithBanThreshold sets the misbehaviour score at which a peer is temporarily banned.
+func WithBanThreshold(threshold int) NodeOpt {
+	return func(opt *Options) {
+		opt.BanThreshold = threshold
+	}
+}
+
+// WithBanDuration sets the amount of time a misbehaving peer is banned for.
+func WithBanDuration(duration time.Duration) NodeOpt {
+	return func(opt *Options) {
+		opt.BanDuration = duration
+	}
+}
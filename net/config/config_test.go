@@ -12,6 +12,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -46,3 +47,63 @@ func TestWithPrivateKey(t *testing.T) {
 	WithPrivateKey([]byte("abc"))(opts)
 	assert.Equal(t, []byte("abc"), opts.PrivateKey)
 }
+
+func TestWithPrivateNetworkKey(t *testing.T) {
+	opts := &Options{}
+	WithPrivateNetworkKey([]byte("abc"))(opts)
+	assert.Equal(t, []byte("abc"), opts.PrivateNetworkKey)
+}
+
+func TestWithEnableTopicEncryption(t *testing.T) {
+	opts := &Options{}
+	WithEnableTopicEncryption(true)(opts)
+	assert.Equal(t, true, opts.EnableTopicEncryption)
+}
+
+func TestWithEnableMDNS(t *testing.T) {
+	opts := &Options{}
+	WithEnableMDNS(true)(opts)
+	assert.Equal(t, true, opts.EnableMDNS)
+}
+
+func TestWithMaxTransfers(t *testing.T) {
+	opts := &Options{}
+	WithMaxTransfers(10)(opts)
+	assert.Equal(t, 10, opts.MaxTransfers)
+}
+
+func TestWithMaxTransfersPerPeer(t *testing.T) {
+	opts := &Options{}
+	WithMaxTransfersPerPeer(2)(opts)
+	assert.Equal(t, 2, opts.MaxTransfersPerPeer)
+}
+
+func TestWithMaxTransferBandwidth(t *testing.T) {
+	opts := &Options{}
+	WithMaxTransferBandwidth(1024)(opts)
+	assert.Equal(t, 1024, opts.MaxTransferBandwidth)
+}
+
+func TestWithMaxBlockSize(t *testing.T) {
+	opts := &Options{}
+	WithMaxBlockSize(1024)(opts)
+	assert.Equal(t, 1024, opts.MaxBlockSize)
+}
+
+func TestWithPeerMessageRate(t *testing.T) {
+	opts := &Options{}
+	WithPeerMessageRate(10)(opts)
+	assert.Equal(t, 10, opts.PeerMessageRate)
+}
+
+func TestWithBanThreshold(t *testing.T) {
+	opts := &Options{}
+	WithBanThreshold(50)(opts)
+	assert.Equal(t, 50, opts.BanThreshold)
+}
+
+func TestWithBanDuration(t *testing.T) {
+	opts := &Options{}
+	WithBanDuration(time.Minute)(opts)
+	assert.Equal(t, time.Minute, opts.BanDuration)
+}
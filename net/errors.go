@@ -12,37 +12,74 @@ package net
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/errors"
 )
 
 const (
-	errPushLog                   = "failed to push log"
-	errFailedToGetDocID          = "failed to get DocID from broadcast message"
-	errPublishingToDocIDTopic    = "can't publish log %s for docID %s"
-	errPublishingToSchemaTopic   = "can't publish log %s for schema %s"
-	errCheckingForExistingBlock  = "failed to check for existing block"
-	errRequestingEncryptionKeys  = "failed to request encryption keys with %v"
-	errTopicAlreadyExist         = "topic with name \"%s\" already exists"
-	errTopicDoesNotExist         = "topic with name \"%s\" does not exists"
-	errFailedToGetIdentity       = "failed to get identity"
-	errReplicatorCollections     = "failed to get collections for replicator"
-	errFailedToCreateTransaction = "failed to create transaction"
+	errPushLog                    = "failed to push log"
+	errFailedToGetDocID           = "failed to get DocID from broadcast message"
+	errPublishingToDocIDTopic     = "can't publish log %s for docID %s"
+	errPublishingToSchemaTopic    = "can't publish log %s for schema %s"
+	errCheckingForExistingBlock   = "failed to check for existing block"
+	errRequestingEncryptionKeys   = "failed to request encryption keys with %v"
+	errTopicAlreadyExist          = "topic with name \"%s\" already exists"
+	errTopicDoesNotExist          = "topic with name \"%s\" does not exists"
+	errFailedToGetIdentity        = "failed to get identity"
+	errReplicatorCollections      = "failed to get collections for replicator"
+	errFailedToCreateTransaction  = "failed to create transaction"
+	errInvalidPrivateNetworkKey   = "invalid private network key size"
+	errTopicKeyCollectionMismatch = "topic key collection does not match the log collection"
+	errUnsupportedBundleVersion   = "unsupported bundle version"
+	errBundleBlockHashMismatch    = "bundle block data does not match its CID"
+	errBundleSectionTooLarge      = "bundle section is too large"
+	errBundleCheckpointNotFound   = "bundle checkpoint not found"
+	errBundleMissingBlock         = "bundle block is missing and not available locally"
+	errInvalidBundle              = "invalid bundle"
+	errBundleMergeTimeout         = "timeout while merging bundle"
+	errUnsignedBundleBlock        = "bundle block is not signed"
+	errBundleUnreachableBlock     = "bundle block is not reachable from the bundle heads"
+	errPeerBanned                 = "peer is temporarily banned"
+	errPeerRateLimited            = "peer exceeded its message rate"
+	errBlockTooLarge              = "block exceeds the maximum block size"
+	errPushLogCreatorMismatch     = "log creator does not match the author of the message"
 )
 
 var (
-	ErrPeerConnectionWaitTimeout = errors.New("waiting for peer connection timed out")
-	ErrPubSubWaitTimeout         = errors.New("waiting for pubsub timed out")
-	ErrPushLogWaitTimeout        = errors.New("waiting for pushlog timed out")
-	ErrNilDB                     = errors.New("database object can't be nil")
-	ErrNilUpdateChannel          = errors.New("tried to subscribe to update channel, but update channel is nil")
-	ErrCheckingForExistingBlock  = errors.New(errCheckingForExistingBlock)
-	ErrTimeoutWaitingForPeerInfo = errors.New("timeout waiting for peer info")
-	ErrSelfTargetForReplicator   = errors.New("can't target ourselves as a replicator")
-	ErrReplicatorNotFound        = errors.New("replicator not found")
-	ErrContextDone               = errors.New("context done")
-	ErrTimeoutDocSync            = errors.New("timeout while syncing doc")
-	ErrReplicatorCollections     = errors.New(errReplicatorCollections)
+	ErrPeerConnectionWaitTimeout    = errors.New("waiting for peer connection timed out")
+	ErrPubSubWaitTimeout            = errors.New("waiting for pubsub timed out")
+	ErrPushLogWaitTimeout           = errors.New("waiting for pushlog timed out")
+	ErrNilDB                        = errors.New("database object can't be nil")
+	ErrNilUpdateChannel             = errors.New("tried to subscribe to update channel, but update channel is nil")
+	ErrCheckingForExistingBlock     = errors.New(errCheckingForExistingBlock)
+	ErrTimeoutWaitingForPeerInfo    = errors.New("timeout waiting for peer info")
+	ErrSelfTargetForReplicator      = errors.New("can't target ourselves as a replicator")
+	ErrReplicatorNotFound           = errors.New("replicator not found")
+	ErrContextDone                  = errors.New("context done")
+	ErrTimeoutDocSync               = errors.New("timeout while syncing doc")
+	ErrReplicatorCollections        = errors.New(errReplicatorCollections)
+	ErrInvalidPrivateNetworkKeySize = errors.New(errInvalidPrivateNetworkKey)
+	ErrTopicKeyProviderNotSet       = errors.New("topic encryption is enabled but no topic key provider is set")
+	ErrUnencryptedTopicMessage      = errors.New("received unencrypted message on an encrypted topic")
+	ErrTopicKeyCollectionMismatch   = errors.New(errTopicKeyCollectionMismatch)
+	ErrUnsupportedBundleVersion     = errors.New(errUnsupportedBundleVersion)
+	ErrInvalidBundleRoots           = errors.New("bundle must have exactly one root")
+	ErrBundleBlockHashMismatch      = errors.New(errBundleBlockHashMismatch)
+	ErrBundleSectionTooLarge        = errors.New(errBundleSectionTooLarge)
+	ErrBundleCheckpointNotFound     = errors.New(errBundleCheckpointNotFound)
+	ErrBundleMissingBlock           = errors.New(errBundleMissingBlock)
+	ErrInvalidBundle                = errors.New(errInvalidBundle)
+	ErrBundleMergeTimeout           = errors.New(errBundleMergeTimeout)
+	ErrUnsignedBundleBlock          = errors.New(errUnsignedBundleBlock)
+	ErrBundleUnreachableBlock       = errors.New(errBundleUnreachableBlock)
+	ErrPeerBanned                   = errors.New(errPeerBanned)
+	ErrPeerRateLimited              = errors.New(errPeerRateLimited)
+	ErrBlockTooLarge                = errors.New(errBlockTooLarge)
+	ErrPushLogCreatorMismatch       = errors.New(errPushLogCreatorMismatch)
 )
 
 func NewErrPushLog(inner error, kv ...errors.KV) error {
@@ -80,3 +117,95 @@ func NewErrReplicatorCollections(inner error, kv ...errors.KV) error {
 func NewErrFailedToCreateTransaction(inner error, kv ...errors.KV) error {
 	return errors.Wrap(errFailedToCreateTransaction, inner, kv...)
 }
+
+func NewErrInvalidPrivateNetworkKeySize(size int) error {
+	return errors.New(
+		errInvalidPrivateNetworkKey,
+		errors.NewKV("Expected", privateNetworkKeySize),
+		errors.NewKV("Actual", size),
+	)
+}
+
+func NewErrTopicKeyCollectionMismatch(collectionID, keyCollectionID string) error {
+	return errors.New(
+		errTopicKeyCollectionMismatch,
+		errors.NewKV("CollectionID", collectionID),
+		errors.NewKV("KeyCollectionID", keyCollectionID),
+	)
+}
+
+func NewErrPushLogCreatorMismatch(creator string, author libpeer.ID) error {
+	return errors.New(
+		errPushLogCreatorMismatch,
+		errors.NewKV("Creator", creator),
+		errors.NewKV("Author", author),
+	)
+}
+
+func NewErrUnsupportedBundleVersion(version int64) error {
+	return errors.New(
+		errUnsupportedBundleVersion,
+		errors.NewKV("Expected", carVersion),
+		errors.NewKV("Actual", version),
+	)
+}
+
+func NewErrBundleBlockHashMismatch(c cid.Cid) error {
+	return errors.New(errBundleBlockHashMismatch, errors.NewKV("CID", c))
+}
+
+func NewErrBundleSectionTooLarge(size uint64) error {
+	return errors.New(
+		errBundleSectionTooLarge,
+		errors.NewKV("Max", maxCARSectionSize),
+		errors.NewKV("Actual", size),
+	)
+}
+
+func NewErrBundleCheckpointNotFound(checkpoint string) error {
+	return errors.New(errBundleCheckpointNotFound, errors.NewKV("Checkpoint", checkpoint))
+}
+
+func NewErrBundleMissingBlock(c cid.Cid) error {
+	return errors.New(errBundleMissingBlock, errors.NewKV("CID", c))
+}
+
+func NewErrInvalidBundle(inner error, kv ...errors.KV) error {
+	return errors.Wrap(errInvalidBundle, inner, kv...)
+}
+
+func NewErrBundleMergeTimeout(merged, total int) error {
+	return errors.New(
+		errBundleMergeTimeout,
+		errors.NewKV("Merged", merged),
+		errors.NewKV("Total", total),
+	)
+}
+
+func NewErrUnsignedBundleBlock(c cid.Cid) error {
+	return errors.New(errUnsignedBundleBlock, errors.NewKV("CID", c))
+}
+
+func NewErrBundleUnreachableBlock(c cid.Cid) error {
+	return errors.New(errBundleUnreachableBlock, errors.NewKV("CID", c))
+}
+
+func NewErrPeerBanned(peerID libpeer.ID, until time.Time) error {
+	return errors.New(
+		errPeerBanned,
+		errors.NewKV("PeerID", peerID),
+		errors.NewKV("Until", until),
+	)
+}
+
+func NewErrPeerRateLimited(peerID libpeer.ID) error {
+	return errors.New(errPeerRateLimited, errors.NewKV("PeerID", peerID))
+}
+
+func NewErrBlockTooLarge(size, maxSize int) error {
+	return errors.New(
+		errBlockTooLarge,
+		errors.NewKV("Max", maxSize),
+		errors.NewKV("Actual", size),
+	)
+}
@@ -22,12 +22,22 @@ import (
 	record "github.com/libp2p/go-libp2p-record"
 	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/pnet"
 	"github.com/libp2p/go-libp2p/core/routing"
 	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 )
 
+// privateNetworkKeySize is the required size of a private network pre-shared key.
+const privateNetworkKeySize = 32
+
 // setupHost returns a host and router configured with the given options.
-func setupHost(ctx context.Context, options *config.Options) (host.Host, *dualdht.DHT, error) {
+//
+// The additional libp2p options are applied after the ones derived from the config options.
+func setupHost(
+	ctx context.Context,
+	options *config.Options,
+	opts ...libp2p.Option,
+) (host.Host, *dualdht.DHT, error) {
 	connManager, err := connmgr.NewConnManager(100, 400, connmgr.WithGracePeriod(time.Second*20))
 	if err != nil {
 		return nil, nil, err
@@ -47,11 +57,24 @@ func setupHost(ctx context.Context, options *config.Options) (host.Host, *dualdh
 
 	libp2pOpts := []libp2p.Option{
 		libp2p.ConnectionManager(connManager),
-		libp2p.DefaultTransports,
 		libp2p.ListenAddrStrings(options.ListenAddresses...),
 		libp2p.Routing(routing),
 	}
 
+	// QUIC based transports do not support private networks so we fallback
+	// to the private transports when a pre-shared key is given.
+	if len(options.PrivateNetworkKey) > 0 {
+		if len(options.PrivateNetworkKey) != privateNetworkKeySize {
+			return nil, nil, NewErrInvalidPrivateNetworkKeySize(len(options.PrivateNetworkKey))
+		}
+		libp2pOpts = append(libp2pOpts,
+			libp2p.PrivateNetwork(pnet.PSK(options.PrivateNetworkKey)),
+			libp2p.DefaultPrivateTransports,
+		)
+	} else {
+		libp2pOpts = append(libp2pOpts, libp2p.DefaultTransports)
+	}
+
 	// relay is enabled by default unless explicitly disabled
 	if !options.EnableRelay {
 		libp2pOpts = append(libp2pOpts, libp2p.DisableRelay())
@@ -66,6 +89,8 @@ func setupHost(ctx context.Context, options *config.Options) (host.Host, *dualdh
 		libp2pOpts = append(libp2pOpts, libp2p.Identity(privateKey))
 	}
 
+	libp2pOpts = append(libp2pOpts, opts...)
+
 	h, err := libp2p.New(libp2pOpts...)
 	if err != nil {
 		return nil, nil, err
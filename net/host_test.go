@@ -29,3 +29,22 @@ func TestSetupHostWithDefaultOptions(t *testing.T) {
 	err = h.Close()
 	require.NoError(t, err)
 }
+
+func TestSetupHostWithPrivateNetworkKey(t *testing.T) {
+	opts := config.DefaultOptions()
+	opts.PrivateNetworkKey = make([]byte, 32)
+
+	h, _, err := setupHost(context.Background(), opts)
+	require.NoError(t, err)
+
+	err = h.Close()
+	require.NoError(t, err)
+}
+
+func TestSetupHostWithInvalidPrivateNetworkKey_Error(t *testing.T) {
+	opts := config.DefaultOptions()
+	opts.PrivateNetworkKey = []byte("abc")
+
+	_, _, err := setupHost(context.Background(), opts)
+	require.ErrorIs(t, err, ErrInvalidPrivateNetworkKeySize)
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+	"github.com/sourcenetwork/corelog"
+)
+
+// mdnsServiceName is the mDNS service name advertised by DefraDB peers.
+//
+// Using a dedicated name ensures that we only discover DefraDB peers and not
+// every libp2p node on the local network.
+const mdnsServiceName = "_defradb._udp"
+
+// mdnsConnectTimeout is the maximum amount of time to spend connecting to a discovered peer.
+const mdnsConnectTimeout = 10 * time.Second
+
+// mdnsNotifee connects to the peers discovered on the local network.
+type mdnsNotifee struct {
+	peer *Peer
+}
+
+var _ mdns.Notifee = (*mdnsNotifee)(nil)
+
+// HandlePeerFound is called by the mDNS service when a new peer is discovered.
+//
+// The connection is made in the background so that the discovery of other peers is not blocked.
+func (n *mdnsNotifee) HandlePeerFound(info peer.AddrInfo) {
+	if info.ID == n.peer.PeerID() {
+		return
+	}
+	if n.peer.host.Network().Connectedness(info.ID) == network.Connected {
+		return
+	}
+
+	log.InfoContext(n.peer.ctx, "Discovered peer via mDNS", corelog.Any("PeerID", info.ID))
+
+	go func() {
+		ctx, cancel := context.WithTimeout(n.peer.ctx, mdnsConnectTimeout)
+		defer cancel()
+
+		if err := n.peer.Connect(ctx, info); err != nil {
+			log.ErrorContextE(n.peer.ctx, "Failed to connect to peer discovered via mDNS", err,
+				corelog.Any("PeerID", info.ID))
+		}
+	}()
+}
+
+// setupMDNS starts the mDNS service that discovers and connects to peers on the local network.
+func (p *Peer) setupMDNS() error {
+	service := mdns.NewMdnsService(p.host, mdnsServiceName, &mdnsNotifee{peer: p})
+	if err := service.Start(); err != nil {
+		return err
+	}
+	p.mdns = service
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/net/config"
+)
+
+func TestMDNS_WithTwoPeers_ShouldDiscoverAndConnect(t *testing.T) {
+	ctx := context.Background()
+
+	db1 := newTestDB(ctx, t)
+	defer db1.Close()
+	p1, err := NewPeer(
+		ctx,
+		db1.Events(),
+		immutable.None[dac.DocumentACP](),
+		db1,
+		config.WithListenAddresses(randomMultiaddr),
+		config.WithEnableMDNS(true),
+	)
+	require.NoError(t, err)
+	defer p1.Close()
+
+	db2 := newTestDB(ctx, t)
+	defer db2.Close()
+	p2, err := NewPeer(
+		ctx,
+		db2.Events(),
+		immutable.None[dac.DocumentACP](),
+		db2,
+		config.WithListenAddresses(randomMultiaddr),
+		config.WithEnableMDNS(true),
+	)
+	require.NoError(t, err)
+	defer p2.Close()
+
+	require.Eventually(t, func() bool {
+		return p1.host.Network().Connectedness(p2.PeerID()) == network.Connected &&
+			p2.host.Network().Connectedness(p1.PeerID()) == network.Connected
+	}, 30*time.Second, 100*time.Millisecond)
+}
+
+func TestMDNS_HandlePeerFoundWithUnreachablePeer_ShouldNotBlock(t *testing.T) {
+	ctx := context.Background()
+	database, p := newTestPeer(ctx, t)
+	defer database.Close()
+	defer p.Close()
+
+	// This address is not routable so connecting to it only fails after the connect timeout.
+	addr, err := multiaddr.NewMultiaddr("/ip4/10.255.255.1/tcp/9171")
+	require.NoError(t, err)
+
+	notifee := &mdnsNotifee{peer: p}
+	start := time.Now()
+	notifee.HandlePeerFound(peer.AddrInfo{
+		ID:    test.RandPeerIDFatal(t),
+		Addrs: []multiaddr.Multiaddr{addr},
+	})
+	require.Less(t, time.Since(start), mdnsConnectTimeout/2)
+}
@@ -0,0 +1,674 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/crypto"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/keys"
+)
+
+// bundleMergeTimeout is the maximum amount of time to wait for the next
+// merge of an imported bundle to complete.
+var bundleMergeTimeout = 30 * time.Second
+
+// bundleManifest describes the document heads of an offline bundle.
+//
+// The manifest is the root block of the bundle and its CID is the bundle checkpoint.
+type bundleManifest struct {
+	// Heads contains the heads of all the exported documents.
+	Heads []bundleHead
+}
+
+// bundleHead is the head of an exported document.
+type bundleHead struct {
+	CollectionID string
+	DocID        string
+	Cid          []byte
+}
+
+func (p *Peer) ExportBundle(
+	ctx context.Context,
+	config client.BundleExportConfig,
+) (result client.BundleExportResult, err error) {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	bs := datastore.BlockstoreFrom(p.db.Rootstore())
+
+	heads, err := p.getBundleHeads(ctx, config.Collections)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+
+	// Blocks reachable from the heads of the checkpoint were already exported.
+	known := make(map[cid.Cid]struct{})
+	if config.Since != "" {
+		since, err := loadBundleManifest(ctx, bs, config.Since)
+		if err != nil {
+			return client.BundleExportResult{}, err
+		}
+		for _, head := range since.Heads {
+			headCid, err := cid.Cast(head.Cid)
+			if err != nil {
+				return client.BundleExportResult{}, err
+			}
+			err = walkBundleBlocks(ctx, bs, headCid, known, func(cid.Cid, []byte) error { return nil })
+			if err != nil {
+				return client.BundleExportResult{}, err
+			}
+		}
+	}
+
+	manifestData, err := cbor.Marshal(bundleManifest{
+		Heads: heads,
+	})
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	manifestCid, err := cid.NewPrefixV1(cid.Raw, mh.SHA2_256).Sum(manifestData)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	manifestBlock, err := blocks.NewBlockWithCid(manifestData, manifestCid)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	// The manifest is stored locally so that it can be used as the checkpoint of a later export.
+	err = bs.Put(ctx, manifestBlock)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+
+	tempFile := config.Filepath + ".temp"
+	f, err := os.Create(tempFile)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	defer func() {
+		closeErr := f.Close()
+		if err == nil && closeErr != nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(tempFile)
+			return
+		}
+		err = os.Rename(tempFile, config.Filepath)
+	}()
+
+	w := bufio.NewWriter(f)
+	err = writeCARHeader(w, manifestCid)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	err = writeCARBlock(w, manifestCid, manifestData)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+
+	blockCount := 0
+	for _, head := range heads {
+		headCid, err := cid.Cast(head.Cid)
+		if err != nil {
+			return client.BundleExportResult{}, err
+		}
+		err = walkBundleBlocks(ctx, bs, headCid, known, func(c cid.Cid, data []byte) error {
+			blockCount++
+			return writeCARBlock(w, c, data)
+		})
+		if err != nil {
+			return client.BundleExportResult{}, err
+		}
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+
+	return client.BundleExportResult{
+		Checkpoint: manifestCid.String(),
+		Heads:      len(heads),
+		Blocks:     blockCount,
+	}, nil
+}
+
+func (p *Peer) ImportBundle(ctx context.Context, filepath string) (client.BundleImportResult, error) {
+	ctx, span := tracer.Start(ctx)
+	defer span.End()
+
+	f, err := os.Open(filepath)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	r := bufio.NewReader(f)
+	root, err := readCARHeader(r)
+	if err != nil {
+		return client.BundleImportResult{}, NewErrInvalidBundle(err)
+	}
+
+	// The manifest is the first block of the bundle.
+	c, manifestData, err := readCARBlock(r)
+	if errors.Is(err, io.EOF) || (err == nil && !c.Equals(root)) {
+		return client.BundleImportResult{}, NewErrInvalidBundle(NewErrBundleMissingBlock(root))
+	}
+	if err != nil {
+		return client.BundleImportResult{}, NewErrInvalidBundle(err)
+	}
+	var manifest bundleManifest
+	err = cbor.Unmarshal(manifestData, &manifest)
+	if err != nil {
+		return client.BundleImportResult{}, NewErrInvalidBundle(err)
+	}
+
+	// The blocks are staged in a transaction that is only committed once the whole bundle
+	// has been read and validated, so that truncated or forged bundles leave no blocks behind.
+	clientTxn, err := p.db.NewTxn(ctx, false)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	defer clientTxn.Discard(ctx)
+	txn := datastore.MustGetFromClientTxn(clientTxn)
+
+	bs := txn.Blockstore()
+	bundled, err := importBundleBlocks(ctx, bs, r)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+
+	// Only the heads that changed since the checkpoint of the bundle need to be merged.
+	var merges []event.Merge
+	encrypted := 0
+	checked := make(map[cid.Cid]bool)
+	encstore := txn.Encstore()
+	for _, head := range manifest.Heads {
+		headCid, err := cid.Cast(head.Cid)
+		if err != nil {
+			return client.BundleImportResult{}, NewErrInvalidBundle(err)
+		}
+		if _, ok := bundled[headCid]; !ok {
+			continue
+		}
+		err = p.ensureBundleCollection(ctx, head.CollectionID)
+		if err != nil {
+			return client.BundleImportResult{}, err
+		}
+		missingKeys, err := checkBundleBlocks(ctx, bs, encstore, headCid, bundled, checked)
+		if err != nil {
+			return client.BundleImportResult{}, err
+		}
+		if missingKeys {
+			// Merging would block until the keys are retrieved from the network,
+			// which is not reachable when bundles are used.
+			encrypted++
+			continue
+		}
+		sender, err := getBundleBlockSender(ctx, bs, headCid)
+		if err != nil {
+			return client.BundleImportResult{}, err
+		}
+		merges = append(merges, event.Merge{
+			DocID:        head.DocID,
+			ByPeer:       sender,
+			FromPeer:     p.PeerID(),
+			Cid:          headCid,
+			CollectionID: head.CollectionID,
+		})
+	}
+
+	// Every block of the bundle must be part of the DAG of one of its heads.
+	for c := range bundled {
+		if _, ok := checked[c]; !ok {
+			return client.BundleImportResult{}, NewErrInvalidBundle(NewErrBundleUnreachableBlock(c))
+		}
+	}
+
+	manifestBlock, err := blocks.NewBlockWithCid(manifestData, root)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	err = bs.Put(ctx, manifestBlock)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	err = clientTxn.Commit(ctx)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+
+	merged, err := p.mergeBundleHeads(ctx, merges)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+
+	return client.BundleImportResult{
+		Checkpoint: root.String(),
+		Blocks:     len(bundled),
+		Merged:     merged,
+		Encrypted:  encrypted,
+	}, nil
+}
+
+// getBundleHeads returns the heads of the documents of the given collections.
+//
+// If no collection names are given, the heads of all collections are returned.
+func (p *Peer) getBundleHeads(ctx context.Context, collectionNames []string) ([]bundleHead, error) {
+	clientTxn, err := p.db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer clientTxn.Discard(ctx)
+
+	var cols []client.Collection
+	if len(collectionNames) == 0 {
+		cols, err = clientTxn.GetCollections(ctx, client.CollectionFetchOptions{})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, name := range collectionNames {
+			namedCols, err := clientTxn.GetCollections(
+				ctx,
+				client.CollectionFetchOptions{
+					Name: immutable.Some(name),
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if len(namedCols) == 0 {
+				return nil, client.NewErrCollectionNotFoundForName(name)
+			}
+			cols = append(cols, namedCols...)
+		}
+	}
+
+	headstore := datastore.HeadstoreFrom(p.db.Rootstore())
+	heads := []bundleHead{}
+	for _, col := range cols {
+		if !col.Version().IsMaterialized {
+			continue
+		}
+		docIDsCh, err := col.GetAllDocIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for docIDResult := range docIDsCh {
+			if docIDResult.Err != nil {
+				return nil, docIDResult.Err
+			}
+			key := keys.HeadstoreDocKey{
+				DocID:   docIDResult.ID.String(),
+				FieldID: core.COMPOSITE_NAMESPACE,
+			}
+			cids, _, err := coreblock.NewHeadSet(headstore, key).List(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range cids {
+				heads = append(heads, bundleHead{
+					CollectionID: col.Version().CollectionID,
+					DocID:        docIDResult.ID.String(),
+					Cid:          c.Bytes(),
+				})
+			}
+		}
+	}
+	return heads, nil
+}
+
+// ensureBundleCollection returns an error if the collection with the given ID does not exist locally.
+func (p *Peer) ensureBundleCollection(ctx context.Context, collectionID string) error {
+	clientTxn, err := p.db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer clientTxn.Discard(ctx)
+
+	cols, err := clientTxn.GetCollections(
+		ctx,
+		client.CollectionFetchOptions{
+			CollectionID: immutable.Some(collectionID),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if len(cols) == 0 {
+		return client.NewErrCollectionNotFoundForCollectionID(collectionID)
+	}
+	return nil
+}
+
+// mergeBundleHeads publishes the given merges and waits for them to complete.
+//
+// It returns the number of completed merges.
+func (p *Peer) mergeBundleHeads(ctx context.Context, merges []event.Merge) (int, error) {
+	if len(merges) == 0 {
+		return 0, nil
+	}
+
+	sub, err := p.bus.Subscribe(event.MergeCompleteName)
+	if err != nil {
+		return 0, err
+	}
+	defer p.bus.Unsubscribe(sub)
+
+	pending := make(map[cid.Cid]struct{}, len(merges))
+	for _, merge := range merges {
+		pending[merge.Cid] = struct{}{}
+	}
+	for _, merge := range merges {
+		p.bus.Publish(event.NewMessage(event.MergeName, merge))
+	}
+
+	timer := time.NewTimer(bundleMergeTimeout)
+	defer timer.Stop()
+
+	merged := 0
+	for len(pending) > 0 {
+		select {
+		case msg, ok := <-sub.Message():
+			if !ok {
+				return merged, ErrContextDone
+			}
+			evt, ok := msg.Data.(event.MergeComplete)
+			if !ok {
+				continue
+			}
+			if _, ok := pending[evt.Merge.Cid]; !ok {
+				continue
+			}
+			delete(pending, evt.Merge.Cid)
+			merged++
+			timer.Reset(bundleMergeTimeout)
+
+		case <-timer.C:
+			return merged, NewErrBundleMergeTimeout(merged, len(merges))
+
+		case <-ctx.Done():
+			return merged, ctx.Err()
+		}
+	}
+	return merged, nil
+}
+
+// loadBundleManifest loads the manifest of a previously exported or imported bundle.
+func loadBundleManifest(ctx context.Context, bs datastore.Blockstore, checkpoint string) (bundleManifest, error) {
+	manifestCid, err := cid.Parse(checkpoint)
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	block, err := bs.Get(ctx, manifestCid)
+	if errors.Is(err, ipld.ErrNotFound{}) {
+		return bundleManifest{}, NewErrBundleCheckpointNotFound(checkpoint)
+	}
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	var manifest bundleManifest
+	err = cbor.Unmarshal(block.RawData(), &manifest)
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	return manifest, nil
+}
+
+// walkBundleBlocks walks the local DAG starting at the given block and calls the
+// given function with the blocks that are not in the visited set.
+//
+// Signature blocks are walked just before the blocks they sign. Encryption blocks
+// hold the keys of encrypted fields in plain text and are never part of bundles.
+func walkBundleBlocks(
+	ctx context.Context,
+	bs datastore.Blockstore,
+	c cid.Cid,
+	visited map[cid.Cid]struct{},
+	fn func(cid.Cid, []byte) error,
+) error {
+	if _, ok := visited[c]; ok {
+		return nil
+	}
+	visited[c] = struct{}{}
+
+	rawBlock, err := bs.Get(ctx, c)
+	if err != nil {
+		return err
+	}
+	block, err := coreblock.GetFromBytes(rawBlock.RawData())
+	if err != nil {
+		return err
+	}
+	// The signature block is written first so that the block can be verified as soon as it is read.
+	if block.Signature != nil {
+		sigCid := block.Signature.Cid
+		if _, ok := visited[sigCid]; !ok {
+			visited[sigCid] = struct{}{}
+			sigBlock, err := bs.Get(ctx, sigCid)
+			if err != nil {
+				return err
+			}
+			err = fn(sigCid, sigBlock.RawData())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = fn(c, rawBlock.RawData())
+	if err != nil {
+		return err
+	}
+	for _, lnk := range block.AllLinks() {
+		err = walkBundleBlocks(ctx, bs, lnk.Cid, visited, fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importBundleBlocks reads the remaining blocks of a bundle and stores them in the given
+// blockstore, which must be the blockstore of the transaction staging the bundle.
+//
+// Blocks are streamed one at a time so that the size of a bundle is not limited by the
+// available memory. Signature blocks are written before the blocks they sign and blocks
+// are written before the blocks they link to, which allows every block to be verified as
+// soon as it is read.
+//
+// Field blocks above the first priority are not signed, their integrity is guaranteed by the
+// signed blocks linking to them. Any other unsigned block is rejected as the sender of a bundle
+// is derived from the signatures of its blocks.
+//
+// The CIDs of the imported blocks are returned.
+func importBundleBlocks(
+	ctx context.Context,
+	bs datastore.Blockstore,
+	r *bufio.Reader,
+) (map[cid.Cid]struct{}, error) {
+	linkSys := cidlink.DefaultLinkSystem()
+	linkSys.SetReadStorage(bs.AsIPLDStorage())
+	linkSys.TrustedStorage = true
+
+	bundled := make(map[cid.Cid]struct{})
+	// linked contains the blocks that are linked to by verified blocks.
+	linked := make(map[cid.Cid]struct{})
+	for {
+		c, data, err := readCARBlock(r)
+		if errors.Is(err, io.EOF) {
+			return bundled, nil
+		}
+		if err != nil {
+			return nil, NewErrInvalidBundle(err)
+		}
+
+		block, err := coreblock.GetFromBytes(data)
+		if err != nil {
+			// The data of signature blocks is only ever read through the blocks they sign.
+			_, sigErr := coreblock.GetSignatureBlockFromBytes(data)
+			if sigErr != nil {
+				return nil, NewErrInvalidBundle(err, errors.NewKV("CID", c))
+			}
+		} else {
+			if block.Signature != nil {
+				_, err = coreblock.VerifyBlockSignature(block, &linkSys)
+				if err != nil {
+					return nil, err
+				}
+			} else if _, ok := linked[c]; !ok || !block.Delta.IsField() {
+				return nil, NewErrUnsignedBundleBlock(c)
+			}
+			for _, lnk := range block.AllLinks() {
+				linked[lnk.Cid] = struct{}{}
+			}
+		}
+
+		rawBlock, err := blocks.NewBlockWithCid(data, c)
+		if err != nil {
+			return nil, err
+		}
+		err = bs.Put(ctx, rawBlock)
+		if err != nil {
+			return nil, err
+		}
+		bundled[c] = struct{}{}
+	}
+}
+
+// checkBundleBlocks checks that all the blocks reachable from the given bundle block are available.
+//
+// The checked blocks, including the signature blocks of the checked bundle blocks, are added to
+// the given checked set.
+//
+// Links to blocks that are not part of the bundle must be available locally. It returns true if the
+// encryption keys of some of the bundle blocks are not available locally.
+func checkBundleBlocks(
+	ctx context.Context,
+	bs datastore.Blockstore,
+	encstore datastore.Blockstore,
+	c cid.Cid,
+	bundled map[cid.Cid]struct{},
+	checked map[cid.Cid]bool,
+) (bool, error) {
+	if missingKeys, ok := checked[c]; ok {
+		return missingKeys, nil
+	}
+	checked[c] = false
+
+	if _, ok := bundled[c]; !ok {
+		has, err := bs.Has(ctx, c)
+		if err != nil {
+			return false, err
+		}
+		if !has {
+			return false, NewErrBundleMissingBlock(c)
+		}
+		// Blocks that were already available locally have been merged when they were stored.
+		return false, nil
+	}
+
+	rawBlock, err := bs.Get(ctx, c)
+	if err != nil {
+		return false, err
+	}
+	block, err := coreblock.GetFromBytes(rawBlock.RawData())
+	if err != nil {
+		return false, NewErrInvalidBundle(err)
+	}
+
+	if block.Signature != nil {
+		checked[block.Signature.Cid] = false
+	}
+	missingKeys := false
+	if block.Encryption != nil {
+		hasKey, err := encstore.Has(ctx, block.Encryption.Cid)
+		if err != nil {
+			return false, err
+		}
+		missingKeys = !hasKey
+	}
+	for _, lnk := range block.AllLinks() {
+		linkMissingKeys, err := checkBundleBlocks(ctx, bs, encstore, lnk.Cid, bundled, checked)
+		if err != nil {
+			return false, err
+		}
+		missingKeys = missingKeys || linkMissingKeys
+	}
+	checked[c] = missingKeys
+	return missingKeys, nil
+}
+
+// getBundleBlockSender returns the ID of the peer that signed the given block.
+//
+// The signer of a block is the node that created it, which is the peer that the
+// changes of a bundle head are attributed to.
+func getBundleBlockSender(ctx context.Context, bs datastore.Blockstore, c cid.Cid) (libpeer.ID, error) {
+	rawBlock, err := bs.Get(ctx, c)
+	if err != nil {
+		return "", err
+	}
+	block, err := coreblock.GetFromBytes(rawBlock.RawData())
+	if err != nil {
+		return "", err
+	}
+	if block.Signature == nil {
+		return "", NewErrUnsignedBundleBlock(c)
+	}
+	rawSig, err := bs.Get(ctx, block.Signature.Cid)
+	if err != nil {
+		return "", err
+	}
+	sig, err := coreblock.GetSignatureBlockFromBytes(rawSig.RawData())
+	if err != nil {
+		return "", err
+	}
+	pubKey, err := sig.PublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	var libp2pPubKey libp2pCrypto.PubKey
+	switch pubKey.Type() {
+	case crypto.KeyTypeEd25519:
+		libp2pPubKey, err = libp2pCrypto.UnmarshalEd25519PublicKey(pubKey.Raw())
+	case crypto.KeyTypeSecp256k1:
+		libp2pPubKey, err = libp2pCrypto.UnmarshalSecp256k1PublicKey(pubKey.Raw())
+	default:
+		return "", crypto.ErrUnsupportedPrivKeyType
+	}
+	if err != nil {
+		return "", err
+	}
+	return libpeer.IDFromPublicKey(libp2pPubKey)
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	badgerds "github.com/dgraph-io/badger/v4"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/ipfs/go-cid"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/corekv/badger"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/crypto"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db"
+	"github.com/sourcenetwork/defradb/net/config"
+)
+
+// newTestBundlePeer creates a peer whose blocks are signed by the given identity.
+func newTestBundlePeer(ctx context.Context, t *testing.T, ident identity.Identity) (*db.DB, *Peer) {
+	store, err := badger.NewDatastore("", badgerds.DefaultOptions("").WithInMemory(true))
+	require.NoError(t, err)
+	adminInfo, err := db.NewNACInfo(ctx, "", false)
+	require.NoError(t, err)
+	database, err := db.NewDB(
+		ctx,
+		store,
+		adminInfo,
+		dac.NoDocumentACP,
+		nil,
+		db.WithNodeIdentity(ident),
+	)
+	require.NoError(t, err)
+
+	p, err := NewPeer(
+		ctx,
+		database.Events(),
+		immutable.None[dac.DocumentACP](),
+		database,
+		config.WithListenAddresses(randomMultiaddr),
+	)
+	require.NoError(t, err)
+
+	_, err = database.AddSchema(ctx, `type User { name: String }`)
+	require.NoError(t, err)
+
+	return database, p
+}
+
+// exportTestBundle creates a document on the given database and exports it to a bundle.
+func exportTestBundle(ctx context.Context, t *testing.T, database *db.DB, p *Peer) string {
+	col, err := database.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`), col.Definition())
+	require.NoError(t, err)
+	err = col.Save(ctx, doc)
+	require.NoError(t, err)
+
+	bundlePath := filepath.Join(t.TempDir(), "bundle.car")
+	_, err = p.ExportBundle(ctx, client.BundleExportConfig{Filepath: bundlePath})
+	require.NoError(t, err)
+	return bundlePath
+}
+
+// rewriteTestBundle rewrites the blocks of a bundle with the given function.
+//
+// Rewritten blocks are written with their new CID.
+func rewriteTestBundle(t *testing.T, bundlePath string, fn func(*coreblock.Block)) {
+	data, err := os.ReadFile(bundlePath)
+	require.NoError(t, err)
+
+	r := bufio.NewReader(bytes.NewReader(data))
+	root, err := readCARHeader(r)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = writeCARHeader(&buf, root)
+	require.NoError(t, err)
+	for {
+		c, data, err := readCARBlock(r)
+		if err != nil {
+			break
+		}
+		block, err := coreblock.GetFromBytes(data)
+		if err == nil && !c.Equals(root) {
+			fn(block)
+			data, err = block.Marshal()
+			require.NoError(t, err)
+			c, err = c.Prefix().Sum(data)
+			require.NoError(t, err)
+		}
+		err = writeCARBlock(&buf, c, data)
+		require.NoError(t, err)
+	}
+
+	err = os.WriteFile(bundlePath, buf.Bytes(), 0o644)
+	require.NoError(t, err)
+}
+
+func TestImportBundle_WithModifiedSignedBlock_ShouldError(t *testing.T) {
+	ctx := context.Background()
+	ident, err := identity.Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	db1, p1 := newTestBundlePeer(ctx, t, ident)
+	defer db1.Close()
+	defer p1.Close()
+	db2, p2 := newTestBundlePeer(ctx, t, ident)
+	defer db2.Close()
+	defer p2.Close()
+
+	bundlePath := exportTestBundle(ctx, t, db1, p1)
+	rewriteTestBundle(t, bundlePath, func(block *coreblock.Block) {
+		if block.Delta.IsField() {
+			block.Delta.LWWDelta.Data = []byte("tampered")
+		}
+	})
+
+	_, err = p2.ImportBundle(ctx, bundlePath)
+	require.ErrorIs(t, err, crypto.ErrSignatureVerification)
+}
+
+func TestImportBundle_WithRemovedSignature_ShouldError(t *testing.T) {
+	ctx := context.Background()
+	ident, err := identity.Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	db1, p1 := newTestBundlePeer(ctx, t, ident)
+	defer db1.Close()
+	defer p1.Close()
+	db2, p2 := newTestBundlePeer(ctx, t, ident)
+	defer db2.Close()
+	defer p2.Close()
+
+	bundlePath := exportTestBundle(ctx, t, db1, p1)
+	rewriteTestBundle(t, bundlePath, func(block *coreblock.Block) {
+		block.Signature = nil
+	})
+
+	_, err = p2.ImportBundle(ctx, bundlePath)
+	require.ErrorIs(t, err, ErrUnsignedBundleBlock)
+}
+
+func TestGetBundleBlockSender_ShouldReturnPeerOfSigner(t *testing.T) {
+	ctx := context.Background()
+	ident, err := identity.Generate(crypto.KeyTypeEd25519)
+	require.NoError(t, err)
+
+	db1, p1 := newTestBundlePeer(ctx, t, ident)
+	defer db1.Close()
+	defer p1.Close()
+	db2, p2 := newTestBundlePeer(ctx, t, ident)
+	defer db2.Close()
+	defer p2.Close()
+
+	bundlePath := exportTestBundle(ctx, t, db1, p1)
+	_, err = p2.ImportBundle(ctx, bundlePath)
+	require.NoError(t, err)
+
+	manifest, err := readTestBundleManifest(bundlePath)
+	require.NoError(t, err)
+	require.Len(t, manifest.Heads, 1)
+	headCid, err := cid.Cast(manifest.Heads[0].Cid)
+	require.NoError(t, err)
+
+	sender, err := getBundleBlockSender(ctx, datastore.BlockstoreFrom(db2.Rootstore()), headCid)
+	require.NoError(t, err)
+
+	pubKey, err := libp2pCrypto.UnmarshalEd25519PublicKey(ident.PublicKey().Raw())
+	require.NoError(t, err)
+	expected, err := libpeer.IDFromPublicKey(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, sender)
+}
+
+// readTestBundleManifest reads the manifest of the given bundle.
+func readTestBundleManifest(bundlePath string) (bundleManifest, error) {
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	r := bufio.NewReader(f)
+	_, err = readCARHeader(r)
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	_, data, err := readCARBlock(r)
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	var manifest bundleManifest
+	err = cbor.Unmarshal(data, &manifest)
+	if err != nil {
+		return bundleManifest{}, err
+	}
+	return manifest, nil
+}
+
+func TestImportBundle_WithTruncatedBundle_ShouldNotStoreBlocks(t *testing.T) {
+	ctx := context.Background()
+	ident, err := identity.Generate(crypto.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	db1, p1 := newTestBundlePeer(ctx, t, ident)
+	defer db1.Close()
+	defer p1.Close()
+	db2, p2 := newTestBundlePeer(ctx, t, ident)
+	defer db2.Close()
+	defer p2.Close()
+
+	bundlePath := exportTestBundle(ctx, t, db1, p1)
+	manifest, err := readTestBundleManifest(bundlePath)
+	require.NoError(t, err)
+	require.Len(t, manifest.Heads, 1)
+	headCid, err := cid.Cast(manifest.Heads[0].Cid)
+	require.NoError(t, err)
+
+	// drop the last block of the bundle, which is linked to by the head
+	data, err := os.ReadFile(bundlePath)
+	require.NoError(t, err)
+	r := bufio.NewReader(bytes.NewReader(data))
+	root, err := readCARHeader(r)
+	require.NoError(t, err)
+	var bundleBlocks [][]byte
+	var bundleCids []cid.Cid
+	for {
+		c, data, err := readCARBlock(r)
+		if err != nil {
+			break
+		}
+		bundleCids = append(bundleCids, c)
+		bundleBlocks = append(bundleBlocks, data)
+	}
+	var buf bytes.Buffer
+	err = writeCARHeader(&buf, root)
+	require.NoError(t, err)
+	for i := 0; i < len(bundleBlocks)-1; i++ {
+		err = writeCARBlock(&buf, bundleCids[i], bundleBlocks[i])
+		require.NoError(t, err)
+	}
+	err = os.WriteFile(bundlePath, buf.Bytes(), 0o644)
+	require.NoError(t, err)
+
+	_, err = p2.ImportBundle(ctx, bundlePath)
+	require.ErrorIs(t, err, ErrBundleMissingBlock)
+
+	bs := datastore.BlockstoreFrom(db2.Rootstore())
+	for _, c := range append(bundleCids, headCid) {
+		has, err := bs.Has(ctx, c)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+}
@@ -65,7 +65,7 @@ func (p *Peer) AddP2PCollections(ctx context.Context, collectionNames ...string)
 
 	txn.OnSuccess(func() {
 		for _, col := range storeCollections {
-			_, err := p.server.addPubSubTopic(col.SchemaRoot(), true, nil)
+			_, err := p.server.addPubSubTopic(col.SchemaRoot(), true, nil, nil)
 			if err != nil {
 				log.ErrorE("Failed to add pubsub topic.", err)
 			}
@@ -223,7 +223,7 @@ func (p *Peer) loadAndPublishP2PCollections(ctx context.Context) error {
 		return err
 	}
 	for _, id := range collectionIDs {
-		_, err := p.server.addPubSubTopic(id, true, nil)
+		_, err := p.server.addPubSubTopic(id, true, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -49,7 +49,7 @@ func (p *Peer) AddP2PDocuments(ctx context.Context, docIDs ...string) error {
 
 	txn.OnSuccess(func() {
 		for _, docID := range docIDs {
-			_, err := p.server.addPubSubTopic(docID, true, nil)
+			_, err := p.server.addPubSubTopic(docID, true, nil, nil)
 			if err != nil {
 				log.ErrorE("Failed to add pubsub topic.", err)
 			}
@@ -140,7 +140,7 @@ func (p *Peer) loadAndPublishP2PDocuments(ctx context.Context) error {
 		return err
 	}
 	for _, docID := range docIDs {
-		_, err := p.server.addPubSubTopic(docID, true, nil)
+		_, err := p.server.addPubSubTopic(docID, true, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -39,6 +39,9 @@ func (p *Peer) SyncDocuments(ctx context.Context, collectionName string, docIDs
 	}
 
 	collectionID := cols[0].Version().CollectionID
+	// Document syncs are explicitly requested so their transfers are scheduled
+	// before the ones of background replication.
+	ctx = withTransferPriority(ctx, interactivePriority)
 	_, err = p.server.syncDocuments(ctx, collectionID, docIDs)
 	return err
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/ipfs/boxo/blockservice"
 	"github.com/ipfs/boxo/bootstrap"
 	blocks "github.com/ipfs/go-block-format"
+	"github.com/libp2p/go-libp2p"
 	gostream "github.com/libp2p/go-libp2p-gostream"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	libp2pevent "github.com/libp2p/go-libp2p/core/event"
@@ -48,6 +49,12 @@ import (
 
 var tracer = telemetry.NewTracer()
 
+// pubsubQueueSize is the size of the pubsub validation and outbound queues.
+//
+// Every document created or updated produces a message, the default size of 32
+// would drop messages from peers writing in bulk.
+const pubsubQueueSize = 1024
+
 // DB hold the database related methods that are required by Peer.
 type DB interface {
 	NewTxn(ctx context.Context, readOnly bool) (client.Txn, error)
@@ -75,16 +82,35 @@ type Peer struct {
 
 	// peer DAG service
 	blockService blockservice.BlockService
+	// scheduler limits the block transfers made with other peers
+	scheduler *transferScheduler
+	// reputation rate limits and bans misbehaving peers
+	reputation *peerReputation
+	// maxBlockSize is the maximum size of the blocks pushed by other peers
+	maxBlockSize int
 
 	documentACP immutable.Option[dac.DocumentACP]
 	db          DB
 
 	bootCloser io.Closer
 
+	// mdns is the local network discovery service. It is only set if mDNS is enabled.
+	mdns io.Closer
+
 	// The intervals at which to retry replicator failures.
 	// For example, this can define an exponential backoff strategy.
 	retryIntervals   []time.Duration
 	handleRetryMutex *sync.Mutex
+
+	// isPrivateNetwork is true if the peer joined a private network with a pre-shared key.
+	isPrivateNetwork bool
+	// enableTopicEncryption is true if the payloads published to document
+	// and collection topics must be encrypted.
+	//
+	// Topic names are not encrypted, document topics are still named after their document ID.
+	enableTopicEncryption bool
+	topicKeys             TopicKeyProvider
+	topicKeysMu           sync.RWMutex
 }
 
 var _ client.P2P = (*Peer)(nil)
@@ -124,7 +150,13 @@ func NewPeer(
 		peers[i] = *addr
 	}
 
-	h, ddht, err := setupHost(ctx, options)
+	reputation := newPeerReputation(
+		float64(options.PeerMessageRate),
+		options.BanThreshold,
+		options.BanDuration,
+	)
+
+	h, ddht, err := setupHost(ctx, options, libp2p.ConnectionGater(reputation))
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +179,18 @@ func NewPeer(
 		p2pRPC:           grpc.NewServer(options.GRPCServerOptions...),
 		retryIntervals:   options.RetryIntervals,
 		handleRetryMutex: &sync.Mutex{},
+		reputation:       reputation,
+		maxBlockSize:     options.MaxBlockSize,
+		scheduler: newTransferScheduler(
+			options.MaxTransfers,
+			options.MaxTransfersPerPeer,
+			options.MaxTransferBandwidth,
+		),
+
+		isPrivateNetwork:      len(options.PrivateNetworkKey) > 0,
+		enableTopicEncryption: options.EnableTopicEncryption,
 	}
+	reputation.onBan = p.handlePeerBanned
 
 	if options.EnablePubSub {
 		p.ps, err = pubsub.NewGossipSub(
@@ -155,6 +198,8 @@ func NewPeer(
 			h,
 			pubsub.WithPeerExchange(true),
 			pubsub.WithFloodPublish(true),
+			pubsub.WithValidateQueueSize(pubsubQueueSize),
+			pubsub.WithPeerOutboundQueueSize(pubsubQueueSize),
 		)
 		if err != nil {
 			return nil, err
@@ -174,7 +219,14 @@ func NewPeer(
 
 	bs := datastore.BlockstoreFrom(db.Rootstore())
 	bswapnet := bsnet.NewFromIpfsHost(h)
-	bswap := bitswap.New(ctx, bswapnet, ddht, bs, bitswap.WithPeerBlockRequestFilter(p.server.hasAccess))
+	bswap := bitswap.New(
+		ctx,
+		bswapnet,
+		ddht,
+		bs,
+		bitswap.WithPeerBlockRequestFilter(p.server.hasAccess),
+		bitswap.WithTracer(p.scheduler),
+	)
 	p.blockService = blockservice.New(bs, bswap)
 
 	p2pListener, err := gostream.Listen(h, corenet.Protocol)
@@ -187,6 +239,13 @@ func NewPeer(
 		return nil, err
 	}
 
+	if options.EnableMDNS {
+		err = p.setupMDNS()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// register the P2P gRPC server
 	go func() {
 		registerServiceServer(p.p2pRPC, p.server)
@@ -241,6 +300,13 @@ func (p *Peer) Close() {
 		}
 	}
 
+	if p.mdns != nil {
+		// close local discovery service
+		if err := p.mdns.Close(); err != nil {
+			log.ErrorE("Error closing mDNS service", err)
+		}
+	}
+
 	if p.server != nil {
 		// close topics
 		if err := p.server.removeAllPubsubTopics(); err != nil {
@@ -393,3 +459,10 @@ func (p *Peer) PeerInfo() peer.AddrInfo {
 func (p *Peer) Server() *server {
 	return p.server
 }
+
+// IsPrivateNetwork returns true if the peer joined a private network.
+//
+// Only the peers that know the pre-shared key of a private network can connect to it.
+func (p *Peer) IsPrivateNetwork() bool {
+	return p.isPrivateNetwork
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/sourcenetwork/corelog"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/event"
+)
+
+// The penalties added to the score of a peer when it misbehaves.
+//
+// Peers are banned once their score reaches the ban threshold.
+const (
+	penaltyRateLimited      = 1
+	penaltyInvalidMessage   = 20
+	penaltyOversizedBlock   = 20
+	penaltyInvalidSignature = 50
+)
+
+// scoreDecayPerSecond is the number of points removed from the score of a peer every second
+// so that occasional failures of well behaved peers never lead to a ban.
+const scoreDecayPerSecond = 1.0
+
+// messageBurstSeconds is the number of seconds worth of messages a peer can send at once
+// before being rate limited, so that bulk writes of honest peers are not dropped.
+const messageBurstSeconds = 10
+
+const (
+	// peerStateIdleTimeout is the amount of time after which the state of a peer that is
+	// not banned and has not been seen is forgotten.
+	//
+	// With the default configuration, it is longer than the time it takes for the score and
+	// message rate of a peer to be fully restored, so forgetting the state of a peer does
+	// not change its reputation.
+	peerStateIdleTimeout = 10 * time.Minute
+	// peerStatePruneInterval is the minimum amount of time between two prunings of the
+	// idle peer states.
+	peerStatePruneInterval = time.Minute
+	// maxPeerStates is the maximum number of peer states that are tracked at once. When
+	// reached, the state of the least recently seen peer that is not banned is forgotten.
+	maxPeerStates = 10_000
+)
+
+// peerState is the reputation state of a single peer.
+type peerState struct {
+	limiter     *rate.Limiter
+	score       float64
+	updatedAt   time.Time
+	seenAt      time.Time
+	bannedUntil time.Time
+}
+
+// peerReputation rate limits the messages received from peers and tracks their
+// misbehaviour to temporarily ban them.
+//
+// It implements the libp2p connection gater interface so that connections with
+// banned peers are refused.
+type peerReputation struct {
+	messageRate  float64
+	banThreshold int
+	banDuration  time.Duration
+	// onBan is called, without holding the lock, when a peer gets banned.
+	onBan func(peerID libpeer.ID, score int, until time.Time)
+
+	mu       sync.Mutex
+	peers    map[libpeer.ID]*peerState
+	prunedAt time.Time
+}
+
+var _ connmgr.ConnectionGater = (*peerReputation)(nil)
+
+// newPeerReputation returns a new peer reputation tracker.
+//
+// A message rate of zero disables rate limiting and a ban threshold of zero disables bans.
+func newPeerReputation(messageRate float64, banThreshold int, banDuration time.Duration) *peerReputation {
+	return &peerReputation{
+		messageRate:  messageRate,
+		banThreshold: banThreshold,
+		banDuration:  banDuration,
+		peers:        make(map[libpeer.ID]*peerState),
+	}
+}
+
+// allowMessage returns an error if messages from the given peer must be dropped
+// because it is banned or has exceeded its message rate.
+func (r *peerReputation) allowMessage(peerID libpeer.ID) error {
+	r.mu.Lock()
+	state := r.getState(peerID)
+	if r.isBannedLocked(state) {
+		r.mu.Unlock()
+		return NewErrPeerBanned(peerID, state.bannedUntil)
+	}
+	if state.limiter == nil || state.limiter.Allow() {
+		r.mu.Unlock()
+		return nil
+	}
+	r.mu.Unlock()
+
+	r.penalize(peerID, penaltyRateLimited)
+	return NewErrPeerRateLimited(peerID)
+}
+
+// penalize adds the given penalty to the score of the peer and bans it
+// if the score reaches the ban threshold.
+func (r *peerReputation) penalize(peerID libpeer.ID, penalty int) {
+	if peerID == "" {
+		return
+	}
+
+	r.mu.Lock()
+	state := r.getState(peerID)
+	if r.isBannedLocked(state) {
+		r.mu.Unlock()
+		return
+	}
+	r.decayLocked(state)
+	state.score += float64(penalty)
+	if r.banThreshold <= 0 || state.score < float64(r.banThreshold) {
+		r.mu.Unlock()
+		return
+	}
+	score := int(state.score)
+	state.score = 0
+	state.bannedUntil = time.Now().Add(r.banDuration)
+	until := state.bannedUntil
+	r.mu.Unlock()
+
+	if r.onBan != nil {
+		r.onBan(peerID, score, until)
+	}
+}
+
+// isBanned returns true if the given peer is currently banned.
+func (r *peerReputation) isBanned(peerID libpeer.ID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.peers[peerID]
+	return ok && r.isBannedLocked(state)
+}
+
+// bannedPeers returns the list of the currently banned peers.
+func (r *peerReputation) bannedPeers() []client.BannedPeer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := []client.BannedPeer{}
+	for peerID, state := range r.peers {
+		if !r.isBannedLocked(state) {
+			continue
+		}
+		result = append(result, client.BannedPeer{
+			ID:    peerID.String(),
+			Until: state.bannedUntil,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+func (r *peerReputation) getState(peerID libpeer.ID) *peerState {
+	now := time.Now()
+	state, ok := r.peers[peerID]
+	if ok {
+		state.seenAt = now
+		return state
+	}
+	r.pruneLocked(now)
+	state = &peerState{updatedAt: now, seenAt: now}
+	if r.messageRate > 0 {
+		burst := max(int(r.messageRate*messageBurstSeconds), 1)
+		state.limiter = rate.NewLimiter(rate.Limit(r.messageRate), burst)
+	}
+	r.peers[peerID] = state
+	return state
+}
+
+// pruneLocked forgets the state of the idle peers that are not banned so that peers
+// changing their ID can not grow the tracked states without bound.
+func (r *peerReputation) pruneLocked(now time.Time) {
+	if now.Sub(r.prunedAt) >= peerStatePruneInterval {
+		r.prunedAt = now
+		for peerID, state := range r.peers {
+			if !r.isBannedLocked(state) && now.Sub(state.seenAt) >= peerStateIdleTimeout {
+				delete(r.peers, peerID)
+			}
+		}
+	}
+	if len(r.peers) < maxPeerStates {
+		return
+	}
+	var oldestID libpeer.ID
+	var oldest *peerState
+	for peerID, state := range r.peers {
+		if r.isBannedLocked(state) {
+			continue
+		}
+		if oldest == nil || state.seenAt.Before(oldest.seenAt) {
+			oldestID, oldest = peerID, state
+		}
+	}
+	if oldest != nil {
+		delete(r.peers, oldestID)
+	}
+}
+
+func (r *peerReputation) isBannedLocked(state *peerState) bool {
+	return time.Now().Before(state.bannedUntil)
+}
+
+func (r *peerReputation) decayLocked(state *peerState) {
+	now := time.Now()
+	elapsed := now.Sub(state.updatedAt).Seconds()
+	state.score = max(state.score-elapsed*scoreDecayPerSecond, 0)
+	state.updatedAt = now
+}
+
+// InterceptPeerDial refuses to dial banned peers.
+func (r *peerReputation) InterceptPeerDial(peerID libpeer.ID) bool {
+	return !r.isBanned(peerID)
+}
+
+// InterceptAddrDial refuses to dial banned peers.
+func (r *peerReputation) InterceptAddrDial(peerID libpeer.ID, _ ma.Multiaddr) bool {
+	return !r.isBanned(peerID)
+}
+
+// InterceptAccept accepts all incoming connections as the remote peer is not known yet.
+func (r *peerReputation) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured refuses the connections of banned peers once their identity is known.
+func (r *peerReputation) InterceptSecured(_ network.Direction, peerID libpeer.ID, _ network.ConnMultiaddrs) bool {
+	return !r.isBanned(peerID)
+}
+
+// InterceptUpgraded accepts all upgraded connections as they have already been secured.
+func (r *peerReputation) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+func (p *Peer) GetBannedPeers(ctx context.Context) ([]client.BannedPeer, error) {
+	return p.reputation.bannedPeers(), nil
+}
+
+// handlePeerBanned closes the connections with a banned peer and notifies the event bus.
+func (p *Peer) handlePeerBanned(peerID libpeer.ID, score int, until time.Time) {
+	log.InfoContext(p.ctx, "Banned misbehaving peer",
+		corelog.Any("PeerID", peerID),
+		corelog.Int("Score", score),
+		corelog.Any("Until", until))
+
+	if err := p.host.Network().ClosePeer(peerID); err != nil {
+		log.ErrorContextE(p.ctx, "Failed to close connections with banned peer", err,
+			corelog.Any("PeerID", peerID))
+	}
+
+	p.bus.Publish(event.NewMessage(event.PeerBannedName, event.PeerBanned{
+		Peer:  peerID,
+		Score: score,
+		Until: until,
+	}))
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerReputation_WithScoreBelowThreshold_ShouldNotBan(t *testing.T) {
+	r := newPeerReputation(0, 100, time.Minute)
+	peerID := libpeer.ID("peer1")
+
+	r.penalize(peerID, penaltyInvalidSignature)
+
+	require.False(t, r.isBanned(peerID))
+	require.NoError(t, r.allowMessage(peerID))
+	require.True(t, r.InterceptPeerDial(peerID))
+}
+
+func TestPeerReputation_WithScoreReachingThreshold_ShouldBan(t *testing.T) {
+	r := newPeerReputation(0, 100, time.Minute)
+	peerID := libpeer.ID("peer1")
+
+	var bannedPeer libpeer.ID
+	r.onBan = func(peerID libpeer.ID, score int, until time.Time) {
+		bannedPeer = peerID
+	}
+	// the score decays between penalties so two of them may not be enough
+	for i := 0; i < 3; i++ {
+		r.penalize(peerID, penaltyInvalidSignature)
+	}
+
+	require.Equal(t, peerID, bannedPeer)
+	require.True(t, r.isBanned(peerID))
+	require.ErrorIs(t, r.allowMessage(peerID), ErrPeerBanned)
+	require.False(t, r.InterceptPeerDial(peerID))
+	require.False(t, r.InterceptSecured(0, peerID, nil))
+
+	bannedPeers := r.bannedPeers()
+	require.Len(t, bannedPeers, 1)
+	require.Equal(t, peerID.String(), bannedPeers[0].ID)
+}
+
+func TestPeerReputation_WithBanExpired_ShouldAllow(t *testing.T) {
+	r := newPeerReputation(0, 10, time.Millisecond)
+	peerID := libpeer.ID("peer1")
+
+	r.penalize(peerID, penaltyInvalidMessage)
+	require.True(t, r.isBanned(peerID))
+
+	require.Eventually(t, func() bool { return !r.isBanned(peerID) }, time.Second, time.Millisecond)
+	require.NoError(t, r.allowMessage(peerID))
+	require.Empty(t, r.bannedPeers())
+}
+
+func TestPeerReputation_WithZeroThreshold_ShouldNeverBan(t *testing.T) {
+	r := newPeerReputation(0, 0, time.Minute)
+	peerID := libpeer.ID("peer1")
+
+	for i := 0; i < 10; i++ {
+		r.penalize(peerID, penaltyInvalidSignature)
+	}
+
+	require.False(t, r.isBanned(peerID))
+}
+
+func TestPeerReputation_WithMessageRateExceeded_ShouldRateLimit(t *testing.T) {
+	r := newPeerReputation(1, 100, time.Minute)
+	peerID := libpeer.ID("peer1")
+
+	for i := 0; i < messageBurstSeconds; i++ {
+		require.NoError(t, r.allowMessage(peerID))
+	}
+	require.ErrorIs(t, r.allowMessage(peerID), ErrPeerRateLimited)
+
+	require.NoError(t, r.allowMessage(libpeer.ID("peer2")))
+}
+
+func TestPeerReputation_WithIdlePeers_ShouldForgetPeersThatAreNotBanned(t *testing.T) {
+	r := newPeerReputation(0, 10, time.Hour)
+
+	require.NoError(t, r.allowMessage(libpeer.ID("peer1")))
+	r.penalize(libpeer.ID("peer2"), penaltyInvalidMessage)
+	require.True(t, r.isBanned(libpeer.ID("peer2")))
+
+	r.mu.Lock()
+	r.pruneLocked(time.Now().Add(peerStateIdleTimeout))
+	r.mu.Unlock()
+
+	require.NotContains(t, r.peers, libpeer.ID("peer1"))
+	require.Contains(t, r.peers, libpeer.ID("peer2"))
+	require.True(t, r.isBanned(libpeer.ID("peer2")))
+}
+
+func TestPeerReputation_WithMaxPeerStatesReached_ShouldForgetLeastRecentlySeenPeer(t *testing.T) {
+	r := newPeerReputation(0, 0, time.Minute)
+
+	for i := 0; i < maxPeerStates; i++ {
+		require.NoError(t, r.allowMessage(libpeer.ID(fmt.Sprintf("peer%d", i))))
+	}
+	r.peers[libpeer.ID("peer0")].seenAt = time.Now().Add(-time.Second)
+	require.NoError(t, r.allowMessage(libpeer.ID(fmt.Sprintf("peer%d", maxPeerStates))))
+
+	require.Len(t, r.peers, maxPeerStates)
+	require.NotContains(t, r.peers, libpeer.ID("peer0"))
+	require.Contains(t, r.peers, libpeer.ID(fmt.Sprintf("peer%d", maxPeerStates)))
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"sync"
+
+	bsmsg "github.com/ipfs/boxo/bitswap/message"
+	bstracer "github.com/ipfs/boxo/bitswap/tracer"
+	"github.com/ipfs/go-cid"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+)
+
+// defaultExpectedBlockSize is the bandwidth reserved for a block before it is fetched
+// while no block has been fetched yet.
+const defaultExpectedBlockSize = 1024
+
+// transferPriority is the priority of a block transfer.
+//
+// Transfers with a higher priority are always scheduled before the ones with a lower priority.
+type transferPriority int
+
+const (
+	// backgroundPriority is the priority of transfers resulting from background
+	// replication such as replicator pushes and pubsub merges.
+	backgroundPriority transferPriority = iota
+	// interactivePriority is the priority of transfers explicitly requested by a user
+	// such as document syncs.
+	interactivePriority
+
+	transferPriorityCount
+)
+
+type transferPriorityContextKey struct{}
+
+type transferPeerContextKey struct{}
+
+// withTransferPriority returns a new context with the given transfer priority.
+func withTransferPriority(ctx context.Context, priority transferPriority) context.Context {
+	return context.WithValue(ctx, transferPriorityContextKey{}, priority)
+}
+
+// transferPriorityFromContext returns the transfer priority set on the given context.
+//
+// If no priority is set, the background priority is returned.
+func transferPriorityFromContext(ctx context.Context) transferPriority {
+	priority, ok := ctx.Value(transferPriorityContextKey{}).(transferPriority)
+	if !ok {
+		return backgroundPriority
+	}
+	return priority
+}
+
+// withTransferPeer returns a new context with the ID of the peer the transfers are made with.
+func withTransferPeer(ctx context.Context, peerID libpeer.ID) context.Context {
+	return context.WithValue(ctx, transferPeerContextKey{}, peerID)
+}
+
+// transferPeerFromContext returns the ID of the peer set on the given context.
+//
+// When walking a DAG, this is the peer that sent the parent block, as it is the peer most likely
+// to send its links. If no peer is set, an empty ID is returned and the transfer will be
+// accounted with all other transfers of unknown peers.
+func transferPeerFromContext(ctx context.Context) libpeer.ID {
+	peerID, _ := ctx.Value(transferPeerContextKey{}).(libpeer.ID)
+	return peerID
+}
+
+// transferWaiter is a transfer waiting to be scheduled.
+type transferWaiter struct {
+	peerID libpeer.ID
+	ready  chan struct{}
+}
+
+// transferScheduler limits the number of concurrent block transfers and the
+// bandwidth they use.
+//
+// Waiting transfers are scheduled by priority and in order of arrival. When a peer
+// has reached its maximum number of concurrent transfers, the transfers of other
+// peers are scheduled first so that a single peer cannot use all the capacity.
+//
+// The scheduler traces the blocks received by bitswap so that the transfers are
+// accounted to the peers that actually sent the blocks.
+type transferScheduler struct {
+	maxTransfers        int
+	maxTransfersPerPeer int
+	limiter             *rate.Limiter
+
+	mu            sync.Mutex
+	active        int
+	activePerPeer map[libpeer.ID]int
+	waiting       [transferPriorityCount][]*transferWaiter
+	// expectedBlockSize is the moving average of the raw size of the fetched blocks.
+	expectedBlockSize int
+	// blockSources maps the CIDs of the blocks being fetched to the peer that sent them,
+	// or to an empty ID if they have not been received yet.
+	blockSources map[cid.Cid]libpeer.ID
+}
+
+var _ bstracer.Tracer = (*transferScheduler)(nil)
+
+// newTransferScheduler returns a new transfer scheduler.
+//
+// A value of zero for any of the limits means that no limit is applied.
+func newTransferScheduler(maxTransfers, maxTransfersPerPeer, maxBandwidth int) *transferScheduler {
+	s := &transferScheduler{
+		maxTransfers:        maxTransfers,
+		maxTransfersPerPeer: maxTransfersPerPeer,
+		activePerPeer:       make(map[libpeer.ID]int),
+		expectedBlockSize:   defaultExpectedBlockSize,
+		blockSources:        make(map[cid.Cid]libpeer.ID),
+	}
+	if maxBandwidth > 0 {
+		s.limiter = rate.NewLimiter(rate.Limit(maxBandwidth), maxBandwidth)
+	}
+	return s
+}
+
+// acquire waits until a transfer with the priority and peer set on the context can start.
+//
+// The returned function must be called once the transfer is complete.
+func (s *transferScheduler) acquire(ctx context.Context) (func(), error) {
+	w := &transferWaiter{
+		peerID: transferPeerFromContext(ctx),
+		ready:  make(chan struct{}),
+	}
+	priority := transferPriorityFromContext(ctx)
+
+	s.mu.Lock()
+	s.waiting[priority] = append(s.waiting[priority], w)
+	s.schedule()
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return func() { s.release(w.peerID) }, nil
+
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		select {
+		case <-w.ready:
+			// The transfer was scheduled while the context was being cancelled
+			// so we need to give its slot back.
+			s.releaseLocked(w.peerID)
+		default:
+			s.removeWaiter(priority, w)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// waitBandwidth waits until the given number of bytes can be transferred without
+// exceeding the bandwidth limit.
+func (s *transferScheduler) waitBandwidth(ctx context.Context, size int) error {
+	if s.limiter == nil {
+		return nil
+	}
+	// Blocks larger than the limiter burst are accounted for in multiple chunks.
+	for size > 0 {
+		n := min(size, s.limiter.Burst())
+		if err := s.limiter.WaitN(ctx, n); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
+
+// reserveBandwidth waits until a block of the expected size can be fetched without exceeding
+// the bandwidth limit and returns the reserved size.
+//
+// Once the block has been fetched the reservation must be settled with [settleBandwidth].
+func (s *transferScheduler) reserveBandwidth(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	reserved := s.expectedBlockSize
+	s.mu.Unlock()
+
+	return reserved, s.waitBandwidth(ctx, reserved)
+}
+
+// settleBandwidth accounts for the difference between the reserved bandwidth and the raw size
+// of the fetched block.
+func (s *transferScheduler) settleBandwidth(ctx context.Context, reserved, size int) error {
+	s.mu.Lock()
+	s.expectedBlockSize = max((s.expectedBlockSize*7+size)/8, 1)
+	s.mu.Unlock()
+
+	if size <= reserved {
+		return nil
+	}
+	return s.waitBandwidth(ctx, size-reserved)
+}
+
+// expectBlock registers the given CID as being fetched so that the peer sending it is recorded.
+func (s *transferScheduler) expectBlock(c cid.Cid) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blockSources[c] = ""
+}
+
+// takeBlockSource returns the peer that sent the block with the given CID and stops
+// recording it.
+func (s *transferScheduler) takeBlockSource(c cid.Cid) libpeer.ID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	source := s.blockSources[c]
+	delete(s.blockSources, c)
+	return source
+}
+
+// MessageReceived is called by bitswap when a message is received from a peer.
+func (s *transferScheduler) MessageReceived(from libpeer.ID, msg bsmsg.BitSwapMessage) {
+	blks := msg.Blocks()
+	if len(blks) == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, block := range blks {
+		if _, ok := s.blockSources[block.Cid()]; ok {
+			s.blockSources[block.Cid()] = from
+		}
+	}
+}
+
+// MessageSent is called by bitswap when a message is sent to a peer.
+func (s *transferScheduler) MessageSent(libpeer.ID, bsmsg.BitSwapMessage) {}
+
+func (s *transferScheduler) release(peerID libpeer.ID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.releaseLocked(peerID)
+}
+
+func (s *transferScheduler) releaseLocked(peerID libpeer.ID) {
+	s.active--
+	s.activePerPeer[peerID]--
+	if s.activePerPeer[peerID] <= 0 {
+		delete(s.activePerPeer, peerID)
+	}
+	s.schedule()
+}
+
+// schedule starts as many waiting transfers as the limits allow.
+//
+// This must be called while holding the lock.
+func (s *transferScheduler) schedule() {
+	for priority := transferPriorityCount - 1; priority >= 0; priority-- {
+		queue := s.waiting[priority]
+		remaining := queue[:0]
+		for _, w := range queue {
+			if !s.canStart(w.peerID) {
+				remaining = append(remaining, w)
+				continue
+			}
+			s.active++
+			s.activePerPeer[w.peerID]++
+			close(w.ready)
+		}
+		s.waiting[priority] = remaining
+		if s.maxTransfers > 0 && s.active >= s.maxTransfers {
+			return
+		}
+	}
+}
+
+func (s *transferScheduler) canStart(peerID libpeer.ID) bool {
+	if s.maxTransfers > 0 && s.active >= s.maxTransfers {
+		return false
+	}
+	if s.maxTransfersPerPeer > 0 && s.activePerPeer[peerID] >= s.maxTransfersPerPeer {
+		return false
+	}
+	return true
+}
+
+func (s *transferScheduler) removeWaiter(priority transferPriority, w *transferWaiter) {
+	queue := s.waiting[priority]
+	for i, other := range queue {
+		if other == w {
+			s.waiting[priority] = append(queue[:i], queue[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bsmsg "github.com/ipfs/boxo/bitswap/message"
+	blocks "github.com/ipfs/go-block-format"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferScheduler_WithNoLimits_ShouldNotBlock(t *testing.T) {
+	s := newTransferScheduler(0, 0, 0)
+
+	for i := 0; i < 100; i++ {
+		_, err := s.acquire(context.Background())
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.waitBandwidth(context.Background(), 1<<20))
+}
+
+func TestTransferScheduler_WithMaxTransfersReached_ShouldWaitForRelease(t *testing.T) {
+	s := newTransferScheduler(1, 0, 0)
+
+	release, err := s.acquire(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release()
+
+	_, err = s.acquire(context.Background())
+	require.NoError(t, err)
+}
+
+func TestTransferScheduler_WithMaxTransfersPerPeerReached_ShouldScheduleOtherPeers(t *testing.T) {
+	s := newTransferScheduler(0, 1, 0)
+
+	_, err := s.acquire(withTransferPeer(context.Background(), libpeer.ID("peer1")))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.acquire(withTransferPeer(ctx, libpeer.ID("peer1")))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = s.acquire(withTransferPeer(context.Background(), libpeer.ID("peer2")))
+	require.NoError(t, err)
+}
+
+func TestTransferScheduler_WithWaitingTransfers_ShouldScheduleInteractiveFirst(t *testing.T) {
+	s := newTransferScheduler(1, 0, 0)
+
+	release, err := s.acquire(context.Background())
+	require.NoError(t, err)
+
+	order := make(chan transferPriority, 2)
+	acquire := func(priority transferPriority) {
+		release, err := s.acquire(withTransferPriority(context.Background(), priority))
+		if err == nil {
+			order <- priority
+			release()
+		}
+	}
+	go acquire(backgroundPriority)
+	require.Eventually(t, func() bool { return waitingCount(s) == 1 }, time.Second, time.Millisecond)
+	go acquire(interactivePriority)
+	require.Eventually(t, func() bool { return waitingCount(s) == 2 }, time.Second, time.Millisecond)
+
+	release()
+
+	require.Equal(t, interactivePriority, <-order)
+	require.Equal(t, backgroundPriority, <-order)
+}
+
+func waitingCount(s *transferScheduler) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, queue := range s.waiting {
+		count += len(queue)
+	}
+	return count
+}
+
+func TestTransferScheduler_SettleBandwidth_ShouldUpdateExpectedBlockSize(t *testing.T) {
+	s := newTransferScheduler(0, 0, 0)
+
+	reserved, err := s.reserveBandwidth(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, defaultExpectedBlockSize, reserved)
+
+	require.NoError(t, s.settleBandwidth(context.Background(), reserved, 9*defaultExpectedBlockSize))
+
+	reserved, err = s.reserveBandwidth(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2*defaultExpectedBlockSize, reserved)
+}
+
+func TestTransferScheduler_SettleBandwidthOfLargerBlock_ShouldWaitForDifference(t *testing.T) {
+	s := newTransferScheduler(0, 0, 1000)
+
+	reserved, err := s.reserveBandwidth(context.Background())
+	require.NoError(t, err)
+
+	// The limiter burst was consumed by the reservation so the difference
+	// can not be transferred before the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = s.settleBandwidth(ctx, reserved, reserved+1000)
+	require.Error(t, err)
+}
+
+func TestTransferScheduler_MessageReceived_ShouldRecordSourceOfExpectedBlocks(t *testing.T) {
+	s := newTransferScheduler(0, 0, 0)
+
+	expected := blocks.NewBlock([]byte("expected"))
+	other := blocks.NewBlock([]byte("other"))
+	s.expectBlock(expected.Cid())
+
+	msg := bsmsg.New(false)
+	msg.AddBlock(expected)
+	msg.AddBlock(other)
+	s.MessageReceived(libpeer.ID("peer1"), msg)
+
+	require.Equal(t, libpeer.ID("peer1"), s.takeBlockSource(expected.Cid()))
+	require.Equal(t, libpeer.ID(""), s.takeBlockSource(other.Cid()))
+	require.Empty(t, s.blockSources)
+}
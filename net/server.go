@@ -20,6 +20,7 @@ import (
 
 	"github.com/fxamacker/cbor/v2"
 	cid "github.com/ipfs/go-cid"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	libpeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
@@ -33,6 +34,7 @@ import (
 	"github.com/sourcenetwork/defradb/acp/identity"
 	acpTypes "github.com/sourcenetwork/defradb/acp/types"
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -73,6 +75,8 @@ type server struct {
 type pubsubTopic struct {
 	*rpc.Topic
 	subscribed bool
+	// validated is true if a validator has been registered for the topic.
+	validated bool
 }
 
 // newServer creates a new network server that handle/directs RPC requests to the
@@ -95,7 +99,7 @@ func newServer(p *Peer, opts ...grpc.DialOption) (*server, error) {
 
 	s.opts = append(defaultOpts, opts...)
 
-	docSyncTopic, err := s.addPubSubTopic(docSyncTopic, true, s.docSyncMessageHandler)
+	docSyncTopic, err := s.addPubSubTopic(docSyncTopic, true, s.docSyncMessageHandler, s.validateDocSyncMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +111,13 @@ func newServer(p *Peer, opts ...grpc.DialOption) (*server, error) {
 
 // pushLogHandler receives a push log request from the grpc server (replicator)
 func (s *server) pushLogHandler(ctx context.Context, req *pushLogRequest) (*pushLogReply, error) {
+	pid, err := peerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.peer.reputation.allowMessage(pid); err != nil {
+		return nil, err
+	}
 	return s.processPushlog(ctx, req, true)
 }
 
@@ -120,17 +131,21 @@ func (s *server) processPushlog(
 	if err != nil {
 		return nil, err
 	}
+	// Pubsub messages may have been forwarded by a peer that is not their author. They have
+	// been checked against their author by the topic validator, so only the verified creator
+	// of the log is penalized if its DAG turns out to be invalid.
+	offender := pid
+	if !isReplicator {
+		offender, _ = libpeer.Decode(req.Creator)
+	}
+	if penalty, err := s.checkPushLogRequest(req); err != nil {
+		s.peer.reputation.penalize(offender, penalty)
+		return nil, err
+	}
 	headCID, err := cid.Cast(req.CID)
 	if err != nil {
 		return nil, err
 	}
-
-	if req.DocID != "" {
-		_, err := client.NewDocIDFromString(req.DocID)
-		if err != nil {
-			return nil, err
-		}
-	}
 	byPeer, err := libpeer.Decode(req.Creator)
 	if err != nil {
 		return nil, err
@@ -158,8 +173,12 @@ func (s *server) processPushlog(
 		corelog.Any("Creator", byPeer.String()),
 		corelog.Any("DocID", req.DocID))
 
-	err = syncDAG(ctx, s.peer.blockService, block)
+	ctx = withTransferPeer(ctx, pid)
+	err = syncDAG(ctx, s.peer.blockService, s.peer.scheduler, block)
 	if err != nil {
+		if errors.Is(err, crypto.ErrSignatureVerification) {
+			s.peer.reputation.penalize(offender, penaltyInvalidSignature)
+		}
 		return nil, err
 	}
 
@@ -178,6 +197,29 @@ func (s *server) processPushlog(
 	return &pushLogReply{}, nil
 }
 
+// checkPushLogRequest returns an error, and the penalty of the sending peer, if the given
+// push log request is malformed.
+func (s *server) checkPushLogRequest(req *pushLogRequest) (int, error) {
+	if _, err := cid.Cast(req.CID); err != nil {
+		return penaltyInvalidMessage, err
+	}
+	if req.DocID != "" {
+		if _, err := client.NewDocIDFromString(req.DocID); err != nil {
+			return penaltyInvalidMessage, err
+		}
+	}
+	if _, err := libpeer.Decode(req.Creator); err != nil {
+		return penaltyInvalidMessage, err
+	}
+	if s.peer.maxBlockSize > 0 && len(req.Block) > s.peer.maxBlockSize {
+		return penaltyOversizedBlock, NewErrBlockTooLarge(len(req.Block), s.peer.maxBlockSize)
+	}
+	if _, err := coreblock.GetFromBytes(req.Block); err != nil {
+		return penaltyInvalidMessage, err
+	}
+	return 0, nil
+}
+
 // getIdentityHandler receives a get identity request and returns the identity token
 // with the requesting peer as the audience.
 func (s *server) getIdentityHandler(
@@ -196,8 +238,16 @@ func (s *server) getIdentityHandler(
 
 // addPubSubTopic subscribes to a topic on the pubsub network
 // A custom message handler can be provided to handle incoming messages. If not provided,
-// the default message handler will be used.
-func (s *server) addPubSubTopic(topic string, subscribe bool, handler rpc.MessageHandler) (pubsubTopic, error) {
+// the default message handler and validator will be used.
+//
+// The validator, if any, is run on every message before it is handled or forwarded to
+// other peers.
+func (s *server) addPubSubTopic(
+	topic string,
+	subscribe bool,
+	handler rpc.MessageHandler,
+	validator pubsub.ValidatorEx,
+) (pubsubTopic, error) {
 	if s.peer.ps == nil {
 		return pubsubTopic{}, nil
 	}
@@ -206,41 +256,53 @@ func (s *server) addPubSubTopic(topic string, subscribe bool, handler rpc.Messag
 		corelog.String("PeerID", s.peer.PeerID().String()),
 		corelog.String("Topic", topic))
 
+	if handler == nil {
+		handler = s.pubSubMessageHandler
+		validator = s.validatePushLogMessage
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if t, ok := s.topics[topic]; ok {
+	existing, exists := s.topics[topic]
+	validated := existing.validated
+	if exists {
 		// When the topic was previously set to publish only and we now want to subscribe,
 		// we need to close the existing topic and create a new one.
-		if !t.subscribed && subscribe {
-			if err := t.Close(); err != nil {
-				return pubsubTopic{}, err
-			}
-		} else {
-			return t, nil
+		if existing.subscribed || !subscribe {
+			return existing, nil
+		}
+		if err := existing.Close(); err != nil {
+			return pubsubTopic{}, err
+		}
+	} else if validator != nil {
+		if err := s.peer.ps.RegisterTopicValidator(topic, validator); err != nil {
+			return pubsubTopic{}, err
 		}
+		validated = true
 	}
 
 	t, err := rpc.NewTopic(s.peer.ctx, s.peer.ps, s.peer.host.ID(), topic, subscribe)
 	if err != nil {
+		delete(s.topics, topic)
+		if validated {
+			_ = s.peer.ps.UnregisterTopicValidator(topic)
+		}
 		return pubsubTopic{}, err
 	}
 
-	if handler == nil {
-		handler = s.pubSubMessageHandler
-	}
-
 	t.SetEventHandler(s.pubSubEventHandler)
 	t.SetMessageHandler(handler)
 	pst := pubsubTopic{
 		Topic:      t,
 		subscribed: subscribe,
+		validated:  validated,
 	}
 	s.topics[topic] = pst
 	return pst, nil
 }
 
 func (s *server) AddPubSubTopic(topicName string, handler rpc.MessageHandler) error {
-	_, err := s.addPubSubTopic(topicName, true, handler)
+	_, err := s.addPubSubTopic(topicName, true, handler, nil)
 	return err
 }
 
@@ -258,7 +320,7 @@ func (s *server) removePubSubTopic(topic string) error {
 	defer s.mu.Unlock()
 	if t, ok := s.topics[topic]; ok {
 		delete(s.topics, topic)
-		return t.Close()
+		return s.closePubSubTopic(topic, t)
 	}
 	return nil
 }
@@ -275,13 +337,23 @@ func (s *server) removeAllPubsubTopics() error {
 	defer s.mu.Unlock()
 	for id, t := range s.topics {
 		delete(s.topics, id)
-		if err := t.Close(); err != nil {
+		if err := s.closePubSubTopic(id, t); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// closePubSubTopic closes the given topic and unregisters its validator.
+func (s *server) closePubSubTopic(topic string, t pubsubTopic) error {
+	if t.validated {
+		if err := s.peer.ps.UnregisterTopicValidator(topic); err != nil {
+			return err
+		}
+	}
+	return t.Close()
+}
+
 // publishLog publishes the given PushLogRequest object on the PubSub network via the
 // corresponding topic
 func (s *server) publishLog(ctx context.Context, topic string, req *pushLogRequest) error {
@@ -294,6 +366,13 @@ func (s *server) publishLog(ctx context.Context, topic string, req *pushLogReque
 		return errors.Wrap("failed to marshal pubsub message", err)
 	}
 
+	if s.peer.enableTopicEncryption {
+		data, err = s.peer.encryptTopicMessage(ctx, req.CollectionID, data)
+		if err != nil {
+			return NewErrPushLog(err, errors.NewKV("Topic", topic))
+		}
+	}
+
 	log.InfoContext(ctx, "Publish log",
 		corelog.String("PeerID", s.peer.PeerID().String()),
 		corelog.String("Topic", topic))
@@ -335,15 +414,17 @@ func (s *server) publishDirectToTopic(ctx context.Context, topic string, data []
 }
 
 // pubSubMessageHandler handles incoming PushLog messages from the pubsub network.
+//
+// The given peer is the peer that forwarded the message, which may not be its author.
+// Messages have already been checked against their author by [validatePushLogMessage].
 func (s *server) pubSubMessageHandler(from libpeer.ID, topic string, msg []byte) ([]byte, error) {
 	log.Info("Received new pubsub event",
 		corelog.String("PeerID", s.peer.PeerID().String()),
 		corelog.Any("SenderId", from),
 		corelog.String("Topic", topic))
 
-	req := &pushLogRequest{}
-	if err := cbor.Unmarshal(msg, req); err != nil {
-		log.ErrorE("Failed to unmarshal pubsub message %s", err)
+	req, err := s.decodePushLogMessage(s.peer.ctx, msg)
+	if err != nil {
 		return nil, err
 	}
 	ctx := grpcpeer.NewContext(s.peer.ctx, &grpcpeer.Peer{
@@ -355,6 +436,66 @@ func (s *server) pubSubMessageHandler(from libpeer.ID, topic string, msg []byte)
 	return nil, nil
 }
 
+// validatePushLogMessage is the pubsub validator of the document and collection topics.
+//
+// Messages are rate limited and checked against their author rather than against the peer
+// that forwarded them, and invalid messages are rejected so that they are not propagated
+// any further.
+func (s *server) validatePushLogMessage(
+	ctx context.Context,
+	_ libpeer.ID,
+	msg *pubsub.Message,
+) pubsub.ValidationResult {
+	author := msg.GetFrom()
+	if author == s.peer.host.ID() {
+		return pubsub.ValidationAccept
+	}
+	if err := s.peer.reputation.allowMessage(author); err != nil {
+		return pubsub.ValidationIgnore
+	}
+
+	req, err := s.decodePushLogMessage(ctx, msg.Data)
+	if err != nil {
+		log.ErrorContextE(ctx, "Rejected invalid pubsub message", err, corelog.Any("Author", author))
+		s.peer.reputation.penalize(author, penaltyInvalidMessage)
+		return pubsub.ValidationReject
+	}
+	penalty, err := s.checkPushLogRequest(req)
+	if err == nil && req.Creator != author.String() {
+		penalty, err = penaltyInvalidMessage, NewErrPushLogCreatorMismatch(req.Creator, author)
+	}
+	if err != nil {
+		log.ErrorContextE(ctx, "Rejected invalid pubsub message", err, corelog.Any("Author", author))
+		s.peer.reputation.penalize(author, penalty)
+		return pubsub.ValidationReject
+	}
+	return pubsub.ValidationAccept
+}
+
+// decodePushLogMessage decrypts, if topic encryption is enabled, and decodes the given
+// pubsub message.
+func (s *server) decodePushLogMessage(ctx context.Context, msg []byte) (*pushLogRequest, error) {
+	// The collection ID the topic key was generated for. This is used to ensure
+	// that a key can't be used to publish logs of other collections.
+	var keyCollectionID string
+	if s.peer.enableTopicEncryption {
+		var err error
+		msg, keyCollectionID, err = s.peer.decryptTopicMessage(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req := &pushLogRequest{}
+	if err := cbor.Unmarshal(msg, req); err != nil {
+		return nil, err
+	}
+	if s.peer.enableTopicEncryption && req.CollectionID != keyCollectionID {
+		return nil, NewErrTopicKeyCollectionMismatch(req.CollectionID, keyCollectionID)
+	}
+	return req, nil
+}
+
 // pubSubEventHandler logs events from the subscribed DocID topics.
 func (s *server) pubSubEventHandler(from libpeer.ID, topic string, msg []byte) {
 	log.Info("Received new pubsub event",
@@ -429,6 +570,7 @@ func (s *server) SendPubSubMessage(
 	ctx context.Context,
 	topic string,
 	data []byte,
+	opts ...rpc.PublishOption,
 ) (<-chan rpc.Response, error) {
 	s.mu.Lock()
 	t, ok := s.topics[topic]
@@ -436,7 +578,7 @@ func (s *server) SendPubSubMessage(
 	if !ok {
 		return nil, NewErrTopicDoesNotExist(topic)
 	}
-	return t.Publish(ctx, data)
+	return t.Publish(ctx, data, opts...)
 }
 
 // hasAccess checks if the requesting peer has access to the given cid.
@@ -537,13 +679,13 @@ func (s *server) hasAccess(p libpeer.ID, c cid.Cid) bool {
 		s.peer.documentACP.Value(),
 		cols[0], // For now we assume there is only one collection.
 		acpTypes.DocumentReadPerm,
+		permission.OperationSync,
 		string(block.Delta.GetDocID()),
 	)
 	if err != nil {
 		log.ErrorE("Failed to check access", err)
 		return false
 	}
-
 	return peerHasAccess
 }
 
@@ -591,6 +733,7 @@ func (s *server) trySelfHasAccess(block *coreblock.Block, p2pID string) (bool, e
 		s.peer.documentACP.Value(),
 		cols[0], // For now we assume there is only one collection.
 		acpTypes.DocumentReadPerm,
+		permission.OperationSync,
 		string(block.Delta.GetDocID()),
 	)
 	if err != nil {
@@ -626,6 +769,30 @@ func (s *server) docSyncMessageHandler(from libpeer.ID, topic string, msg []byte
 	return cbor.Marshal(reply)
 }
 
+// validateDocSyncMessage is the pubsub validator of the document sync topic.
+//
+// Requests are rate limited per author, and malformed requests are rejected so that they
+// are not propagated any further.
+func (s *server) validateDocSyncMessage(
+	ctx context.Context,
+	_ libpeer.ID,
+	msg *pubsub.Message,
+) pubsub.ValidationResult {
+	author := msg.GetFrom()
+	if author == s.peer.host.ID() {
+		return pubsub.ValidationAccept
+	}
+	if err := s.peer.reputation.allowMessage(author); err != nil {
+		return pubsub.ValidationIgnore
+	}
+	req := &docSyncRequest{}
+	if err := cbor.Unmarshal(msg.Data, req); err != nil {
+		s.peer.reputation.penalize(author, penaltyInvalidMessage)
+		return pubsub.ValidationReject
+	}
+	return pubsub.ValidationAccept
+}
+
 // processDocSyncItem processes a single document sync request and returns the result.
 func (s *server) processDocSyncItem(docID string) (docSyncItem, error) {
 	txn, err := s.peer.db.NewTxn(s.peer.ctx, true)
@@ -49,9 +49,17 @@ func makeLinkSystem(blockService blockservice.BlockService) linking.LinkSystem {
 // syncDAG synchronizes the DAG starting with the given block
 // using the blockservice to fetch remote blocks.
 //
+// Remote fetches are throttled by the given scheduler using the transfer priority and peer
+// set on the context.
+//
 // This process walks the entire DAG until the issue below is resolved.
 // https://github.com/sourcenetwork/defradb/issues/2722
-func syncDAG(ctx context.Context, blockService blockservice.BlockService, block *coreblock.Block) error {
+func syncDAG(
+	ctx context.Context,
+	blockService blockservice.BlockService,
+	scheduler *transferScheduler,
+	block *coreblock.Block,
+) error {
 	// use a session to make remote fetches more efficient
 	ctx = blockservice.ContextWithSession(ctx, blockService)
 
@@ -63,7 +71,7 @@ func syncDAG(ctx context.Context, blockService blockservice.BlockService, block
 		return err
 	}
 
-	err = loadBlockLinks(ctx, &linkSystem, block)
+	err = loadBlockLinks(ctx, blockService, &linkSystem, scheduler, block)
 	if err != nil {
 		return err
 	}
@@ -74,7 +82,13 @@ func syncDAG(ctx context.Context, blockService blockservice.BlockService, block
 //
 // If it encounters errors in the concurrent loading of links, it will return
 // the first error it encountered.
-func loadBlockLinks(ctx context.Context, linkSys *linking.LinkSystem, block *coreblock.Block) error {
+func loadBlockLinks(
+	ctx context.Context,
+	blockService blockservice.BlockService,
+	linkSys *linking.LinkSystem,
+	scheduler *transferScheduler,
+	block *coreblock.Block,
+) error {
 	ctxWithCancel, cancel := context.WithCancel(ctx)
 	defer cancel()
 	var wg sync.WaitGroup
@@ -105,20 +119,19 @@ func loadBlockLinks(ctx context.Context, linkSys *linking.LinkSystem, block *cor
 			if ctxWithCancel.Err() != nil {
 				return
 			}
-			ctxWithTimeout, cancel := context.WithTimeout(ctx, syncBlockLinkTimeout)
-			defer cancel()
-			nd, err := linkSys.Load(linking.LinkContext{Ctx: ctxWithTimeout}, lnk, coreblock.BlockSchemaPrototype)
-			if err != nil {
-				asyncErrOnce.Do(func() { setAsyncErr(err) })
-				return
-			}
-			linkBlock, err := coreblock.GetFromNode(nd)
+			linkBlock, source, err := loadBlockLink(ctxWithCancel, blockService, scheduler, lnk)
 			if err != nil {
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
 				return
 			}
 
-			err = loadBlockLinks(ctx, linkSys, linkBlock)
+			// The links of the block are most likely held by the peer that sent it,
+			// so their transfers are accounted to that peer.
+			linkCtx := ctx
+			if source != "" {
+				linkCtx = withTransferPeer(ctx, source)
+			}
+			err = loadBlockLinks(linkCtx, blockService, linkSys, scheduler, linkBlock)
 			if err != nil {
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
 				return
@@ -131,6 +144,62 @@ func loadBlockLinks(ctx context.Context, linkSys *linking.LinkSystem, block *cor
 	return asyncErr
 }
 
+// loadBlockLink loads the block of the given link and returns it along with the ID of the peer
+// that sent it.
+//
+// Blocks that are not stored locally are only fetched once the scheduler allows the transfer
+// and the bandwidth for the block has been reserved. The transfer slot is released before the
+// links of the loaded block are walked so that deep DAGs cannot exhaust the available slots.
+func loadBlockLink(
+	ctx context.Context,
+	blockService blockservice.BlockService,
+	scheduler *transferScheduler,
+	lnk cidlink.Link,
+) (*coreblock.Block, libpeer.ID, error) {
+	isLocal, err := blockService.Blockstore().Has(ctx, lnk.Cid)
+	if err != nil {
+		return nil, "", err
+	}
+	if isLocal {
+		rawBlock, err := blockService.Blockstore().Get(ctx, lnk.Cid)
+		if err != nil {
+			return nil, "", err
+		}
+		linkBlock, err := coreblock.GetFromBytes(rawBlock.RawData())
+		return linkBlock, "", err
+	}
+
+	release, err := scheduler.acquire(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+
+	reserved, err := scheduler.reserveBandwidth(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scheduler.expectBlock(lnk.Cid)
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, syncBlockLinkTimeout)
+	defer cancel()
+	rawBlock, err := blockService.GetBlock(ctxWithTimeout, lnk.Cid)
+	source := scheduler.takeBlockSource(lnk.Cid)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = scheduler.settleBandwidth(ctx, reserved, len(rawBlock.RawData()))
+	if err != nil {
+		return nil, "", err
+	}
+	linkBlock, err := coreblock.GetFromBytes(rawBlock.RawData())
+	if err != nil {
+		return nil, "", err
+	}
+	return linkBlock, source, nil
+}
+
 // syncDocuments requests document synchronization from the network.
 func (s *server) syncDocuments(
 	ctx context.Context,
@@ -257,7 +326,7 @@ func (s *server) syncDocumentAndMerge(
 	collectionID, docID string,
 	head cid.Cid,
 ) error {
-	err := s.syncDocumentDAG(ctx, head)
+	err := s.syncDocumentDAG(withTransferPeer(ctx, sender), head)
 
 	if err != nil {
 		return err
@@ -276,17 +345,13 @@ func (s *server) syncDocumentAndMerge(
 
 // syncDocumentDAG synchronizes the DAG for a specific document CID.
 func (s *server) syncDocumentDAG(ctx context.Context, docCid cid.Cid) error {
-	linkSys := makeLinkSystem(s.peer.blockService)
-
-	nd, err := linkSys.Load(linking.LinkContext{Ctx: ctx}, cidlink.Link{Cid: docCid}, coreblock.BlockSchemaPrototype)
+	linkBlock, source, err := loadBlockLink(ctx, s.peer.blockService, s.peer.scheduler, cidlink.Link{Cid: docCid})
 	if err != nil {
 		return err
 	}
-
-	linkBlock, err := coreblock.GetFromNode(nd)
-	if err != nil {
-		return err
+	if source != "" {
+		ctx = withTransferPeer(ctx, source)
 	}
 
-	return syncDAG(ctx, s.peer.blockService, linkBlock)
+	return syncDAG(ctx, s.peer.blockService, s.peer.scheduler, linkBlock)
 }
@@ -0,0 +1,100 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/sourcenetwork/defradb/crypto"
+)
+
+// TopicKeyProvider provides the group keys used to encrypt the payloads
+// published to document and collection pubsub topics.
+type TopicKeyProvider interface {
+	// GetTopicKey returns the ID and value of the group key to use to encrypt
+	// payloads published for the given collection.
+	GetTopicKey(ctx context.Context, collectionID string) ([]byte, []byte, error)
+	// GetTopicKeyByID returns the collection ID and value of the group key with the given ID.
+	GetTopicKeyByID(ctx context.Context, keyID []byte) (string, []byte, error)
+}
+
+// encryptedTopicMessage is the envelope of a pubsub payload encrypted with a topic key.
+type encryptedTopicMessage struct {
+	// KeyID is the ID of the topic key used to encrypt the payload.
+	KeyID []byte
+	// Payload is the encrypted payload with the nonce prepended.
+	Payload []byte
+}
+
+// SetTopicKeyProvider sets the provider of the keys used to encrypt pubsub topic payloads.
+//
+// This must be called before any log is published if topic encryption is enabled.
+func (p *Peer) SetTopicKeyProvider(provider TopicKeyProvider) {
+	p.topicKeysMu.Lock()
+	defer p.topicKeysMu.Unlock()
+	p.topicKeys = provider
+}
+
+func (p *Peer) getTopicKeyProvider() (TopicKeyProvider, error) {
+	p.topicKeysMu.RLock()
+	defer p.topicKeysMu.RUnlock()
+	if p.topicKeys == nil {
+		return nil, ErrTopicKeyProviderNotSet
+	}
+	return p.topicKeys, nil
+}
+
+// encryptTopicMessage encrypts the given data with the topic key of the given collection.
+func (p *Peer) encryptTopicMessage(ctx context.Context, collectionID string, data []byte) ([]byte, error) {
+	provider, err := p.getTopicKeyProvider()
+	if err != nil {
+		return nil, err
+	}
+	keyID, key, err := provider.GetTopicKey(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	payload, _, err := crypto.EncryptAES(data, key, keyID, true)
+	if err != nil {
+		return nil, err
+	}
+	return cbor.Marshal(&encryptedTopicMessage{
+		KeyID:   keyID,
+		Payload: payload,
+	})
+}
+
+// decryptTopicMessage decrypts the given topic message and returns the decrypted data
+// along with the ID of the collection the topic key belongs to.
+func (p *Peer) decryptTopicMessage(ctx context.Context, msg []byte) ([]byte, string, error) {
+	envelope := &encryptedTopicMessage{}
+	if err := cbor.Unmarshal(msg, envelope); err != nil {
+		return nil, "", err
+	}
+	if len(envelope.KeyID) == 0 {
+		return nil, "", ErrUnencryptedTopicMessage
+	}
+	provider, err := p.getTopicKeyProvider()
+	if err != nil {
+		return nil, "", err
+	}
+	collectionID, key, err := provider.GetTopicKeyByID(ctx, envelope.KeyID)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := crypto.DecryptAES(nil, envelope.Payload, key, envelope.KeyID)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, collectionID, nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"crypto/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/acp/dac"
+	"github.com/sourcenetwork/defradb/internal/datastore"
+	"github.com/sourcenetwork/defradb/internal/db"
+	"github.com/sourcenetwork/defradb/internal/kms"
+	"github.com/sourcenetwork/defradb/net/config"
+)
+
+// topicKeysTopic is the pubsub topic the KMS exchanges topic keys on.
+const topicKeysTopic = "encryption-topic-keys"
+
+func newTestPrivateNetworkKey(t *testing.T) []byte {
+	psk := make([]byte, privateNetworkKeySize)
+	_, err := rand.Read(psk)
+	require.NoError(t, err)
+	return psk
+}
+
+// newTestTopicEncryptionPeer creates a peer with topic encryption enabled along with the KMS
+// service providing its topic keys.
+func newTestTopicEncryptionPeer(
+	ctx context.Context,
+	t *testing.T,
+	database *db.DB,
+	psk []byte,
+	topicKeyPeers ...libpeer.ID,
+) (*Peer, TopicKeyProvider) {
+	opts := []config.NodeOpt{
+		config.WithListenAddresses(randomMultiaddr),
+		config.WithEnableTopicEncryption(true),
+	}
+	if len(psk) > 0 {
+		opts = append(opts, config.WithPrivateNetworkKey(psk))
+	}
+	p, err := NewPeer(ctx, database.Events(), immutable.None[dac.DocumentACP](), database, opts...)
+	require.NoError(t, err)
+
+	kmsService, err := kms.NewPubSubService(
+		ctx,
+		p.PeerID(),
+		p.Server(),
+		database.Events(),
+		datastore.EncstoreFrom(database.Rootstore()),
+		datastore.SystemstoreFrom(database.Rootstore()),
+		immutable.None[dac.DocumentACP](),
+		db.NewCollectionRetriever(database),
+		"",
+		kms.TopicKeyAccess{
+			Peers:          topicKeyPeers,
+			PrivateNetwork: p.IsPrivateNetwork(),
+		},
+	)
+	require.NoError(t, err)
+	p.SetTopicKeyProvider(kmsService)
+
+	return p, kmsService
+}
+
+// connectTopicKeyPeers connects the given peers and waits for them to join each other
+// on the topic keys pubsub topic.
+func connectTopicKeyPeers(ctx context.Context, t *testing.T, p1, p2 *Peer) {
+	err := p2.host.Connect(ctx, p1.PeerInfo())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return slices.Contains(p1.ps.ListPeers(topicKeysTopic), p2.PeerID()) &&
+			slices.Contains(p2.ps.ListPeers(topicKeysTopic), p1.PeerID())
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestTopicEncryption_WithinPrivateNetwork_ShouldDecryptMessageOfOtherPeer(t *testing.T) {
+	ctx := context.Background()
+	psk := newTestPrivateNetworkKey(t)
+
+	db1 := newTestDB(ctx, t)
+	defer db1.Close()
+	p1, _ := newTestTopicEncryptionPeer(ctx, t, db1, psk)
+	defer p1.Close()
+
+	db2 := newTestDB(ctx, t)
+	defer db2.Close()
+	p2, _ := newTestTopicEncryptionPeer(ctx, t, db2, psk)
+	defer p2.Close()
+
+	connectTopicKeyPeers(ctx, t, p1, p2)
+
+	msg, err := p1.encryptTopicMessage(ctx, "collectionID", []byte("payload"))
+	require.NoError(t, err)
+	require.NotContains(t, string(msg), "payload")
+
+	// The second peer does not have the topic key yet and must request it from the first one.
+	data, collectionID, err := p2.decryptTopicMessage(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []byte("payload"), data)
+	require.Equal(t, "collectionID", collectionID)
+}
+
+func TestTopicEncryption_WithAllowedPeer_ShouldShareTopicKey(t *testing.T) {
+	ctx := context.Background()
+
+	db2 := newTestDB(ctx, t)
+	defer db2.Close()
+	p2, kms2 := newTestTopicEncryptionPeer(ctx, t, db2, nil)
+	defer p2.Close()
+
+	db1 := newTestDB(ctx, t)
+	defer db1.Close()
+	p1, kms1 := newTestTopicEncryptionPeer(ctx, t, db1, nil, p2.PeerID())
+	defer p1.Close()
+
+	connectTopicKeyPeers(ctx, t, p1, p2)
+
+	keyID, key, err := kms1.GetTopicKey(ctx, "collectionID")
+	require.NoError(t, err)
+
+	collectionID, receivedKey, err := kms2.GetTopicKeyByID(ctx, keyID)
+	require.NoError(t, err)
+	require.Equal(t, "collectionID", collectionID)
+	require.Equal(t, key, receivedKey)
+}
+
+func TestTopicEncryption_WithoutAllowedPeersOnPublicNetwork_ShouldNotShareTopicKey(t *testing.T) {
+	ctx := context.Background()
+
+	db1 := newTestDB(ctx, t)
+	defer db1.Close()
+	p1, kms1 := newTestTopicEncryptionPeer(ctx, t, db1, nil)
+	defer p1.Close()
+
+	db2 := newTestDB(ctx, t)
+	defer db2.Close()
+	p2, kms2 := newTestTopicEncryptionPeer(ctx, t, db2, nil)
+	defer p2.Close()
+
+	connectTopicKeyPeers(ctx, t, p1, p2)
+
+	keyID, _, err := kms1.GetTopicKey(ctx, "collectionID")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	_, _, err = kms2.GetTopicKeyByID(ctx, keyID)
+	require.ErrorIs(t, err, kms.ErrTopicKeyNotFound)
+}
+
+func TestTopicEncryption_WithTopicKeyOfOtherCollection_ShouldError(t *testing.T) {
+	ctx := context.Background()
+
+	database := newTestDB(ctx, t)
+	defer database.Close()
+	p, _ := newTestTopicEncryptionPeer(ctx, t, database, nil)
+	defer p.Close()
+
+	data, err := cbor.Marshal(&pushLogRequest{
+		DocID:        "docID",
+		CollectionID: "otherCollectionID",
+		Creator:      p.PeerID().String(),
+		Block:        emptyBlock(),
+	})
+	require.NoError(t, err)
+
+	msg, err := p.encryptTopicMessage(ctx, "collectionID", data)
+	require.NoError(t, err)
+
+	_, err = p.server.pubSubMessageHandler(p.PeerID(), "docID", msg)
+	require.ErrorIs(t, err, ErrTopicKeyCollectionMismatch)
+}
+
+func TestTopicEncryption_WithUnencryptedMessage_ShouldError(t *testing.T) {
+	ctx := context.Background()
+
+	database := newTestDB(ctx, t)
+	defer database.Close()
+	p, _ := newTestTopicEncryptionPeer(ctx, t, database, nil)
+	defer p.Close()
+
+	data, err := cbor.Marshal(&pushLogRequest{
+		DocID:        "docID",
+		CollectionID: "collectionID",
+		Creator:      p.PeerID().String(),
+		Block:        emptyBlock(),
+	})
+	require.NoError(t, err)
+
+	_, err = p.server.pubSubMessageHandler(p.PeerID(), "docID", data)
+	require.ErrorIs(t, err, ErrUnencryptedTopicMessage)
+}
+
+func TestTopicEncryption_AfterRestart_ShouldUseSameTopicKey(t *testing.T) {
+	ctx := context.Background()
+
+	database := newTestDB(ctx, t)
+	defer database.Close()
+
+	p, kmsService := newTestTopicEncryptionPeer(ctx, t, database, nil)
+	keyID, key, err := kmsService.GetTopicKey(ctx, "collectionID")
+	require.NoError(t, err)
+	p.Close()
+
+	p, kmsService = newTestTopicEncryptionPeer(ctx, t, database, nil)
+	defer p.Close()
+	restartedKeyID, restartedKey, err := kmsService.GetTopicKey(ctx, "collectionID")
+	require.NoError(t, err)
+	require.Equal(t, keyID, restartedKeyID)
+	require.Equal(t, key, restartedKey)
+}
@@ -23,6 +23,7 @@ import (
 // - `NodeOpt`
 // - `StoreOpt`
 // - `db.Option`
+// - `http.HandlerOpt`
 // - `http.ServerOpt`
 // - `net.NodeOpt`
 type Option any
@@ -33,6 +34,7 @@ type Config struct {
 	disableAPI        bool
 	enableDevelopment bool
 	kmsType           immutable.Option[kms.ServiceType]
+	topicKeyPeers     []string
 }
 
 // DefaultConfig returns a Config with default settings.
@@ -63,6 +65,16 @@ func WithKMS(kms kms.ServiceType) NodeOpt {
 	}
 }
 
+// WithTopicKeyPeers sets the IDs of the peers allowed to receive the KMS topic keys.
+//
+// If no peers are set, only the peers of the private network the node joined can receive
+// the topic keys. Topic keys are not shared at all if the node is not on a private network.
+func WithTopicKeyPeers(peers ...string) NodeOpt {
+	return func(o *Config) {
+		o.topicKeyPeers = peers
+	}
+}
+
 // WithEnableDevelopment sets the enable development mode flag.
 func WithEnableDevelopment(enable bool) NodeOpt {
 	return func(o *Config) {
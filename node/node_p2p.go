@@ -17,6 +17,8 @@ package node
 import (
 	"context"
 
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
+
 	"github.com/sourcenetwork/defradb/internal/datastore"
 	"github.com/sourcenetwork/defradb/internal/db"
 	"github.com/sourcenetwork/defradb/internal/kms"
@@ -45,21 +47,35 @@ func (n *Node) startP2P(ctx context.Context) error {
 		return err
 	}
 	if n.config.kmsType.HasValue() {
+		topicKeyPeers := make([]libpeer.ID, len(n.config.topicKeyPeers))
+		for i, p := range n.config.topicKeyPeers {
+			topicKeyPeers[i], err = libpeer.Decode(p)
+			if err != nil {
+				return err
+			}
+		}
 		switch n.config.kmsType.Value() {
 		case kms.PubSubServiceType:
-			n.kmsService, err = kms.NewPubSubService(
+			kmsService, err := kms.NewPubSubService(
 				ctx,
 				peer.PeerID(),
 				peer.Server(),
 				n.DB.Events(),
 				datastore.EncstoreFrom(n.DB.Rootstore()),
+				datastore.SystemstoreFrom(n.DB.Rootstore()),
 				n.DB.DocumentACP(),
 				db.NewCollectionRetriever(n.DB),
 				ident.Value().DID,
+				kms.TopicKeyAccess{
+					Peers:          topicKeyPeers,
+					PrivateNetwork: peer.IsPrivateNetwork(),
+				},
 			)
-		}
-		if err != nil {
-			return err
+			if err != nil {
+				return err
+			}
+			n.kmsService = kmsService
+			peer.SetTopicKeyProvider(kmsService)
 		}
 	}
 	return nil
@@ -67,6 +67,7 @@ func assertCollectionVersions(
 		require.Equal(s.T, expected.Name, actual.Name)
 		require.Equal(s.T, expected.IsMaterialized, actual.IsMaterialized)
 		require.Equal(s.T, expected.IsBranchable, actual.IsBranchable)
+		require.Equal(s.T, expected.IsEncrypted, actual.IsEncrypted)
 		require.Equal(s.T, expected.IsActive, actual.IsActive)
 
 		if expected.Indexes != nil || len(actual.Indexes) != 0 {
@@ -12,6 +12,7 @@ package cwrap
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -40,6 +41,7 @@ func setupTests(n int, identityPrivateKey string, enableNAC bool) {
 	nodeOpts.DisableAPI = 0
 	nodeOpts.InMemory = 1
 	nodeOpts.IdentityPrivateKey = identityPrivateKey
+	nodeOpts.BlindIndexSecret = hex.EncodeToString([]byte("test-blind-index-secret"))
 	if identityPrivateKey != "" {
 		if enableNAC {
 			nodeOpts.EnableNodeACP = 1
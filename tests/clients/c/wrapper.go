@@ -197,6 +197,21 @@ func (w *CWrapper) SyncDocuments(
 	return nil
 }
 
+func (w *CWrapper) GetBannedPeers(ctx context.Context) ([]client.BannedPeer, error) {
+	panic("not implemented")
+}
+
+func (w *CWrapper) ExportBundle(
+	ctx context.Context,
+	config client.BundleExportConfig,
+) (client.BundleExportResult, error) {
+	panic("not implemented")
+}
+
+func (w *CWrapper) ImportBundle(ctx context.Context, filepath string) (client.BundleImportResult, error) {
+	panic("not implemented")
+}
+
 func (w *CWrapper) BasicImport(ctx context.Context, filepath string) error {
 	panic("not implemented")
 }
@@ -303,6 +318,63 @@ func (w *CWrapper) DeleteDACActorRelationship(
 	return deleteRelationshipRes, nil
 }
 
+func (w *CWrapper) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	txnID := txnIDFromContext(ctx)
+	identity := identityFromContext(ctx)
+
+	batchJSON, err := json.Marshal(batch)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	result := cbindings.ACPAddDACActorRelationships(w.nodeNum, identity, string(batchJSON), txnID)
+	if result.Status != 0 {
+		return client.ActorRelationshipBatchResult{}, errors.New(result.Error)
+	}
+	return unmarshalResult[client.ActorRelationshipBatchResult](result.Value)
+}
+
+func (w *CWrapper) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	txnID := txnIDFromContext(ctx)
+	identity := identityFromContext(ctx)
+
+	batchJSON, err := json.Marshal(batch)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	result := cbindings.ACPDeleteDACActorRelationships(w.nodeNum, identity, string(batchJSON), txnID)
+	if result.Status != 0 {
+		return client.ActorRelationshipBatchResult{}, errors.New(result.Error)
+	}
+	return unmarshalResult[client.ActorRelationshipBatchResult](result.Value)
+}
+
+func (w *CWrapper) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	txnID := txnIDFromContext(ctx)
+	identity := identityFromContext(ctx)
+
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := cbindings.ACPGetACPAuditLog(w.nodeNum, identity, string(queryJSON), txnID)
+	if result.Status != 0 {
+		return nil, errors.New(result.Error)
+	}
+	return unmarshalResult[[]client.ACPAuditRecord](result.Value)
+}
+
 func (w *CWrapper) GetNACStatus(ctx context.Context) (client.NACStatusResult, error) {
 	txnID := txnIDFromContext(ctx)
 	identity := identityFromContext(ctx)
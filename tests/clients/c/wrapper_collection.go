@@ -193,6 +193,60 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) RotateEncryptionKey(
+	ctx context.Context,
+	docID client.DocID,
+	opts ...client.EncryptionKeyRotationOption,
+) error {
+	rotationOpts := client.EncryptionKeyRotationOptions{}
+	rotationOpts.Apply(opts)
+
+	var copts cbindings.GoCOptions
+	copts.TxID = txnIDFromContext(ctx)
+	copts.Version = ""
+	copts.CollectionID = ""
+	copts.Name = c.def.GetName()
+	copts.Identity = identityFromContext(ctx)
+	copts.GetInactive = 0
+
+	result := cbindings.CollectionRotateKey(
+		c.nodeNum,
+		docID.String(),
+		strings.Join(rotationOpts.Fields, ","),
+		rotationOpts.ReEncrypt,
+		copts,
+	)
+
+	if result.Status != 0 {
+		return errors.New(result.Error)
+	}
+	return nil
+}
+
+func (c *Collection) MigrateDocuments(
+	ctx context.Context,
+	batchSize int,
+) (client.DocumentMigrationProgress, error) {
+	var copts cbindings.GoCOptions
+	copts.TxID = txnIDFromContext(ctx)
+	copts.Version = c.def.Version.VersionID
+	copts.CollectionID = ""
+	copts.Name = ""
+	copts.Identity = identityFromContext(ctx)
+	copts.GetInactive = 1
+
+	result := cbindings.CollectionMigrateDocuments(c.nodeNum, batchSize, copts)
+
+	if result.Status != 0 {
+		return client.DocumentMigrationProgress{}, errors.New(result.Error)
+	}
+	var progress client.DocumentMigrationProgress
+	if err := json.Unmarshal([]byte(result.Value), &progress); err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	return progress, nil
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
@@ -382,7 +436,7 @@ func (c *Collection) CreateIndex(
 	}
 	fields := strings.Join(orderedFields, ",")
 
-	result := cbindings.IndexCreate(c.nodeNum, name, indexDesc.Name, fields, indexDesc.Unique, txnID)
+	result := cbindings.IndexCreate(c.nodeNum, name, indexDesc.Name, fields, indexDesc.Unique, indexDesc.Blind, txnID)
 
 	if result.Status != 0 {
 		return client.IndexDescription{}, errors.New(result.Error)
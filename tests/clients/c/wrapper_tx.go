@@ -79,6 +79,30 @@ func (txn *Transaction) DeleteDACActorRelationship(
 	return txn.CWrapper.DeleteDACActorRelationship(ctx, collectionName, docID, relation, targetActor)
 }
 
+func (txn *Transaction) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
+	return txn.CWrapper.AddDACActorRelationships(ctx, batch)
+}
+
+func (txn *Transaction) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
+	return txn.CWrapper.DeleteDACActorRelationships(ctx, batch)
+}
+
+func (txn *Transaction) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
+	return txn.CWrapper.GetACPAuditLog(ctx, query)
+}
+
 func (txn *Transaction) GetNodeIdentity(ctx context.Context) (immutable.Option[identity.PublicRawIdentity], error) {
 	ctx = datastore.CtxSetFromClientTxn(ctx, txn)
 	return txn.CWrapper.GetNodeIdentity(ctx)
@@ -200,6 +200,66 @@ func (w *Wrapper) SyncDocuments(
 	return err
 }
 
+func (w *Wrapper) GetBannedPeers(ctx context.Context) ([]client.BannedPeer, error) {
+	args := []string{"client", "p2p", "info"}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var info struct {
+		BannedPeers []client.BannedPeer
+	}
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+	return info.BannedPeers, nil
+}
+
+func (w *Wrapper) ExportBundle(
+	ctx context.Context,
+	config client.BundleExportConfig,
+) (client.BundleExportResult, error) {
+	args := []string{"client", "p2p", "bundle", "export"}
+	if len(config.Collections) > 0 {
+		args = append(args, "--collections", strings.Join(config.Collections, ","))
+	}
+	if config.Since != "" {
+		args = append(args, "--since", config.Since)
+	}
+	args = append(args, config.Filepath)
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.BundleExportResult{}, err
+	}
+	var res client.BundleExportResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return client.BundleExportResult{}, err
+	}
+	return res, nil
+}
+
+func (w *Wrapper) ImportBundle(ctx context.Context, filepath string) (client.BundleImportResult, error) {
+	args := []string{"client", "p2p", "bundle", "import"}
+
+	deadline, hasDeadline := ctx.Deadline()
+	if hasDeadline {
+		args = append(args, "--timeout", time.Until(deadline).String())
+	}
+	args = append(args, filepath)
+
+	data, err := w.cmd.execute(context.Background(), args)
+	if err != nil {
+		return client.BundleImportResult{}, err
+	}
+	var res client.BundleImportResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return client.BundleImportResult{}, err
+	}
+	return res, nil
+}
+
 func (w *Wrapper) BasicImport(ctx context.Context, filepath string) error {
 	args := []string{"client", "backup", "import"}
 	args = append(args, filepath)
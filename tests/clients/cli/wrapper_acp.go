@@ -13,6 +13,9 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sourcenetwork/defradb/client"
 )
@@ -93,6 +96,114 @@ func (w *Wrapper) DeleteDACActorRelationship(
 	return exists, err
 }
 
+func (w *Wrapper) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	args := []string{
+		"client", "acp", "document", "relationship", "batch", "add",
+		"--collection", batch.CollectionName,
+		"--relation", batch.Relation,
+		"--actor", batch.TargetActor,
+	}
+	if len(batch.DocIDs) > 0 {
+		args = append(args, "--docID", strings.Join(batch.DocIDs, ","))
+	}
+	if batch.Filter != nil {
+		filter, err := json.Marshal(batch.Filter)
+		if err != nil {
+			return client.ActorRelationshipBatchResult{}, err
+		}
+		args = append(args, "--filter", string(filter))
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	var batchResult client.ActorRelationshipBatchResult
+	if err := json.Unmarshal(data, &batchResult); err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	return batchResult, nil
+}
+
+func (w *Wrapper) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	args := []string{
+		"client", "acp", "document", "relationship", "batch", "delete",
+		"--collection", batch.CollectionName,
+		"--relation", batch.Relation,
+		"--actor", batch.TargetActor,
+	}
+	if len(batch.DocIDs) > 0 {
+		args = append(args, "--docID", strings.Join(batch.DocIDs, ","))
+	}
+	if batch.Filter != nil {
+		filter, err := json.Marshal(batch.Filter)
+		if err != nil {
+			return client.ActorRelationshipBatchResult{}, err
+		}
+		args = append(args, "--filter", string(filter))
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	var batchResult client.ActorRelationshipBatchResult
+	if err := json.Unmarshal(data, &batchResult); err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+
+	return batchResult, nil
+}
+
+func (w *Wrapper) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	args := []string{"client", "acp", "document", "audit"}
+	if query.Identity != "" {
+		args = append(args, "--actor", query.Identity)
+	}
+	if query.CollectionName != "" {
+		args = append(args, "--collection", query.CollectionName)
+	}
+	if query.DocID != "" {
+		args = append(args, "--docID", query.DocID)
+	}
+	if !query.Since.IsZero() {
+		args = append(args, "--since", query.Since.Format(time.RFC3339Nano))
+	}
+	if !query.Until.IsZero() {
+		args = append(args, "--until", query.Until.Format(time.RFC3339Nano))
+	}
+	if query.OnlyDenied {
+		args = append(args, "--denied")
+	}
+	if query.Limit > 0 {
+		args = append(args, "--limit", strconv.Itoa(query.Limit))
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []client.ACPAuditRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
 func (w *Wrapper) GetNACStatus(ctx context.Context) (client.NACStatusResult, error) {
 	args := []string{"client", "acp", "node", "status"}
 
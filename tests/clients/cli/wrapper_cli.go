@@ -67,6 +67,10 @@ func (w *cliWrapper) executeStream(ctx context.Context, args []string) (io.ReadC
 			args = append(args, "--source-hub-address", w.sourceHubAddress)
 		}
 	}
+	capability := identity.CapabilityFromContext(ctx)
+	if capability.HasValue() {
+		args = append(args, "--capability", capability.Value().Token())
+	}
 	args = append(args, "--url", w.address)
 
 	cmd := cli.NewDefraCommand()
@@ -13,6 +13,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -171,6 +172,50 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) RotateEncryptionKey(
+	ctx context.Context,
+	docID client.DocID,
+	opts ...client.EncryptionKeyRotationOption,
+) error {
+	args := []string{"client", "collection", "rotate-key"}
+	args = append(args, "--name", c.Version().Name)
+	args = append(args, "--docID", docID.String())
+
+	rotationOpts := client.EncryptionKeyRotationOptions{}
+	rotationOpts.Apply(opts)
+
+	if len(rotationOpts.Fields) > 0 {
+		args = append(args, "--fields", strings.Join(rotationOpts.Fields, ","))
+	}
+	if rotationOpts.ReEncrypt {
+		args = append(args, "--re-encrypt")
+	}
+
+	_, err := c.cmd.execute(ctx, args)
+	return err
+}
+
+func (c *Collection) MigrateDocuments(
+	ctx context.Context,
+	batchSize int,
+) (client.DocumentMigrationProgress, error) {
+	args := []string{"client", "schema", "migrate"}
+	args = append(args, "--collection", c.Version().Name)
+	args = append(args, "--to", c.Version().VersionID)
+	args = append(args, "--batch-size", strconv.Itoa(batchSize))
+	args = append(args, "--batches", "1")
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	var progress client.DocumentMigrationProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	return progress, nil
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
@@ -314,6 +359,9 @@ func (c *Collection) CreateIndex(
 	if indexDesc.Unique {
 		args = append(args, "--unique")
 	}
+	if indexDesc.Blind {
+		args = append(args, "--blind")
+	}
 
 	fields := make([]string, len(indexDesc.Fields))
 	orders := make([]bool, len(indexDesc.Fields))
@@ -107,6 +107,21 @@ func (w *Wrapper) SyncDocuments(
 	return w.client.SyncDocuments(ctx, collectionName, docIDs)
 }
 
+func (w *Wrapper) GetBannedPeers(ctx context.Context) ([]client.BannedPeer, error) {
+	return w.client.GetBannedPeers(ctx)
+}
+
+func (w *Wrapper) ExportBundle(
+	ctx context.Context,
+	config client.BundleExportConfig,
+) (client.BundleExportResult, error) {
+	return w.client.ExportBundle(ctx, config)
+}
+
+func (w *Wrapper) ImportBundle(ctx context.Context, filepath string) (client.BundleImportResult, error) {
+	return w.client.ImportBundle(ctx, filepath)
+}
+
 func (w *Wrapper) BasicImport(ctx context.Context, filepath string) error {
 	return w.client.BasicImport(ctx, filepath)
 }
@@ -158,6 +173,27 @@ func (w *Wrapper) DeleteDACActorRelationship(
 	)
 }
 
+func (w *Wrapper) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	return w.client.AddDACActorRelationships(ctx, batch)
+}
+
+func (w *Wrapper) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	return w.client.DeleteDACActorRelationships(ctx, batch)
+}
+
+func (w *Wrapper) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	return w.client.GetACPAuditLog(ctx, query)
+}
+
 func (w *Wrapper) AddNACActorRelationship(
 	ctx context.Context,
 	relation string,
@@ -96,6 +96,21 @@ func (w *Wrapper) SyncDocuments(
 	panic("not implemented")
 }
 
+func (w *Wrapper) GetBannedPeers(ctx context.Context) ([]client.BannedPeer, error) {
+	panic("not implemented")
+}
+
+func (w *Wrapper) ExportBundle(
+	ctx context.Context,
+	config client.BundleExportConfig,
+) (client.BundleExportResult, error) {
+	panic("not implemented")
+}
+
+func (w *Wrapper) ImportBundle(ctx context.Context, filepath string) (client.BundleImportResult, error) {
+	panic("not implemented")
+}
+
 func (w *Wrapper) BasicImport(ctx context.Context, filepath string) error {
 	panic("not implemented")
 }
@@ -167,6 +182,44 @@ func (w *Wrapper) DeleteDACActorRelationship(
 	return out, nil
 }
 
+func (w *Wrapper) AddDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	batchVal, err := goji.MarshalJS(batch)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	res, err := execute(ctx, w.value, "addDACActorRelationships", batchVal)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	var out client.ActorRelationshipBatchResult
+	if err := goji.UnmarshalJS(res[0], &out); err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	return out, nil
+}
+
+func (w *Wrapper) DeleteDACActorRelationships(
+	ctx context.Context,
+	batch client.DACActorRelationshipBatch,
+) (client.ActorRelationshipBatchResult, error) {
+	batchVal, err := goji.MarshalJS(batch)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	res, err := execute(ctx, w.value, "deleteDACActorRelationships", batchVal)
+	if err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	var out client.ActorRelationshipBatchResult
+	if err := goji.UnmarshalJS(res[0], &out); err != nil {
+		return client.ActorRelationshipBatchResult{}, err
+	}
+	return out, nil
+}
+
 func (w *Wrapper) GetNACStatus(ctx context.Context) (client.NACStatusResult, error) {
 	res, err := execute(ctx, w.value, "getNACStatus")
 	if err != nil {
@@ -189,6 +242,25 @@ func (w *Wrapper) DisableNAC(ctx context.Context) error {
 	return err
 }
 
+func (w *Wrapper) GetACPAuditLog(
+	ctx context.Context,
+	query client.ACPAuditLogQuery,
+) ([]client.ACPAuditRecord, error) {
+	queryVal, err := goji.MarshalJS(query)
+	if err != nil {
+		return nil, err
+	}
+	res, err := execute(ctx, w.value, "getACPAuditLog", queryVal)
+	if err != nil {
+		return nil, err
+	}
+	var out []client.ACPAuditRecord
+	if err := goji.UnmarshalJS(res[0], &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (w *Wrapper) AddNACActorRelationship(
 	ctx context.Context,
 	relation string,
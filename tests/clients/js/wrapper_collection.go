@@ -194,6 +194,37 @@ func (c *Collection) Exists(
 	return res[0].Bool(), nil
 }
 
+func (c *Collection) RotateEncryptionKey(
+	ctx context.Context,
+	docID client.DocID,
+	opts ...client.EncryptionKeyRotationOption,
+) error {
+	rotationOpts := client.EncryptionKeyRotationOptions{}
+	rotationOpts.Apply(opts)
+
+	optsVal, err := goji.MarshalJS(rotationOpts)
+	if err != nil {
+		return err
+	}
+	_, err = execute(ctx, c.client, "rotateEncryptionKey", docID.String(), optsVal)
+	return err
+}
+
+func (c *Collection) MigrateDocuments(
+	ctx context.Context,
+	batchSize int,
+) (client.DocumentMigrationProgress, error) {
+	res, err := execute(ctx, c.client, "migrateDocuments", batchSize)
+	if err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	var out client.DocumentMigrationProgress
+	if err := goji.UnmarshalJS(res[0], &out); err != nil {
+		return client.DocumentMigrationProgress{}, err
+	}
+	return out, nil
+}
+
 func (c *Collection) UpdateWithFilter(
 	ctx context.Context,
 	filter any,
@@ -34,6 +34,10 @@ func execute(ctx context.Context, value js.Value, method string, args ...any) ([
 			contextValues["full_identity"] = full.PrivateKey().String()
 		}
 	}
+	capability := identity.CapabilityFromContext(ctx)
+	if capability.HasValue() {
+		contextValues["capability"] = capability.Value().Token()
+	}
 	args = append(args, contextValues)
 	prom := value.Call(method, args...)
 	return goji.Await(goji.PromiseValue(prom))
@@ -0,0 +1,485 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestACP_AuditLog_RecordsReadDecisions(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log records the read decisions of an actor",
+
+		EnableACPAuditLog: true,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				CollectionID:      0,
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+
+			testUtils.GetACPAuditLog{
+				RequestorIdentity: testUtils.NodeIdentity(0),
+				Identity:          testUtils.ClientIdentity(2),
+
+				ExpectedRecords: []testUtils.ExpectedACPAuditRecord{
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "query",
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+						Allowed:      false,
+					},
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "query",
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+						Allowed:      true,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AuditLog_OnlyDenied(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log query only returns the denied decisions",
+
+		EnableACPAuditLog: true,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+
+			testUtils.GetACPAuditLog{
+				RequestorIdentity: testUtils.NodeIdentity(0),
+				CollectionID:      immutable.Some(0),
+				DocID:             immutable.Some(0),
+				OnlyDenied:        true,
+
+				ExpectedRecords: []testUtils.ExpectedACPAuditRecord{
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "query",
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+						Allowed:      false,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AuditLog_RecordsCollectionDecisions(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log records the collection permission decisions",
+
+		EnableACPAuditLog: true,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithCreatePermission,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Invoices @policy(
+						id: "{{.Policy0}}",
+						resource: "invoices"
+					) {
+						number: Int
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(2),
+
+				Doc: `
+					{
+						"number": 1
+					}
+				`,
+
+				ExpectedError: "not authorized to create documents in collection",
+			},
+
+			testUtils.GetACPAuditLog{
+				RequestorIdentity: testUtils.NodeIdentity(0),
+				Identity:          testUtils.ClientIdentity(2),
+
+				ExpectedRecords: []testUtils.ExpectedACPAuditRecord{
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "create",
+						CollectionID: 0,
+						DocID:        -1,
+						Permission:   "create",
+						Allowed:      false,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AuditLog_WithLimit(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log query returns at most the given number of records, oldest first",
+
+		EnableACPAuditLog: true,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(3),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+
+			testUtils.GetACPAuditLog{
+				RequestorIdentity: testUtils.NodeIdentity(0),
+				OnlyDenied:        true,
+				Limit:             1,
+
+				ExpectedRecords: []testUtils.ExpectedACPAuditRecord{
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "query",
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+						Allowed:      false,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AuditLog_NotEnabled_Error(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log can not be queried if it is not enabled",
+
+		Actions: []any{
+			testUtils.GetACPAuditLog{
+				ExpectedError: "acp audit log is not enabled",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AuditLog_RecordsOperationOfMutation(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log records the operation the read permission was checked for",
+
+		EnableACPAuditLog: true,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					mutation {
+						update_Users(input: {name: "Fred"}) {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"update_Users": []map[string]any{},
+				},
+			},
+
+			testUtils.GetACPAuditLog{
+				RequestorIdentity: testUtils.NodeIdentity(0),
+				Identity:          testUtils.ClientIdentity(2),
+
+				// The documents are read both to find the targets of the update, and to
+				// return the updated documents.
+				ExpectedRecords: []testUtils.ExpectedACPAuditRecord{
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "update",
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+						Allowed:      false,
+					},
+					{
+						Identity:     testUtils.ClientIdentity(2),
+						Operation:    "update",
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+						Allowed:      false,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AuditLog_WithoutNodeIdentity_Error(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, audit log can only be queried with the node identity",
+
+		EnableACPAuditLog: true,
+
+		Actions: []any{
+			testUtils.GetACPAuditLog{
+				ExpectedError: "acp audit log can only be read with the node identity",
+			},
+
+			testUtils.GetACPAuditLog{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+
+				ExpectedError: "acp audit log can only be read with the node identity",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,725 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+// The c client does not support capability tokens.
+var capabilityClientTypes = immutable.Some([]state.ClientType{
+	testUtils.GoClientType,
+	testUtils.HTTPClientType,
+	testUtils.CLIClientType,
+	testUtils.JSClientType,
+})
+
+func TestACP_Capability_DelegatedRead_CanRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token delegating read access to an actor, actor can read",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(2),
+				Capability: immutable.Some(0),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_DelegatedReadOfCollection_CanReadAllDocs(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token delegating read access to all the documents of a collection, actor can read",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        -1,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(2),
+				Capability: immutable.Some(0),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_UsedByOtherActor_CanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token used by an actor other than its audience, actor can not read",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(3),
+				Capability: immutable.Some(0),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_WithAnyAudience_CanReadWithoutIdentity(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token delegating read access to any actor, request without identity can read",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.AllClientIdentities(),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Capability: immutable.Some(0),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_DelegatorWithoutAccess_CanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token delegated by an actor without access, actor can not read",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(2),
+				Audience: testUtils.ClientIdentity(3),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(3),
+				Capability: immutable.Some(0),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_DelegatedUpdate_CanUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token delegating update access to an actor, actor can update",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "update",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(2),
+				Capability: immutable.Some(0),
+
+				Request: `
+					mutation {
+						update_Users(input: {name: "Orpheus"}) {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"update_Users": []map[string]any{
+						{
+							"name": "Orpheus",
+						},
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Orpheus",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_DelegatedRead_CanNotUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token delegating read access to an actor, actor can not update",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(2),
+				Capability: immutable.Some(0),
+
+				Request: `
+					mutation {
+						update_Users(input: {name: "Orpheus"}) {
+							name
+						}
+					}
+				`,
+
+				ExpectedError: "document not found or not authorized to access",
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_ReDelegated_CanRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token re-delegated by its audience, actor can read",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        -1,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(2),
+				Audience: testUtils.ClientIdentity(3),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+				ProofID: immutable.Some(0),
+			},
+
+			testUtils.Request{
+				Identity:   testUtils.ClientIdentity(3),
+				Capability: immutable.Some(1),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_Capability_ReDelegatedBeyondProof_Error(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, capability token re-delegating a capability not granted by its proof, error",
+
+		SupportedClientTypes: capabilityClientTypes,
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(1),
+				Audience: testUtils.ClientIdentity(2),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "read",
+					},
+				},
+			},
+
+			testUtils.NewCapabilityToken{
+				Identity: testUtils.ClientIdentity(2),
+				Audience: testUtils.ClientIdentity(3),
+				Capabilities: []testUtils.DelegatedCapability{
+					{
+						CollectionID: 0,
+						DocID:        0,
+						Permission:   "update",
+					},
+				},
+				ProofID: immutable.Some(0),
+
+				ExpectedError: "capability is not delegated by the proof",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const policyWithCreatePermission = `
+    name: Test Policy
+
+    description: A Policy
+
+    actor:
+      name: actor
+
+    resources:
+      invoices:
+        permissions:
+          read:
+            expr: owner + reader
+
+          update:
+            expr: owner
+
+          delete:
+            expr: owner
+
+          create:
+            expr: owner + creator
+
+        relations:
+          owner:
+            types:
+              - actor
+
+          reader:
+            types:
+              - actor
+
+          creator:
+            types:
+              - actor
+`
+
+func TestACP_CreatePermission_ActorWithoutPermissionCanNotCreate(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, collection create permission, actor without the permission can not create",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithCreatePermission,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Invoices @policy(
+						id: "{{.Policy0}}",
+						resource: "invoices"
+					) {
+						number: Int
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"number": 1
+					}
+				`,
+
+				ExpectedError: "not authorized to create documents in collection",
+			},
+
+			testUtils.CreateDoc{
+				Doc: `
+					{
+						"number": 1
+					}
+				`,
+
+				ExpectedError: "not authorized to create documents in collection",
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+
+				Request: `
+					query {
+						Invoices {
+							number
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Invoices": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_CreatePermission_GrantedActorCanCreate(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, collection create permission, actor granted the permission can create",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithCreatePermission,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Invoices @policy(
+						id: "{{.Policy0}}",
+						resource: "invoices"
+					) {
+						number: Int
+					}
+				`,
+			},
+
+			// The collection is owned by the node identity, as the schema was added without
+			// a request identity.
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.NodeIdentity(0),
+				TargetIdentity:    testUtils.ClientIdentity(1),
+				DocID:             -1,
+				Relation:          "creator",
+				ExpectedExistence: false,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"number": 1
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(2),
+
+				Doc: `
+					{
+						"number": 2
+					}
+				`,
+
+				ExpectedError: "not authorized to create documents in collection",
+			},
+
+			testUtils.DeleteDACActorRelationship{
+				RequestorIdentity:   testUtils.NodeIdentity(0),
+				TargetIdentity:      testUtils.ClientIdentity(1),
+				DocID:               -1,
+				Relation:            "creator",
+				ExpectedRecordFound: true,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"number": 3
+					}
+				`,
+
+				ExpectedError: "not authorized to create documents in collection",
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+
+				Request: `
+					query {
+						Invoices {
+							number
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Invoices": []map[string]any{
+						{
+							"number": int64(1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_CreatePermission_WithoutCreatePermissionAnyActorCanCreate(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, collection without create permission, any actor can create",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithFieldPermissions,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Employees @policy(
+						id: "{{.Policy0}}",
+						resource: "employees"
+					) {
+						name: String
+						status: String
+						salary: Int
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(2),
+
+				Doc: `
+					{
+						"name": "Shahzad"
+					}
+				`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
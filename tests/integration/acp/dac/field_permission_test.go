@@ -0,0 +1,315 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const policyWithFieldPermissions = `
+    name: Test Policy
+
+    description: A Policy
+
+    actor:
+      name: actor
+
+    resources:
+      employees:
+        permissions:
+          read:
+            expr: owner + reader + manager + hr
+
+          update:
+            expr: owner + manager + hr
+
+          delete:
+            expr: owner
+
+          read:salary:
+            expr: owner + hr
+
+          update:salary:
+            expr: owner + hr
+
+          update:status:
+            expr: owner + hr
+
+        relations:
+          owner:
+            types:
+              - actor
+
+          reader:
+            types:
+              - actor
+
+          manager:
+            types:
+              - actor
+
+          hr:
+            types:
+              - actor
+`
+
+func TestACP_FieldReadPermission_ActorWithoutPermissionCanNotReadField(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, field read permission, actor without the permission can not read the field",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithFieldPermissions,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Employees @policy(
+						id: "{{.Policy0}}",
+						resource: "employees"
+					) {
+						name: String
+						status: String
+						salary: Int
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad",
+						"status": "active",
+						"salary": 100
+					}
+				`,
+			},
+
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocID:             0,
+				Relation:          "hr",
+				ExpectedExistence: false,
+			},
+
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(3),
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Employees {
+							name
+							status
+							salary
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Employees": []map[string]any{
+						{
+							"name":   "Shahzad",
+							"status": "active",
+							"salary": int64(100),
+						},
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(3),
+
+				Request: `
+					query {
+						Employees {
+							name
+							status
+							salary
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Employees": []map[string]any{
+						{
+							"name":   "Shahzad",
+							"status": "active",
+							"salary": nil,
+						},
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(3),
+
+				Request: `
+					query {
+						Employees(filter: {salary: {_eq: 100}}) {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Employees": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_FieldUpdatePermission_ActorWithoutPermissionCanNotUpdateField(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, field update permission, actor without the permission can not update the field",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithFieldPermissions,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Employees @policy(
+						id: "{{.Policy0}}",
+						resource: "employees"
+					) {
+						name: String
+						status: String
+						salary: Int
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				Doc: `
+					{
+						"name": "Shahzad",
+						"status": "active",
+						"salary": 100
+					}
+				`,
+			},
+
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocID:             0,
+				Relation:          "manager",
+				ExpectedExistence: false,
+			},
+
+			testUtils.UpdateDoc{
+				Identity: testUtils.ClientIdentity(2),
+
+				DocID: 0,
+
+				Doc: `
+					{
+						"status": "inactive"
+					}
+				`,
+
+				ExpectedError: "not authorized to update field",
+			},
+
+			testUtils.UpdateDoc{
+				Identity: testUtils.ClientIdentity(2),
+
+				DocID: 0,
+
+				Doc: `
+					{
+						"name": "Shahzad Lone"
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+
+				Request: `
+					query {
+						Employees {
+							name
+							status
+							salary
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Employees": []map[string]any{
+						{
+							"name":   "Shahzad Lone",
+							"status": "active",
+							"salary": int64(100),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_FieldPermissionOnUnknownField_SchemaRejected(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, field permission on a field that does not exist, schema rejected",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithFieldPermissions,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Employees @policy(
+						id: "{{.Policy0}}",
+						resource: "employees"
+					) {
+						name: String
+						status: String
+					}
+				`,
+
+				ExpectedError: "field of the field permission does not exist on collection",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,371 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+const policyWithReaderRelation = `
+    name: Test Policy
+
+    description: A Policy
+
+    actor:
+      name: actor
+
+    resources:
+      users:
+        permissions:
+          read:
+            expr: owner + reader
+
+          update:
+            expr: owner
+
+          delete:
+            expr: owner
+
+        relations:
+          owner:
+            types:
+              - actor
+
+          reader:
+            types:
+              - actor
+`
+
+const policyWithReaderAndUpdaterRelations = `
+    name: Test Policy
+
+    description: A Policy
+
+    actor:
+      name: actor
+
+    resources:
+      users:
+        permissions:
+          read:
+            expr: owner + reader + updater
+
+          update:
+            expr: owner + updater
+
+          delete:
+            expr: owner
+
+        relations:
+          owner:
+            types:
+              - actor
+
+          reader:
+            types:
+              - actor
+
+          updater:
+            types:
+              - actor
+`
+
+func TestACP_FieldRelation_ActorInFieldCanRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, relation derived from field, actor held in the field can read",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users",
+						relations: [{field: "readers", relation: "reader"}]
+					) {
+						name: String
+						readers: [String]
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				DocMap: map[string]any{
+					"name":    "Shahzad",
+					"readers": []immutable.Option[state.Identity]{testUtils.ClientIdentity(2)},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(3),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_FieldRelation_ActorRemovedFromFieldCanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, relation derived from field, actor removed from the field can no longer read",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users",
+						relations: [{field: "readers", relation: "reader"}]
+					) {
+						name: String
+						readers: [String]
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				DocMap: map[string]any{
+					"name":    "Shahzad",
+					"readers": []immutable.Option[state.Identity]{testUtils.ClientIdentity(2)},
+				},
+			},
+
+			testUtils.UpdateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				DocID: 0,
+
+				Doc: `
+					{
+						"readers": []
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+
+			testUtils.DeleteDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				DocID: 0,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_FieldRelation_UpdaterRemovesActorFromField_ActorCanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, relation derived from field, updated by an actor that is not the owner",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderAndUpdaterRelations,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users",
+						relations: [{field: "readers", relation: "reader"}]
+					) {
+						name: String
+						readers: [String]
+					}
+				`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+
+				DocMap: map[string]any{
+					"name":    "Shahzad",
+					"readers": []immutable.Option[state.Identity]{testUtils.ClientIdentity(3)},
+				},
+			},
+
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+
+				TargetIdentity: testUtils.ClientIdentity(2),
+
+				DocID: 0,
+
+				Relation: "updater",
+
+				ExpectedExistence: false,
+			},
+
+			testUtils.UpdateDoc{
+				Identity: testUtils.ClientIdentity(2),
+
+				DocID: 0,
+
+				Doc: `
+					{
+						"readers": []
+					}
+				`,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(3),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_FieldRelation_OwnerRelation_SchemaRejected(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, relation derived from field can not be the owner relation",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users",
+						relations: [{field: "owners", relation: "owner"}]
+					) {
+						name: String
+						owners: [String]
+					}
+				`,
+
+				ExpectedError: "policy relation derived from a field can not be the owner relation",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_FieldRelation_NonStringField_SchemaRejected(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, relation derived from a field that can not hold actors",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   policyWithReaderRelation,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users",
+						relations: [{field: "age", relation: "reader"}]
+					) {
+						name: String
+						age: Int
+					}
+				`,
+
+				ExpectedError: "field of the policy relation must be a String or [String] field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac_p2p
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+func TestACP_P2PFieldRelation_ActorRemovedFromFieldByPeer_ActorCanNotRead_LocalACP(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, p2p relation derived from field, field updated by a peer",
+
+		SupportedDocumentACPTypes: immutable.Some(
+			[]testUtils.DocumentACPType{
+				testUtils.LocalDocumentACPType,
+			},
+		),
+
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+
+			testUtils.RandomNetworkingConfig(),
+
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+
+				Policy: `
+                    name: Test Policy
+
+                    description: A Policy
+
+                    actor:
+                      name: actor
+
+                    resources:
+                      users:
+                        permissions:
+                          read:
+                            expr: owner + reader
+
+                          update:
+                            expr: owner
+
+                          delete:
+                            expr: owner
+
+                        relations:
+                          owner:
+                            types:
+                              - actor
+
+                          reader:
+                            types:
+                              - actor
+                `,
+			},
+
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users",
+						relations: [{field: "readers", relation: "reader"}]
+					) {
+						name: String
+						readers: [String]
+					}
+				`,
+			},
+
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 1,
+				TargetNodeID: 0,
+			},
+
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(1),
+
+				Identity: testUtils.ClientIdentity(1),
+
+				DocMap: map[string]any{
+					"name":    "Shahzad",
+					"readers": []immutable.Option[state.Identity]{testUtils.ClientIdentity(2)},
+				},
+			},
+
+			testUtils.WaitForSync{},
+
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+					},
+				},
+			},
+
+			// The document is not registered with the acp of the first node, so it can be
+			// updated there without an identity.
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+
+				DocID: 0,
+
+				Doc: `
+					{
+						"readers": []
+					}
+				`,
+			},
+
+			testUtils.WaitForSync{},
+
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,408 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_acp_dac_relationship_doc_actor_batch
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const userPolicy = `
+    name: Test Policy
+
+    description: A Policy
+
+    actor:
+      name: actor
+
+    resources:
+      users:
+        permissions:
+          read:
+            expr: owner + reader
+
+          update:
+            expr: owner
+
+          delete:
+            expr: owner
+
+        relations:
+          owner:
+            types:
+              - actor
+
+          reader:
+            types:
+              - actor
+`
+
+const userSchema = `
+	type Users @policy(
+		id: "{{.Policy0}}",
+		resource: "users"
+	) {
+		name: String
+		age: Int
+	}
+`
+
+func TestACP_AddDocActorRelationshipBatch_WithDocIDs_OtherActorCanRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, add relationship batch with docIDs, other actor can read the documents",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userPolicy,
+			},
+
+			&action.AddSchema{
+				Schema: userSchema,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Shahzad", "age": 28}`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Fred", "age": 35}`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Islam", "age": 42}`,
+			},
+
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocID:             0,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+
+			testUtils.AddDACActorRelationships{
+				RequestorIdentity:     testUtils.ClientIdentity(1),
+				TargetIdentity:        testUtils.ClientIdentity(2),
+				DocIDs:                []int{0, 1, 1},
+				Relation:              "reader",
+				ExpectedChangedDocIDs: []int{1},
+				ExpectedUnchanged:     1,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Shahzad",
+						},
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AddDocActorRelationshipBatch_WithFilter_OtherActorCanRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, add relationship batch with filter, other actor can read the matching documents",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userPolicy,
+			},
+
+			&action.AddSchema{
+				Schema: userSchema,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Shahzad", "age": 28}`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Fred", "age": 35}`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Islam", "age": 42}`,
+			},
+
+			testUtils.AddDACActorRelationships{
+				RequestorIdentity:     testUtils.ClientIdentity(1),
+				TargetIdentity:        testUtils.ClientIdentity(2),
+				Filter:                `{age: {_gt: 30}}`,
+				Relation:              "reader",
+				ExpectedChangedDocIDs: []int{1, 2},
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Islam",
+						},
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_DeleteDocActorRelationshipBatch_WithFilter_OtherActorCanNotRead(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, delete relationship batch with filter, other actor can no longer read",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userPolicy,
+			},
+
+			&action.AddSchema{
+				Schema: userSchema,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Shahzad", "age": 28}`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Fred", "age": 35}`,
+			},
+
+			testUtils.AddDACActorRelationships{
+				RequestorIdentity:     testUtils.ClientIdentity(1),
+				TargetIdentity:        testUtils.ClientIdentity(2),
+				DocIDs:                []int{0, 1},
+				Relation:              "reader",
+				ExpectedChangedDocIDs: []int{0, 1},
+			},
+
+			testUtils.DeleteDACActorRelationships{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocIDs:            []int{0},
+				Filter:            `{age: {_gt: 20}}`,
+				Relation:          "reader",
+				ExpectedChanged:   2,
+			},
+
+			testUtils.DeleteDACActorRelationships{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocIDs:            []int{0, 1},
+				Relation:          "reader",
+				ExpectedUnchanged: 2,
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AddDocActorRelationshipBatch_WithUnauthorizedDocument_RevertsBatch(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, add relationship batch with a document the requestor can not share, batch reverted",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userPolicy,
+			},
+
+			&action.AddSchema{
+				Schema: userSchema,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Shahzad", "age": 28}`,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(3),
+				Doc:      `{"name": "Fred", "age": 35}`,
+			},
+
+			testUtils.AddDACActorRelationships{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocIDs:            []int{0, 1},
+				Relation:          "reader",
+				ExpectedError:     "relationship batch failed, changes were reverted",
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AddDocActorRelationshipBatch_WithUnregisteredDocument_ChangesNothing(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, add relationship batch with a public document, no relationship is changed",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userPolicy,
+			},
+
+			&action.AddSchema{
+				Schema: userSchema,
+			},
+
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc:      `{"name": "Shahzad", "age": 28}`,
+			},
+
+			testUtils.CreateDoc{
+				Doc: `{"name": "Fred", "age": 35}`,
+			},
+
+			testUtils.AddDACActorRelationships{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				DocIDs:            []int{0, 1},
+				Relation:          "reader",
+				ExpectedError:     "relationship batch document is not registered with acp",
+			},
+
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestACP_AddDocActorRelationshipBatch_WithoutDocIDsOrFilter_Error(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Test acp, add relationship batch without docIDs or filter, error",
+
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userPolicy,
+			},
+
+			&action.AddSchema{
+				Schema: userSchema,
+			},
+
+			testUtils.AddDACActorRelationships{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				Relation:          "reader",
+				ExpectedError:     "relationship batch must have docIDs or a filter",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+// NewCapabilityToken is an action that will create a capability token, delegating document
+// permissions of the given identity to the audience.
+//
+// The token can then be used by requests using its index, see [Request.Capability].
+type NewCapabilityToken struct {
+	// The identity delegating its permissions, i.e. the issuer of the token.
+	Identity immutable.Option[state.Identity]
+
+	// The identity the permissions are delegated to.
+	//
+	// Use [AllClientIdentities] to create a token that can be used by any actor.
+	Audience immutable.Option[state.Identity]
+
+	// The capabilities that are delegated.
+	Capabilities []DelegatedCapability
+
+	// The duration after which the token expires, an hour if zero.
+	Duration time.Duration
+
+	// The index of the capability token the permissions are re-delegated from, if provided.
+	ProofID immutable.Option[int]
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// DelegatedCapability is a capability delegated by a [NewCapabilityToken] action.
+type DelegatedCapability struct {
+	// The collection of the documents.
+	CollectionID int
+
+	// The index-identifier of the document within the collection, -1 for all the documents
+	// of the collection.
+	DocID int
+
+	// The document permission that is delegated.
+	Permission string
+}
+
+func newCapabilityToken(
+	s *state.State,
+	action NewCapabilityToken,
+) {
+	issuer, ok := state.GetIdentity(s, action.Identity).(acpIdentity.FullIdentity)
+	require.True(s.T, ok, "capability token issuer must have a private key")
+
+	capabilities := make([]acpIdentity.Capability, len(action.Capabilities))
+	for i, capability := range action.Capabilities {
+		// The collections and documents are the same across nodes so the first node is used.
+		collectionName, docID := getCollectionAndDocInfo(s, capability.CollectionID, capability.DocID, 0)
+		capabilities[i] = acpIdentity.Capability{
+			CollectionName: collectionName,
+			DocID:          docID,
+			Permission:     capability.Permission,
+		}
+	}
+
+	duration := action.Duration
+	if duration == 0 {
+		duration = time.Hour
+	}
+
+	var proof immutable.Option[string]
+	if action.ProofID.HasValue() {
+		proof = immutable.Some(s.CapabilityTokens[action.ProofID.Value()])
+	}
+
+	token, err := acpIdentity.NewCapabilityToken(
+		issuer,
+		getIdentityDID(s, action.Audience),
+		capabilities,
+		duration,
+		proof,
+	)
+
+	expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
+	assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+
+	if expectedErrorRaised {
+		return
+	}
+
+	s.CapabilityTokens = append(s.CapabilityTokens, string(token))
+}
+
+// getContextWithCapability returns a context with the capability token of the given index.
+func getContextWithCapability(ctx context.Context, s *state.State, capabilityID int) context.Context {
+	token, err := acpIdentity.ParseCapabilityToken([]byte(s.CapabilityTokens[capabilityID]))
+	require.NoError(s.T, err)
+	return acpIdentity.WithCapability(ctx, immutable.Some(token))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/tests/state"
 )
 
@@ -159,6 +160,9 @@ type AddDACActorRelationship struct {
 	// database.
 	//
 	// This is a required field. To test the invalid usage of not having this arg, use -1 index.
+	//
+	// Using -1 index also targets the collection itself, which is how collection scoped permissions
+	// (e.g. "create") are granted.
 	DocID int
 
 	// The name of the relation to set between document and target actor (should be defined in the policy).
@@ -224,7 +228,8 @@ func addDACActorRelationship(
 		}
 	}
 
-	if action.ExpectedError == "" && !action.ExpectedExistence {
+	// Relationships with the collection itself do not publish a document update event.
+	if action.ExpectedError == "" && !action.ExpectedExistence && docID != "" {
 		expect := map[string]struct{}{
 			docID: {},
 		}
@@ -314,6 +319,196 @@ func deleteDACActorRelationship(
 	}
 }
 
+// AddDACActorRelationships will attempt to add a relationship between many documents and an actor,
+// in a single batch.
+type AddDACActorRelationships struct {
+	// NodeID may hold the ID (index) of the node we want to add the relationships on.
+	//
+	// If a value is not provided the relationships will be added in all nodes, unless testing with
+	// sourcehub ACP, in which case the relationships will only be defined once.
+	NodeID immutable.Option[int]
+
+	// The collection in which the documents we want to add the relationships for exist.
+	CollectionID int
+
+	// The index-identifiers of the documents within the collection. Optional if a Filter is given.
+	DocIDs []int
+
+	// The filter selecting the documents within the collection. Optional if DocIDs are given.
+	Filter any
+
+	// The name of the relation to set between the documents and target actor.
+	Relation string
+
+	// The target public identity, i.e. the identity of the actor to tie the documents' relation with.
+	TargetIdentity immutable.Option[state.Identity]
+
+	// The requestor identity, i.e. identity of the actor creating the relationships.
+	RequestorIdentity immutable.Option[state.Identity]
+
+	// The index-identifiers of the documents that are expected to have a new relationship made.
+	ExpectedChangedDocIDs []int
+
+	// The number of documents that are expected to already have had the relationship.
+	ExpectedUnchanged int
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+func addDACActorRelationships(
+	s *state.State,
+	action AddDACActorRelationships,
+) {
+	actionNodeID := action.NodeID
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		nodeID := nodeIDs[index]
+
+		result, err := node.AddDACActorRelationships(
+			getContextWithIdentity(s.Ctx, s, action.RequestorIdentity, nodeID),
+			getDACActorRelationshipBatch(
+				s,
+				nodeID,
+				action.CollectionID,
+				action.DocIDs,
+				action.Filter,
+				action.Relation,
+				action.TargetIdentity,
+			),
+		)
+
+		expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
+		assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+
+		if !expectedErrorRaised {
+			require.Equal(s.T, len(action.ExpectedChangedDocIDs), result.Changed)
+			require.Equal(s.T, action.ExpectedUnchanged, result.Unchanged)
+		}
+
+		// The relationships should only be added to a SourceHub chain once - there is no need to loop
+		// through the nodes.
+		if documentACPType == SourceHubDocumentACPType {
+			actionNodeID = immutable.Some(0)
+			break
+		}
+	}
+
+	if action.ExpectedError == "" && len(action.ExpectedChangedDocIDs) > 0 {
+		expect := make(map[string]struct{}, len(action.ExpectedChangedDocIDs))
+		for _, docInd := range action.ExpectedChangedDocIDs {
+			expect[s.DocIDs[action.CollectionID][docInd].String()] = struct{}{}
+		}
+
+		waitForUpdateEvents(s, actionNodeID, action.CollectionID, expect, action.TargetIdentity)
+	}
+}
+
+// DeleteDACActorRelationships will attempt to delete a relationship between many documents and an
+// actor, in a single batch.
+type DeleteDACActorRelationships struct {
+	// NodeID may hold the ID (index) of the node we want to delete the relationships on.
+	//
+	// If a value is not provided the relationships will be deleted on all nodes, unless testing with
+	// sourcehub document ACP, in which case the relationships will only be deleted once.
+	NodeID immutable.Option[int]
+
+	// The collection in which the documents we want to delete the relationships for exist.
+	CollectionID int
+
+	// The index-identifiers of the documents within the collection. Optional if a Filter is given.
+	DocIDs []int
+
+	// The filter selecting the documents within the collection. Optional if DocIDs are given.
+	Filter any
+
+	// The name of the relation within the relationships we want to delete.
+	Relation string
+
+	// The target public identity, i.e. the identity of the actor with whom the relationships are with.
+	TargetIdentity immutable.Option[state.Identity]
+
+	// The requestor identity, i.e. identity of the actor deleting the relationships.
+	RequestorIdentity immutable.Option[state.Identity]
+
+	// The number of relationships that are expected to be found and deleted.
+	ExpectedChanged int
+
+	// The number of relationships that are expected to not be found.
+	ExpectedUnchanged int
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+func deleteDACActorRelationships(
+	s *state.State,
+	action DeleteDACActorRelationships,
+) {
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		nodeID := nodeIDs[index]
+
+		result, err := node.DeleteDACActorRelationships(
+			getContextWithIdentity(s.Ctx, s, action.RequestorIdentity, nodeID),
+			getDACActorRelationshipBatch(
+				s,
+				nodeID,
+				action.CollectionID,
+				action.DocIDs,
+				action.Filter,
+				action.Relation,
+				action.TargetIdentity,
+			),
+		)
+
+		expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
+		assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+
+		if !expectedErrorRaised {
+			require.Equal(s.T, action.ExpectedChanged, result.Changed)
+			require.Equal(s.T, action.ExpectedUnchanged, result.Unchanged)
+		}
+
+		// The relationships should only be deleted from a SourceHub chain once - there is no need to
+		// loop through the nodes.
+		if documentACPType == SourceHubDocumentACPType {
+			break
+		}
+	}
+}
+
+func getDACActorRelationshipBatch(
+	s *state.State,
+	nodeID int,
+	collectionID int,
+	docInds []int,
+	filter any,
+	relation string,
+	targetIdentity immutable.Option[state.Identity],
+) client.DACActorRelationshipBatch {
+	collectionName, _ := getCollectionAndDocInfo(s, collectionID, -1, nodeID)
+
+	docIDs := make([]string, len(docInds))
+	for i, docInd := range docInds {
+		docIDs[i] = s.DocIDs[collectionID][docInd].String()
+	}
+
+	return client.DACActorRelationshipBatch{
+		CollectionName: collectionName,
+		DocIDs:         docIDs,
+		Filter:         filter,
+		Relation:       relation,
+		TargetActor:    getIdentityDID(s, targetIdentity),
+	}
+}
+
 func getCollectionAndDocInfo(s *state.State, collectionID, docInd, nodeID int) (string, string) {
 	collectionName := ""
 	docID := ""
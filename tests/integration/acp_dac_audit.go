@@ -0,0 +1,151 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package tests
+
+import (
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+// GetACPAuditLog is an action that will query the document acp audit log, and assert that
+// the returned records match the expected records.
+//
+// The audit log must be enabled with [TestCase.EnableACPAuditLog].
+type GetACPAuditLog struct {
+	// NodeID may hold the ID (index) of the node we want to query the audit log of.
+	//
+	// If a value is not provided the audit log will be queried on all nodes.
+	NodeID immutable.Option[int]
+
+	// The requestor identity, i.e. identity of the actor querying the audit log.
+	RequestorIdentity immutable.Option[state.Identity]
+
+	// Identity only matches the records of this identity, if provided.
+	Identity immutable.Option[state.Identity]
+
+	// CollectionID only matches the records of this collection, if provided.
+	CollectionID immutable.Option[int]
+
+	// DocID only matches the records of the document with this index-identifier within
+	// the collection of CollectionID, if provided.
+	DocID immutable.Option[int]
+
+	// OnlyDenied only matches the records of the decisions that denied access.
+	OnlyDenied bool
+
+	// Limit is the maximum number of records to return, zero returns all the records.
+	Limit int
+
+	// The records expected to be returned, from the oldest to the newest.
+	ExpectedRecords []ExpectedACPAuditRecord
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// ExpectedACPAuditRecord is a record expected to be returned from the document acp audit log.
+//
+// The time at which the decision was made is not asserted, only that the records are ordered.
+type ExpectedACPAuditRecord struct {
+	// The identity whose access was checked.
+	Identity immutable.Option[state.Identity]
+
+	// The operation access was checked for.
+	Operation string
+
+	// The collection of the document.
+	CollectionID int
+
+	// The index-identifier of the document within the collection, -1 if the decision was
+	// made for the collection itself.
+	DocID int
+
+	// The permission that was checked.
+	Permission string
+
+	// Whether access was granted.
+	Allowed bool
+}
+
+func getACPAuditLog(
+	s *state.State,
+	action GetACPAuditLog,
+) {
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		nodeID := nodeIDs[index]
+
+		query := client.ACPAuditLogQuery{
+			OnlyDenied: action.OnlyDenied,
+			Limit:      action.Limit,
+		}
+		if action.Identity.HasValue() {
+			query.Identity = getIdentityDID(s, action.Identity)
+		}
+		if action.CollectionID.HasValue() {
+			docInd := -1
+			if action.DocID.HasValue() {
+				docInd = action.DocID.Value()
+			}
+			query.CollectionName, query.DocID = getCollectionAndDocInfo(
+				s,
+				action.CollectionID.Value(),
+				docInd,
+				nodeID,
+			)
+		}
+
+		records, err := node.GetACPAuditLog(
+			getContextWithIdentity(s.Ctx, s, action.RequestorIdentity, nodeID),
+			query,
+		)
+
+		expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
+		assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+
+		if expectedErrorRaised {
+			continue
+		}
+
+		expectedRecords := make([]client.ACPAuditRecord, len(action.ExpectedRecords))
+		for i, expected := range action.ExpectedRecords {
+			collectionName, docID := getCollectionAndDocInfo(s, expected.CollectionID, expected.DocID, nodeID)
+			expectedRecords[i] = client.ACPAuditRecord{
+				Identity:       getIdentityDID(s, expected.Identity),
+				Operation:      expected.Operation,
+				CollectionName: collectionName,
+				DocID:          docID,
+				Permission:     expected.Permission,
+				Allowed:        expected.Allowed,
+			}
+		}
+
+		actualRecords := make([]client.ACPAuditRecord, len(records))
+		for i, record := range records {
+			require.False(s.T, record.Timestamp.IsZero())
+			if i > 0 {
+				require.False(s.T, record.Timestamp.Before(records[i-1].Timestamp))
+			}
+			record.Timestamp = time.Time{}
+			actualRecords[i] = record
+		}
+
+		require.Equal(s.T, expectedRecords, actualRecords)
+	}
+}
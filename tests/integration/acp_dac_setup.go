@@ -59,7 +59,9 @@ func setupSourceHub(s *state.State, testCase TestCase) ([]node.DocumentACPOpt, e
 		case
 			AddDACPolicy,
 			AddDACActorRelationship,
-			DeleteDACActorRelationship:
+			DeleteDACActorRelationship,
+			AddDACActorRelationships,
+			DeleteDACActorRelationships:
 			isDocumentACPTest = true
 		}
 	}
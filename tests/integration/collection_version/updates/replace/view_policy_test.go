@@ -21,7 +21,7 @@ import (
 
 // todo: The inverse of this test is not currently possible, make sure it also is tested when
 // resolving https://github.com/sourcenetwork/defradb/issues/2983
-func TestColVersionUpdateReplaceIsMaterialized_GivenPolicyOnNonMAterializedView_Errors(t *testing.T) {
+func TestColVersionUpdateReplaceIsMaterialized_GivenPolicyOnNonMAterializedView(t *testing.T) {
 	test := testUtils.TestCase{
 		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
 			testUtils.CachelessViewType,
@@ -92,7 +92,6 @@ func TestColVersionUpdateReplaceIsMaterialized_GivenPolicyOnNonMAterializedView_
 						}
 					]
 				`,
-				ExpectedError: "materialized views do not support ACP. Collection: UserView",
 			},
 		},
 	}
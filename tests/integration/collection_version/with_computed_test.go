@@ -0,0 +1,283 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package collection_version
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCollectionVersion_WithComputedFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+						label: String @computed(expr: "concat('x', qty)", stored: false)
+					}
+				`,
+			},
+			testUtils.GetCollections{
+				ExpectedResults: []client.CollectionVersion{
+					{
+						Name:           "Orders",
+						IsMaterialized: true,
+						IsActive:       true,
+						Fields: []client.CollectionFieldDescription{
+							{
+								Name: "_docID",
+							},
+							{
+								Name: "label",
+								Computed: immutable.Some(client.ComputedFieldDescription{
+									Expression: "concat('x', qty)",
+								}),
+							},
+							{
+								Name: "price",
+							},
+							{
+								Name: "qty",
+							},
+							{
+								Name: "total",
+								Computed: immutable.Some(client.ComputedFieldDescription{
+									Expression: "qty * price",
+									IsStored:   true,
+								}),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithInvalidComputedExpression_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * ")
+					}
+				`,
+				ExpectedError: "invalid computed field expression",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedExpressionReferencingUnknownField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+				ExpectedError: "computed field expressions may only reference non-computed scalar fields. " +
+					"Collection: Orders, Field: total, ReferencedField: price",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedExpressionReferencingComputedField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+						doubleTotal: Float @computed(expr: "total * 2")
+					}
+				`,
+				ExpectedError: "computed field expressions may only reference non-computed scalar fields. " +
+					"Collection: Orders, Field: doubleTotal, ReferencedField: total",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedExpressionReferencingEncryptedField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int @crdt(type: lww) @encrypted
+						price: Float
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+				ExpectedError: "computed field expressions may not reference encrypted or field permissioned fields. " +
+					"Collection: Orders, Field: total, ReferencedField: qty",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedExpressionReferencingFieldWithFieldPermission_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy: `
+                    name: test
+                    description: a policy restricting the read of the price of orders
+
+                    actor:
+                      name: actor
+
+                    resources:
+                      orders:
+                        permissions:
+                          read:
+                            expr: owner + reader
+                          update:
+                            expr: owner
+                          delete:
+                            expr: owner
+                          read:price:
+                            expr: owner
+
+                        relations:
+                          owner:
+                            types:
+                              - actor
+                          reader:
+                            types:
+                              - actor
+                `,
+			},
+			&action.AddSchema{
+				Schema: `
+					type Orders @policy(
+						id: "{{.Policy0}}",
+						resource: "orders"
+					) {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+				ExpectedError: "computed field expressions may not reference encrypted or field permissioned fields. " +
+					"Collection: Orders, Field: total, ReferencedField: price",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedExpressionOfInvalidOperand_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						item: String
+						qty: Int
+						total: Int @computed(expr: "qty * item")
+					}
+				`,
+				ExpectedError: "invalid computed field expression",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedExpressionMismatchingFieldKind_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Int @computed(expr: "qty * price")
+					}
+				`,
+				ExpectedError: "computed field expression does not match the kind of the field. " +
+					"Collection: Orders, Field: total, ExpressionType: Float, Kind: Int",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithComputedFieldOfUnsupportedKind_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						createdAt: DateTime @computed(expr: "qty")
+					}
+				`,
+				ExpectedError: "computed fields of this kind are not supported. " +
+					"Collection: Orders, Field: createdAt, Kind: DateTime",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithIndexOnNotStoredComputedField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price", stored: false) @index
+					}
+				`,
+				ExpectedError: "computed fields that are not stored may not be indexed. " +
+					"Collection: Orders, Field: total",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package collection_version
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCollectionVersion_WithFieldConstraints(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(notNull: true, minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						age: Int @constraints(min: 0, max: 150)
+						status: String @constraints(oneOf: ["active", "inactive"])
+					}
+				`,
+			},
+			testUtils.GetCollections{
+				ExpectedResults: []client.CollectionVersion{
+					{
+						Name:           "Users",
+						IsMaterialized: true,
+						IsActive:       true,
+						Fields: []client.CollectionFieldDescription{
+							{
+								Name: "_docID",
+							},
+							{
+								Name: "age",
+								Constraints: client.FieldConstraints{
+									Min: immutable.Some[float64](0),
+									Max: immutable.Some[float64](150),
+								},
+							},
+							{
+								Name: "name",
+								Constraints: client.FieldConstraints{
+									MinLength: immutable.Some(2),
+									MaxLength: immutable.Some(10),
+									Pattern:   immutable.Some("^[A-Z]"),
+									NotNull:   true,
+								},
+							},
+							{
+								Name: "status",
+								Constraints: client.FieldConstraints{
+									OneOf: []any{"active", "inactive"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithLengthConstraintOnIntField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						age: Int @constraints(maxLength: 2)
+					}
+				`,
+				ExpectedError: "constraint is not supported by the kind of this field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithInvalidPatternConstraint_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(pattern: "[a-z")
+					}
+				`,
+				ExpectedError: "invalid field constraint",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCollectionVersion_WithMinConstraintGreaterThanMax_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						age: Int @constraints(min: 10, max: 1)
+					}
+				`,
+				ExpectedError: "constraint minimum is greater than its maximum",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
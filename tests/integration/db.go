@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/sourcenetwork/defradb/internal/db"
 	"github.com/sourcenetwork/defradb/node"
 	changeDetector "github.com/sourcenetwork/defradb/tests/change_detector"
 	"github.com/sourcenetwork/defradb/tests/state"
@@ -29,6 +30,9 @@ const (
 	inMemoryEnvName         = "DEFRA_IN_MEMORY"
 )
 
+// testBlindIndexSecret is the secret the keys of the blind indexes of the test nodes are derived from.
+const testBlindIndexSecret = "test-blind-index-secret"
+
 const (
 	BadgerIMType   state.DatabaseType = "badger-in-memory"
 	DefraIMType    state.DatabaseType = "defra-memory-datastore"
@@ -75,6 +79,8 @@ func defaultNodeOpts() []node.Option {
 		// to keep the tests as lightweight as possible.
 		node.WithDisableP2P(true),
 		node.WithLensRuntime(lensType),
+		// Nodes started from the cli always have a blind index secret, unless the keyring is disabled.
+		db.WithBlindIndexSecret([]byte(testBlindIndexSecret)),
 	}
 }
 
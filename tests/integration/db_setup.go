@@ -57,6 +57,7 @@ func setupNode(
 ) (*state.NodeState, error) {
 	opts = append(defaultNodeOpts(), opts...)
 	opts = append(opts, db.WithEnabledSigning(testCase.EnableSigning))
+	opts = append(opts, db.WithACPAuditLog(testCase.EnableACPAuditLog))
 
 	err := createBadgerEncryptionKey()
 	if err != nil {
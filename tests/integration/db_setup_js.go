@@ -31,6 +31,7 @@ func setupNode(
 ) (*state.NodeState, error) {
 	opts = append(defaultNodeOpts(), opts...)
 	opts = append(opts, db.WithEnabledSigning(testCase.EnableSigning))
+	opts = append(opts, db.WithACPAuditLog(testCase.EnableACPAuditLog))
 	opts = append(opts, node.WithLensRuntime(node.JSLensRuntime))
 	// Note: Since we are hard-coding to run with badger in-mem only, we have a function that
 	// handles some edge-cases by skipping js client testing when a db type is something else.
@@ -0,0 +1,308 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func blindIndexUsersActions() []any {
+	return []any{
+		&action.AddSchema{
+			Schema: `
+				type Users {
+					name: String
+					ssn: String @encrypted @index(blind: true)
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name":	"John",
+				"ssn":	"123-45-6789"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name":	"Islam",
+				"ssn":	"987-65-4321"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name":	"Andy",
+				"ssn":	"555-55-5555"
+			}`,
+		},
+	}
+}
+
+func TestDocEncryptionBlindIndex_WithEqFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Users(filter: {ssn: {_eq: "987-65-4321"}}) {
+			name
+			ssn
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			blindIndexUsersActions(),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Islam",
+							"ssn":  "987-65-4321",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  "query @explain(type: execute) " + req[len("query "):],
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_WithInFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Users(filter: {ssn: {_in: ["123-45-6789", "555-55-5555", "000-00-0000"]}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			blindIndexUsersActions(),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "John"},
+						{"name": "Andy"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  "query @explain(type: execute) " + req[len("query "):],
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_WithLikeFilter_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		Users(filter: {ssn: {_like: "9%"}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			blindIndexUsersActions(),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "Islam"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  "query @explain(type: execute) " + req[len("query "):],
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_WithOrderOnIndexedField_ShouldOrderByValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			blindIndexUsersActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(order: {ssn: ASC}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "John"},
+						{"name": "Andy"},
+						{"name": "Islam"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_UponUpdate_ShouldFindDocByNewValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			blindIndexUsersActions(),
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc: `{
+					"ssn":	"111-11-1111"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {ssn: {_eq: "987-65-4321"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {ssn: {_eq: "111-11-1111"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "Islam"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_WithUniqueIndexAndDuplicateValue_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						ssn: String @encrypted @index(unique: true, blind: true)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"ssn":	"123-45-6789"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Islam",
+					"ssn":	"123-45-6789"
+				}`,
+				ExpectedError: "can not index a doc's field(s) that violates unique index",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_CreateIndexOnEncryptedField_ShouldIndexExistingDocs(t *testing.T) {
+	req := `query {
+		Users(filter: {age: {_eq: 21}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @crdt(type: lww) @encrypted
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: john21Doc,
+			},
+			testUtils.CreateDoc{
+				Doc: islam33Doc,
+			},
+			testUtils.CreateIndex{
+				FieldName: "age",
+				Blind:     true,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{"name": "John"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  "query @explain(type: execute) " + req[len("query "):],
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_WithMultipleFields_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users @index(blind: true, includes: [{field: "name"}, {field: "ssn"}]) {
+						name: String
+						ssn: String @encrypted
+					}
+				`,
+				ExpectedError: "blind index can not have multiple fields",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionBlindIndex_WithUnsupportedFieldKind_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String] @encrypted @index(blind: true)
+					}
+				`,
+				ExpectedError: "unsupported blind index field type",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,385 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestDocEncryptionRotation_UponUpdate_ShouldEncryptWithNewKey(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc:            john21Doc,
+				IsDocEncrypted: true,
+			},
+			testUtils.RotateEncryptionKey{
+				DocID: 0,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encryptWithEpoch(testUtils.CBORValue(22), john21DocID, "", 1),
+						},
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, ""),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotation_WithReEncryption_ShouldEncryptCurrentValuesWithNewKey(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc:            john21Doc,
+				IsDocEncrypted: true,
+			},
+			testUtils.RotateEncryptionKey{
+				DocID:     0,
+				ReEncrypt: true,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "name") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encryptWithEpoch(testUtils.CBORValue("John"), john21DocID, "", 1),
+						},
+						{
+							"delta": encrypt(testUtils.CBORValue("John"), john21DocID, ""),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotation_RotatedTwice_ShouldEncryptWithKeyOfLatestEpoch(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc:            john21Doc,
+				IsDocEncrypted: true,
+			},
+			testUtils.RotateEncryptionKey{
+				DocID: 0,
+			},
+			testUtils.RotateEncryptionKey{
+				DocID: 0,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encryptWithEpoch(testUtils.CBORValue(22), john21DocID, "", 2),
+						},
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, ""),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotation_OfIndividuallyEncryptedField_ShouldEncryptFieldWithNewKey(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc:             john21Doc,
+				EncryptedFields: []string{"age"},
+			},
+			testUtils.RotateEncryptionKey{
+				DocID:     0,
+				Fields:    []string{"age"},
+				ReEncrypt: true,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encryptWithEpoch(testUtils.CBORValue(21), john21DocID, "age", 1),
+						},
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, "age"),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "name") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": testUtils.CBORValue("John"),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotation_OfFieldNotEncryptedIndividually_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc:            john21Doc,
+				IsDocEncrypted: true,
+			},
+			testUtils.RotateEncryptionKey{
+				DocID:         0,
+				Fields:        []string{"age"},
+				ExpectedError: "document or field is not encrypted",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotation_OfDocNotEncrypted_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: john21Doc,
+			},
+			testUtils.RotateEncryptionKey{
+				DocID:         0,
+				ExpectedError: "document or field is not encrypted",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotation_OfUnknownField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc:             john21Doc,
+				EncryptedFields: []string{"age"},
+			},
+			testUtils.RotateEncryptionKey{
+				DocID:         0,
+				Fields:        []string{"points"},
+				ExpectedError: "the given field does not exist. Name: points",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionRotationPeer_WithReEncryption_ShouldFetchNewKeyFromPeerAndUseIt(t *testing.T) {
+	test := testUtils.TestCase{
+		KMS: testUtils.KMS{Activated: true},
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			updateUserCollectionSchema(),
+			testUtils.ConnectPeers{
+				SourceNodeID: 1,
+				TargetNodeID: 0,
+			},
+			testUtils.SubscribeToCollection{
+				NodeID:        1,
+				CollectionIDs: []int{0},
+			},
+			testUtils.CreateDoc{
+				NodeID:         immutable.Some(0),
+				Doc:            john21Doc,
+				IsDocEncrypted: true,
+			},
+			testUtils.WaitForSync{},
+			testUtils.RotateEncryptionKey{
+				NodeID:    immutable.Some(0),
+				DocID:     0,
+				ReEncrypt: true,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encryptWithEpoch(testUtils.CBORValue(22), john21DocID, "", 1),
+						},
+						{
+							"delta": encryptWithEpoch(testUtils.CBORValue(21), john21DocID, "", 1),
+						},
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, ""),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,403 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestDocEncryptionSchema_WithEncryptedType_ShouldEncryptDocWithoutRequestingIt(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users @encrypted {
+						name: String
+						age: Int @crdt(type: lww)
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.GetCollections{
+				ExpectedResults: []client.CollectionVersion{
+					{
+						Name:           "Users",
+						IsMaterialized: true,
+						IsEncrypted:    true,
+						IsActive:       true,
+					},
+				},
+			},
+			testUtils.CreateDoc{
+				Doc: john21Doc,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, ""),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithEncryptedTypeUponUpdateOfNewField_ShouldEncryptField(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users @encrypted {
+						name: String
+						age: Int @crdt(type: lww)
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: john21Doc,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"verified": true
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "verified") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encrypt(testUtils.CBORValue(true), john21DocID, ""),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							verified
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"verified": true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithEncryptedField_ShouldEncryptOnlyField(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @crdt(type: lww) @encrypted
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: john21Doc,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encrypt(testUtils.CBORValue(22), john21DocID, "age"),
+						},
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, "age"),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "name") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": testUtils.CBORValue("John"),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithEncryptedFieldAndRequestedFields_ShouldEncryptAllFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @crdt(type: lww) @encrypted
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc:             john21Doc,
+				EncryptedFields: []string{"name"},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "name") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encrypt(testUtils.CBORValue("John"), john21DocID, "name"),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "age") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encrypt(testUtils.CBORValue(21), john21DocID, "age"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithIndexOnEncryptedType_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users @encrypted {
+						name: String @index
+						age: Int
+					}
+				`,
+				ExpectedError: "can not index encrypted field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithIndexOnEncryptedField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users @index(includes: [{field: "name"}, {field: "age"}]) {
+						name: String
+						age: Int @crdt(type: lww) @encrypted
+						verified: Boolean
+					}
+				`,
+				ExpectedError: "can not index encrypted field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_CreateIndexOnEncryptedField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @crdt(type: lww) @encrypted
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "age",
+				ExpectedError: "can not index encrypted field",
+			},
+			testUtils.CreateIndex{
+				FieldName: "name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithEncryptedRelationField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+						devices: [Device]
+					}
+					type Device {
+						model: String
+						owner: User @encrypted
+					}
+				`,
+				ExpectedError: "can not encrypt relation field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDocEncryptionSchema_WithEncryptedTypeOnGQLCreate_ShouldEncryptDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			testUtils.GQLRequestMutationType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users @encrypted {
+						name: String
+						age: Int @crdt(type: lww)
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: john21Doc,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "name") {
+							delta
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"delta": encrypt(testUtils.CBORValue("John"), john21DocID, ""),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
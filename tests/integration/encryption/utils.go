@@ -11,6 +11,8 @@
 package encryption
 
 import (
+	"strconv"
+
 	"github.com/sourcenetwork/defradb/crypto"
 	"github.com/sourcenetwork/defradb/tests/action"
 )
@@ -53,3 +55,13 @@ func encrypt(plaintext []byte, docID, fieldName string) []byte {
 	val, _, _ := crypto.EncryptAES(plaintext, []byte(fieldName + docID + testEncKey)[0:keyLength], nil, true)
 	return val
 }
+
+// encryptWithEpoch encrypts the given plain text with the deterministic encryption key of the given
+// key epoch, as generated when rotating the encryption key.
+func encryptWithEpoch(plaintext []byte, docID, fieldName string, epoch uint64) []byte {
+	const keyLength = 32
+	const testEncKey = "examplekey1234567890examplekey12"
+	key := []byte(strconv.FormatUint(epoch, 10) + fieldName + docID + testEncKey)[0:keyLength]
+	val, _, _ := crypto.EncryptAES(plaintext, key, nil, true)
+	return val
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package tests
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+// RotateEncryptionKey is an action that will rotate the encryption key of the given document.
+type RotateEncryptionKey struct {
+	// NodeID may hold the ID (index) of a node to rotate the key on.
+	//
+	// If a value is not provided the key will be rotated on all nodes.
+	NodeID immutable.Option[int]
+
+	// The identity of this request. Optional.
+	//
+	// Use `ClientIdentity` to create a client identity and `NodeIdentity` to create a node identity.
+	// Default value is `NoIdentity()`.
+	Identity immutable.Option[state.Identity]
+
+	// The collection of the document.
+	CollectionID int
+
+	// The index-identifier of the document within the collection.  This is based on
+	// the order in which it was created, not the ordering of the document within the
+	// database.
+	DocID int
+
+	// The individually encrypted fields whose keys are rotated. If empty the key of
+	// the document is rotated.
+	Fields []string
+
+	// If true the current values of the document are re-encrypted with the new key.
+	ReEncrypt bool
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+func rotateEncryptionKey(
+	s *state.State,
+	action RotateEncryptionKey,
+) {
+	docID := s.DocIDs[action.CollectionID][action.DocID]
+
+	var expectedErrorRaised bool
+
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		nodeID := nodeIDs[index]
+		collection := s.Nodes[nodeID].Collections[action.CollectionID]
+		ctx := getContextWithIdentity(s.Ctx, s, action.Identity, nodeID)
+		err := withRetryOnNode(
+			node,
+			func() error {
+				return collection.RotateEncryptionKey(
+					ctx,
+					docID,
+					client.RotateKeyOfFields(action.Fields),
+					client.RotateKeyWithReEncryption(action.ReEncrypt),
+				)
+			},
+		)
+		expectedErrorRaised = AssertError(s.T, err, action.ExpectedError)
+	}
+
+	assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+
+	if action.ExpectedError == "" && action.ReEncrypt {
+		expect := map[string]struct{}{
+			docID.String(): {},
+		}
+
+		waitForUpdateEvents(s, action.NodeID, action.CollectionID, expect, immutable.None[state.Identity]())
+	}
+}
@@ -360,3 +360,36 @@ func getMergeEventKey(evt event.Merge) string {
 
 	return evt.DocID
 }
+
+// waitForViewRefresh waits for the selected nodes to publish the expected number of view refresh
+// events to the local event bus.
+//
+// Will fail the test if an event is not received within the expected time interval to prevent tests
+// from running forever.
+func waitForViewRefresh(s *state.State, action WaitForViewRefresh) {
+	count := 1
+	if action.Count.HasValue() {
+		count = action.Count.Value()
+	}
+
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for i, node := range nodes {
+		if node.Closed {
+			continue // node is closed
+		}
+
+		for j := 0; j < count; j++ {
+			select {
+			case msg, ok := <-node.Event.ViewRefresh.Message():
+				if !ok {
+					require.Fail(s.T, "subscription closed waiting for view refresh event", "Node %d", nodeIDs[i])
+				}
+				evt, _ := msg.Data.(event.ViewRefresh)
+				require.NoError(s.T, evt.Err, "Node %d", nodeIDs[i])
+
+			case <-time.After(eventTimeout):
+				require.Fail(s.T, "timeout waiting for view refresh event", "Node %d", nodeIDs[i])
+			}
+		}
+	}
+}
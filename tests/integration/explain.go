@@ -35,34 +35,35 @@ var (
 		"subType": {},
 
 		// These are all valid nodes.
-		"averageNode":    {},
-		"countNode":      {},
-		"createNode":     {},
-		"dagScanNode":    {},
-		"deleteNode":     {},
-		"groupNode":      {},
-		"limitNode":      {},
-		"maxNode":        {},
-		"minNode":        {},
-		"multiScanNode":  {},
-		"orderNode":      {},
-		"parallelNode":   {},
-		"pipeNode":       {},
-		"scanNode":       {},
-		"selectNode":     {},
-		"selectTopNode":  {},
-		"sumNode":        {},
-		"topLevelNode":   {},
-		"typeIndexJoin":  {},
-		"typeJoinMany":   {},
-		"typeJoinOne":    {},
-		"updateNode":     {},
-		"upsertNode":     {},
-		"valuesNode":     {},
-		"viewNode":       {},
-		"lensNode":       {},
-		"operationNode":  {},
-		"similarityNode": {},
+		"averageNode":       {},
+		"countNode":         {},
+		"createNode":        {},
+		"dagScanNode":       {},
+		"deleteNode":        {},
+		"groupNode":         {},
+		"limitNode":         {},
+		"maxNode":           {},
+		"minNode":           {},
+		"multiScanNode":     {},
+		"orderNode":         {},
+		"parallelNode":      {},
+		"pipeNode":          {},
+		"scanNode":          {},
+		"selectNode":        {},
+		"selectTopNode":     {},
+		"sumNode":           {},
+		"topLevelNode":      {},
+		"typeIndexJoin":     {},
+		"typeJoinMany":      {},
+		"typeJoinOne":       {},
+		"updateNode":        {},
+		"upsertNode":        {},
+		"valuesNode":        {},
+		"viewNode":          {},
+		"lensNode":          {},
+		"operationNode":     {},
+		"similarityNode":    {},
+		"cachedViewFetcher": {},
 	}
 )
 
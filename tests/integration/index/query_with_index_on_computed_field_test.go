@@ -0,0 +1,93 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndex_WithFilterOnStoredComputedField_ShouldIndex(t *testing.T) {
+	req := `query {
+		User(filter: {fullName: {_eq: "John Smith"}}) {
+			first
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						first: String
+						last: String
+						fullName: String @computed(expr: "concat(first, ' ', last)") @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+						"first": "John",
+						"last": "Doe"
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+						"first": "Fred",
+						"last": "Smith"
+					}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+						"last": "Smith"
+					}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"first": "John"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestIndex_OnNotStoredComputedField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						first: String
+						last: String
+						fullName: String @computed(expr: "concat(first, ' ', last)", stored: false)
+					}`,
+			},
+			testUtils.CreateIndex{
+				CollectionID: 0,
+				FieldName:    "fullName",
+				ExpectedError: "computed fields that are not stored may not be indexed. " +
+					"Collection: User, Field: fullName",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -14,6 +14,7 @@ import (
 	"os"
 
 	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/db"
@@ -68,3 +69,88 @@ func configureMigration(
 		assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
 	}
 }
+
+// MigrateDocuments is a test action which will migrate the stored documents of a collection
+// to a collection version, one batch at a time, until the migration is done.
+type MigrateDocuments struct {
+	// NodeID may hold the ID (index) of a node to migrate the documents on.
+	//
+	// If a value is not provided the documents will be migrated on all nodes.
+	NodeID immutable.Option[int]
+
+	// The identity of this request. Optional.
+	//
+	// Use `ClientIdentity` to create a client identity and `NodeIdentity` to create a node identity.
+	// Default value is `NoIdentity()`.
+	Identity immutable.Option[state.Identity]
+
+	// The collection of the documents.
+	CollectionID int
+
+	// The collection version to migrate the documents to.
+	//
+	// If a value is not provided the documents will be migrated to the active version.
+	VersionID immutable.Option[string]
+
+	// The number of documents to process per batch.
+	BatchSize int
+
+	// The maximum number of batches to run. Optional.
+	//
+	// If zero, batches are run until the migration is done.
+	MaxBatches int
+
+	// The expected progress returned by each batch. Optional.
+	ExpectedProgress []client.DocumentMigrationProgress
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+func migrateDocuments(
+	s *state.State,
+	action MigrateDocuments,
+) {
+	var expectedErrorRaised bool
+
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		nodeID := nodeIDs[index]
+		ctx := getContextWithIdentity(s.Ctx, s, action.Identity, nodeID)
+
+		collection := s.Nodes[nodeID].Collections[action.CollectionID]
+		if action.VersionID.HasValue() {
+			cols, err := node.GetCollections(ctx, client.CollectionFetchOptions{
+				VersionID:       action.VersionID,
+				IncludeInactive: immutable.Some(true),
+			})
+			require.NoError(s.T, err)
+			require.Len(s.T, cols, 1)
+			collection = cols[0]
+		}
+
+		progress := []client.DocumentMigrationProgress{}
+		var err error
+		for batch := 0; action.MaxBatches <= 0 || batch < action.MaxBatches; batch++ {
+			var batchProgress client.DocumentMigrationProgress
+			batchProgress, err = collection.MigrateDocuments(ctx, action.BatchSize)
+			if err != nil {
+				break
+			}
+			progress = append(progress, batchProgress)
+			if batchProgress.Done {
+				break
+			}
+		}
+		expectedErrorRaised = AssertError(s.T, err, action.ExpectedError)
+
+		if action.ExpectedError == "" && action.ExpectedProgress != nil {
+			require.Equal(s.T, action.ExpectedProgress, progress)
+		}
+	}
+
+	assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package computed
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithStoredComputedFields_ShouldComputeValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						first: String
+						last: String
+						qty: Int
+						price: Float
+						customer: String @computed(expr: "concat(first, ' ', last)")
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"first": "John",
+					"last": "Doe",
+					"qty": 3,
+					"price": 2.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Orders {
+							customer
+							total
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Orders": []map[string]any{
+						{
+							"customer": "John Doe",
+							"total":    float64(7.5),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithNotStoredComputedField_ShouldComputeValueOnRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price", stored: false)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"qty": 3,
+					"price": 2.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Orders {
+							total
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Orders": []map[string]any{
+						{
+							"total": float64(7.5),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithComputedFieldAndMissingReferencedField_ShouldReturnNil(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+						label: String @computed(expr: "upper(concat('order of ', qty))", stored: false)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"price": 2.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Orders {
+							total
+							label
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Orders": []map[string]any{
+						{
+							"total": nil,
+							"label": "ORDER OF ",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithComputedFieldSet_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		// Computed fields are not part of the GQL mutation input types, they can only be given
+		// to the collection directly.
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			testUtils.CollectionSaveMutationType,
+			testUtils.CollectionNamedMutationType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"qty": 3,
+					"price": 2.5,
+					"total": 10
+				}`,
+				ExpectedError: "computed fields may not be set. Field: total",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithComputedFieldViaGQL_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `
+					mutation {
+						create_Orders(input: {qty: 3, price: 2.5, total: 10}) {
+							total
+						}
+					}
+				`,
+				ExpectedError: `Argument "input" has invalid value`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithComputedFieldDivisionByZero_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						total: Int
+						unitPrice: Int @computed(expr: "total / qty")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"qty": 0,
+					"total": 10
+				}`,
+				ExpectedError: "failed to compute field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
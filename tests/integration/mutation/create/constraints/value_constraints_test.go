@@ -0,0 +1,241 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package constraints
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithValueConstraints_ShouldSucceed(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(notNull: true, minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						age: Int @constraints(min: 0, max: 150)
+						score: Float @constraints(min: 0.5)
+						status: String @constraints(oneOf: ["active", "inactive"])
+						level: Int @constraints(oneOf: [1, 2, 3])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 150,
+					"score": 0.5,
+					"status": "active",
+					"level": 2
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+							score
+							status
+							level
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":   "John",
+							"age":    int64(150),
+							"score":  0.5,
+							"status": "active",
+							"level":  int64(2),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithMinConstraintViolated_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						age: Int @constraints(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"age": -1
+				}`,
+				ExpectedError: "field value violates constraint. DocID: bae-",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithMaxConstraintViolated_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						score: Float @constraints(max: 10)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"score": 10.5
+				}`,
+				ExpectedError: "Field: score, Constraint: max, Value: 10.5",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithLengthConstraintsViolated_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(minLength: 2, maxLength: 4)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Johnny"
+				}`,
+				ExpectedError: "Field: name, Constraint: maxLength, Value: Johnny",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithPatternConstraintViolated_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						email: String @constraints(pattern: "^[^@]+@[^@]+$")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"email": "john"
+				}`,
+				ExpectedError: "Field: email, Constraint: pattern, Value: john",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithOneOfConstraintViolated_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						status: String @constraints(oneOf: ["active", "inactive"])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"status": "deleted"
+				}`,
+				ExpectedError: "Field: status, Constraint: oneOf, Value: deleted",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithNotNullConstraintViolated_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(notNull: true)
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"age": 21
+				}`,
+				ExpectedError: "Field: name, Constraint: notNull",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithMultipleConstraintsViolated_ShouldListEveryViolation(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(minLength: 2, pattern: "^[A-Z]")
+						age: Int @constraints(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "j",
+					"age": -1
+				}`,
+				ExpectedError: "Field: name, Constraint: minLength, Value: j\n" +
+					"field value violates constraint. DocID: bae-",
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
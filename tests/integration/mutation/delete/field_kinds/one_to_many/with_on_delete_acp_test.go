@@ -0,0 +1,232 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const bookPolicy = `
+    name: test
+    description: a test policy which marks a collection in a database as a resource
+
+    actor:
+      name: actor
+
+    resources:
+      books:
+        permissions:
+          read:
+            expr: owner
+          update:
+            expr: owner
+          delete:
+            expr: owner
+
+        relations:
+          owner:
+            types:
+              - actor
+`
+
+func TestDeletionOfADocument_WithOnDeleteRestrictAndUnreadableReferencingDocument_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(2),
+				Policy:   bookPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type Book @policy(
+						id: "{{.Policy0}}",
+						resource: "books"
+					) {
+						name: String
+						author: Author @relation(onDelete: RESTRICT)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Identity:     testUtils.ClientIdentity(2),
+				CollectionID: 0,
+				DocMap: map[string]any{
+					"name":      "John and the philosopher are stoned",
+					"author_id": testUtils.NewDocIndex(1, 0),
+				},
+			},
+			testUtils.DeleteDoc{
+				// The first identity can not read the book, but the author may still not be deleted
+				// out from under it.
+				Identity:      testUtils.ClientIdentity(1),
+				CollectionID:  1,
+				DocID:         0,
+				ExpectedError: "document is referenced by another document and may not be deleted",
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDeleteCascadeAndUndeletableReferencingDocument_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(2),
+				Policy:   bookPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type Book @policy(
+						id: "{{.Policy0}}",
+						resource: "books"
+					) {
+						name: String
+						author: Author @relation(onDelete: CASCADE)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Identity:     testUtils.ClientIdentity(2),
+				CollectionID: 0,
+				DocMap: map[string]any{
+					"name":      "John and the philosopher are stoned",
+					"author_id": testUtils.NewDocIndex(1, 0),
+				},
+			},
+			testUtils.DeleteDoc{
+				Identity:      testUtils.ClientIdentity(1),
+				CollectionID:  1,
+				DocID:         0,
+				ExpectedError: "not authorized to apply the delete action to a referencing document",
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+					Book {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Book": []map[string]any{
+						{
+							"name": "John and the philosopher are stoned",
+							"author": map[string]any{
+								"name": "John",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDeleteCascadeAndDeletableReferencingDocument_DeletesIt(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(2),
+				Policy:   bookPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type Book @policy(
+						id: "{{.Policy0}}",
+						resource: "books"
+					) {
+						name: String
+						author: Author @relation(onDelete: CASCADE)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Identity:     testUtils.ClientIdentity(2),
+				CollectionID: 0,
+				DocMap: map[string]any{
+					"name":      "John and the philosopher are stoned",
+					"author_id": testUtils.NewDocIndex(1, 0),
+				},
+			},
+			testUtils.DeleteDoc{
+				Identity:     testUtils.ClientIdentity(2),
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+					Book {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Book": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
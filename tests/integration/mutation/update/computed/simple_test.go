@@ -0,0 +1,213 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package computed
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithStoredComputedField_ShouldRecomputeValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						first: String
+						last: String
+						age: Int
+						fullName: String @computed(expr: "concat(first, ' ', last)")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"first": "John",
+					"last": "Doe",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"last": "Smith"
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							fullName
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"fullName": "John Smith",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdate_WithStoredComputedFieldAndUnrelatedField_ShouldNotRecomputeValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						first: String
+						last: String
+						age: Int
+						fullName: String @computed(expr: "concat(first, ' ', last)")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"first": "John",
+					"last": "Doe",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							age
+							fullName
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"age":      int64(22),
+							"fullName": "John Doe",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						commits(fieldName: "fullName") {
+							fieldName
+						}
+					}
+				`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"fieldName": "fullName",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdate_WithNotStoredComputedField_ShouldComputeValueOnRead(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price", stored: false)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"qty": 3,
+					"price": 2.5
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"qty": 4
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Orders {
+							total
+						}
+					}
+				`,
+				Results: map[string]any{
+					"Orders": []map[string]any{
+						{
+							"total": float64(10),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdate_WithComputedFieldSet_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		// Computed fields are not part of the GQL mutation input types, they can only be given
+		// to the collection directly.
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			testUtils.CollectionSaveMutationType,
+			testUtils.CollectionNamedMutationType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Orders {
+						qty: Int
+						price: Float
+						total: Float @computed(expr: "qty * price")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"qty": 3,
+					"price": 2.5
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"total": 10
+				}`,
+				ExpectedError: "computed fields may not be set. Field: total",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
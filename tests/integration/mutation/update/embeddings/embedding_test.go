@@ -14,24 +14,14 @@ import (
 	"testing"
 
 	"github.com/onsi/gomega"
-	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/tests/action"
 	testUtils "github.com/sourcenetwork/defradb/tests/integration"
-	"github.com/sourcenetwork/defradb/tests/state"
 )
 
 func TestMutationUpdate_WithMultipleEmbeddingFields_ShouldSucceed(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Simple update mutation",
-		SupportedClientTypes: immutable.Some([]state.ClientType{
-			// Embedding test with updates are currently only compatible with the Go client.
-			// The docID is updated by collection.Create after vector embedding generation and
-			// the HTTP and CLI clients don't receive that updated docID. This causes the waitForUpdateEvents
-			// to fail sinces it receives an update on a docID that wasn't expected. We will look for a solution
-			// and update the test accordingly.
-			testUtils.GoClientType,
-		}),
 		Actions: []any{
 			&action.AddSchema{
 				Schema: `
@@ -103,14 +93,6 @@ func TestMutationUpdate_WithMultipleEmbeddingFields_ShouldSucceed(t *testing.T)
 func TestMutationUpdate_UserDefinedVectorEmbeddingDoesNotTriggerGeneration_ShouldSucceed(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Simple update mutation with manually defined vector embedding",
-		SupportedClientTypes: immutable.Some([]state.ClientType{
-			// Embedding test with updates are currently only compatible with the Go client.
-			// The docID is updated by collection.Create after vector embedding generation and
-			// the HTTP and CLI clients don't receive that updated docID. This causes the waitForUpdateEvents
-			// to fail sinces it receives an update on a docID that wasn't expected. We will look for a solution
-			// and update the test accordingly.
-			testUtils.GoClientType,
-		}),
 		Actions: []any{
 			&action.AddSchema{
 				Schema: `
@@ -159,14 +141,6 @@ func TestMutationUpdate_UserDefinedVectorEmbeddingDoesNotTriggerGeneration_Shoul
 func TestMutationUpdate_FieldsForEmbeddingNotUpdatedDoesNotTriggerGeneration_ShouldSucceed(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Simple update mutation with manually defined vector embedding",
-		SupportedClientTypes: immutable.Some([]state.ClientType{
-			// Embedding test with updates are currently only compatible with the Go client.
-			// The docID is updated by collection.Create after vector embedding generation and
-			// the HTTP and CLI clients don't receive that updated docID. This causes the waitForUpdateEvents
-			// to fail sinces it receives an update on a docID that wasn't expected. We will look for a solution
-			// and update the test accordingly.
-			testUtils.GoClientType,
-		}),
 		Actions: []any{
 			&action.AddSchema{
 				Schema: `
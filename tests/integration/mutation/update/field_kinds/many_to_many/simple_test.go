@@ -0,0 +1,287 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const (
+	johnID      = "bae-cf49d926-8f02-55c3-a677-8127a3bade12"
+	physicsID   = "bae-05d80d69-40aa-5e7f-8582-fa7d1b566b00"
+	chemistryID = "bae-3a5bfae1-d418-50f9-9a3c-02f3bc27e1d4"
+)
+
+func TestMutationUpdateManyToMany_Link(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many update mutation, linking documents",
+		Actions: []any{
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Student(docID: "%s", input: {courses: {link: ["%s"]}}) {
+							name
+							courses {
+								title
+							}
+						}
+					}`,
+					johnID,
+					physicsID,
+				),
+				Results: map[string]any{
+					"update_Student": []map[string]any{
+						{
+							"name": "John",
+							"courses": []map[string]any{
+								{
+									"title": "Physics",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateManyToMany_LinkFromOtherSide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many update mutation, linking documents from the other side of the relation",
+		Actions: []any{
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Course(docID: "%s", input: {students: {link: ["%s"]}}) {
+							title
+						}
+					}`,
+					chemistryID,
+					johnID,
+				),
+				Results: map[string]any{
+					"update_Course": []map[string]any{
+						{
+							"title": "Chemistry",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Student {
+						name
+						courses {
+							title
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Student": []map[string]any{
+						{
+							"name": "John",
+							"courses": []map[string]any{
+								{
+									"title": "Chemistry",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateManyToMany_Unlink(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many update mutation, unlinking documents",
+		Actions: []any{
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Student(docID: "%s", input: {courses: {link: ["%s", "%s"]}}) {
+							name
+						}
+					}`,
+					johnID,
+					physicsID,
+					chemistryID,
+				),
+				Results: map[string]any{
+					"update_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Course(docID: "%s", input: {students: {unlink: ["%s"]}}) {
+							title
+							students {
+								name
+							}
+						}
+					}`,
+					physicsID,
+					johnID,
+				),
+				Results: map[string]any{
+					"update_Course": []map[string]any{
+						{
+							"title":    "Physics",
+							"students": []map[string]any{},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Student {
+						name
+						courses {
+							title
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Student": []map[string]any{
+						{
+							"name": "John",
+							"courses": []map[string]any{
+								{
+									"title": "Chemistry",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateManyToMany_RelinkAfterUnlink(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many update mutation, linking documents again after they were unlinked",
+		Actions: []any{
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Student(docID: "%s", input: {courses: {link: ["%s"]}}) {
+							name
+						}
+					}`,
+					johnID,
+					physicsID,
+				),
+				Results: map[string]any{
+					"update_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Student(docID: "%s", input: {courses: {unlink: ["%s"]}}) {
+							name
+						}
+					}`,
+					johnID,
+					physicsID,
+				),
+				Results: map[string]any{
+					"update_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Student(docID: "%s", input: {courses: {link: ["%s"]}}) {
+							name
+							courses {
+								title
+							}
+						}
+					}`,
+					johnID,
+					physicsID,
+				),
+				Results: map[string]any{
+					"update_Student": []map[string]any{
+						{
+							"name": "John",
+							"courses": []map[string]any{
+								{
+									"title": "Physics",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateManyToMany_UnlinkNotLinked_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many update mutation, unlinking documents that were never linked",
+		Actions: []any{
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Student(docID: "%s", input: {name: "Johnny", courses: {unlink: ["%s"]}}) {
+							name
+							courses {
+								title
+							}
+						}
+					}`,
+					johnID,
+					physicsID,
+				),
+				Results: map[string]any{
+					"update_Student": []map[string]any{
+						{
+							"name":    "Johnny",
+							"courses": []map[string]any{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
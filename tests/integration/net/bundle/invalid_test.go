@@ -0,0 +1,116 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package bundle_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestBundle_WithTamperedBlock_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description:          "Test that a bundle with a modified block is rejected",
+		SupportedClientTypes: bundleClientTypes,
+		EnableSigning:        true,
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.ExportBundle{
+				NodeID: 0,
+			},
+			testUtils.ImportBundle{
+				NodeID:   1,
+				BundleID: 0,
+				Tamper: func(data []byte) []byte {
+					data[len(data)-1] ^= 0xff
+					return data
+				},
+				ExpectedError: "bundle block data does not match its CID",
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestBundle_WithUnsignedBlocks_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description:          "Test that a bundle with unsigned blocks is rejected",
+		SupportedClientTypes: bundleClientTypes,
+		EnableSigning:        false,
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.ExportBundle{
+				NodeID: 0,
+			},
+			testUtils.ImportBundle{
+				NodeID:        1,
+				BundleID:      0,
+				ExpectedError: "bundle block is not signed",
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package bundle_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/state"
+)
+
+// bundleClientTypes are the client types that can export and import bundles.
+var bundleClientTypes = immutable.Some([]state.ClientType{
+	testUtils.GoClientType,
+	testUtils.HTTPClientType,
+	testUtils.CLIClientType,
+})
+
+func TestBundle_ExportAndImport_ShouldSyncDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description:          "Test documents synchronization between disconnected nodes with a bundle",
+		SupportedClientTypes: bundleClientTypes,
+		EnableSigning:        true,
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Andy",
+					"age": 25
+				}`,
+			},
+			testUtils.ExportBundle{
+				NodeID: 0,
+			},
+			testUtils.ImportBundle{
+				NodeID:   1,
+				BundleID: 0,
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+						{
+							"name": "Andy",
+							"age":  int64(25),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestBundle_WithConcurrentUpdates_ShouldConverge(t *testing.T) {
+	test := testUtils.TestCase{
+		Description:          "Test convergence of concurrent updates exchanged with bundles",
+		SupportedClientTypes: bundleClientTypes,
+		EnableSigning:        true,
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.ExportBundle{
+				NodeID: 0,
+			},
+			testUtils.ImportBundle{
+				NodeID:   1,
+				BundleID: 0,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				DocID:  0,
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				DocID:  0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			// Only the blocks created after the first export are part of this bundle.
+			testUtils.ExportBundle{
+				NodeID: 0,
+				Since:  immutable.Some(0),
+			},
+			testUtils.ExportBundle{
+				NodeID: 1,
+			},
+			testUtils.ImportBundle{
+				NodeID:   1,
+				BundleID: 1,
+			},
+			testUtils.ImportBundle{
+				NodeID:   0,
+				BundleID: 2,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Johnny",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestBundle_ImportTwice_ShouldSucceed(t *testing.T) {
+	test := testUtils.TestCase{
+		Description:          "Test importing the same bundle twice",
+		SupportedClientTypes: bundleClientTypes,
+		EnableSigning:        true,
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.ExportBundle{
+				NodeID: 0,
+			},
+			testUtils.ImportBundle{
+				NodeID:   1,
+				BundleID: 0,
+			},
+			testUtils.ImportBundle{
+				NodeID:   1,
+				BundleID: 0,
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replicator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// An honest peer creating many documents at once must not be rate limited or banned
+// by the default configuration.
+func TestP2POneToOneReplicator_WithBurstOfDocuments_ShouldConverge(t *testing.T) {
+	const docCount = 300
+
+	actions := []any{
+		testUtils.RandomNetworkingConfig(),
+		testUtils.RandomNetworkingConfig(),
+		&action.AddSchema{
+			Schema: `
+				type Users {
+					name: String
+				}
+			`,
+		},
+		testUtils.ConfigureReplicator{
+			SourceNodeID: 0,
+			TargetNodeID: 1,
+		},
+	}
+	for i := 0; i < docCount; i++ {
+		actions = append(actions, testUtils.CreateDoc{
+			NodeID: immutable.Some(0),
+			Doc:    fmt.Sprintf(`{"name": "User %d"}`, i),
+		})
+	}
+	actions = append(
+		actions,
+		testUtils.WaitForSync{},
+		testUtils.Request{
+			NodeID: immutable.Some(1),
+			Request: `query {
+				_count(Users: {})
+			}`,
+			Results: map[string]any{
+				"_count": docCount,
+			},
+		},
+	)
+
+	test := testUtils.TestCase{
+		Actions: actions,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -130,3 +130,70 @@ func TestP2POneToOneReplicatorDeletesDocCreatedBeforeReplicatorConfigWithNodesIn
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestP2POneToOneReplicatorDeletesDocWithOnDeleteCascade_ReplicatesCascadedDeletes(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: CASCADE)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.CreateDoc{
+				NodeID:       immutable.Some(0),
+				CollectionID: 1,
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				NodeID:       immutable.Some(0),
+				CollectionID: 0,
+				DocMap: map[string]any{
+					"name":      "John and the philosopher are stoned",
+					"author_id": testUtils.NewDocIndex(1, 0),
+				},
+			},
+			testUtils.WaitForSync{},
+			testUtils.DeleteDoc{
+				// The delete action is applied by the first node, and the deletion of the book
+				// is replicated to the second node alongside the deletion of the author.
+				NodeID:       immutable.Some(0),
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Book(showDeleted: true) {
+						_deleted
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Book": []map[string]any{
+						{
+							"_deleted": true,
+							"name":     "John and the philosopher are stoned",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -11,8 +11,12 @@
 package tests
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/sourcenetwork/defradb/client"
 	netConfig "github.com/sourcenetwork/defradb/net/config"
 	"github.com/sourcenetwork/defradb/tests/state"
 
@@ -493,3 +497,58 @@ func syncDocs(s *state.State, action SyncDocs) {
 		}
 	}
 }
+
+// exportBundle exports the documents of the given node to a new bundle file.
+func exportBundle(s *state.State, action ExportBundle) {
+	node := s.Nodes[action.NodeID]
+
+	collectionNames := make([]string, len(action.CollectionIDs))
+	for i, collectionID := range action.CollectionIDs {
+		collectionNames[i] = node.Collections[collectionID].Name()
+	}
+
+	var since string
+	if action.Since.HasValue() {
+		since = s.Bundles[action.Since.Value()].Checkpoint
+	}
+
+	bundlePath := filepath.Join(s.T.TempDir(), fmt.Sprintf("bundle-%d.car", len(s.Bundles)))
+	result, err := node.ExportBundle(
+		s.Ctx,
+		client.BundleExportConfig{
+			Filepath:    bundlePath,
+			Collections: collectionNames,
+			Since:       since,
+		},
+	)
+
+	expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
+	assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+
+	if !expectedErrorRaised {
+		s.Bundles = append(s.Bundles, state.Bundle{
+			Filepath:   bundlePath,
+			Checkpoint: result.Checkpoint,
+		})
+	}
+}
+
+// importBundle imports a previously exported bundle into the given node.
+func importBundle(s *state.State, action ImportBundle) {
+	node := s.Nodes[action.NodeID]
+
+	bundlePath := s.Bundles[action.BundleID].Filepath
+	if action.Tamper != nil {
+		data, err := os.ReadFile(bundlePath)
+		require.NoError(s.T, err)
+
+		bundlePath = filepath.Join(s.T.TempDir(), filepath.Base(bundlePath))
+		err = os.WriteFile(bundlePath, action.Tamper(data), 0o644)
+		require.NoError(s.T, err)
+	}
+
+	_, err := node.ImportBundle(s.Ctx, bundlePath)
+
+	expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
+	assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const (
+	physicsID   = "bae-21c36d01-bb22-5044-9fc3-7a987b0059f8"
+	chemistryID = "bae-d9fc4b8e-5fc3-5401-8de2-4a87356778a9"
+	historyID   = "bae-800c082f-a732-59af-8b78-dcf3faa07876"
+)
+
+// createCourses returns the actions creating the courses that students are linked to.
+func createCourses() []any {
+	return []any{
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			Doc: `{
+				"title": "Physics",
+				"credits": 5
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			Doc: `{
+				"title": "Chemistry",
+				"credits": 3
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			Doc: `{
+				"title": "History",
+				"credits": 2
+			}`,
+		},
+	}
+}
+
+func TestQueryManyToMany_WithoutLinks(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many query, without links",
+		Actions: append(
+			createCourses(),
+			testUtils.Request{
+				Request: `query {
+					Course {
+						title
+						students {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Course": []map[string]any{
+						{
+							"title":    "Physics",
+							"students": []map[string]any{},
+						},
+						{
+							"title":    "History",
+							"students": []map[string]any{},
+						},
+						{
+							"title":    "Chemistry",
+							"students": []map[string]any{},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_FromBothSides(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many query, from both sides of the relation",
+		Actions: append(
+			createCourses(),
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "John", courses: {link: ["%s", "%s"]}}) {
+							name
+							courses {
+								title
+							}
+						}
+					}`,
+					physicsID,
+					chemistryID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "John",
+							"courses": []map[string]any{
+								{
+									"title": "Physics",
+								},
+								{
+									"title": "Chemistry",
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "Fred", courses: {link: ["%s"]}}) {
+							name
+						}
+					}`,
+					physicsID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Course {
+						title
+						students {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Course": []map[string]any{
+						{
+							"title": "Physics",
+							"students": []map[string]any{
+								{
+									"name": "Fred",
+								},
+								{
+									"name": "John",
+								},
+							},
+						},
+						{
+							"title":    "History",
+							"students": []map[string]any{},
+						},
+						{
+							"title": "Chemistry",
+							"students": []map[string]any{
+								{
+									"name": "John",
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var studentCourseGQLSchema = (`
+	type Student {
+		name: String
+		courses: [Course] @relation(name: "enrollment")
+	}
+
+	type Course {
+		title: String
+		credits: Int
+		students: [Student] @relation(name: "enrollment")
+	}
+`)
+
+func executeTestCase(t *testing.T, test testUtils.TestCase) {
+	testUtils.ExecuteTestCase(
+		t,
+		testUtils.TestCase{
+			Description: test.Description,
+			Actions: append(
+				[]any{
+					&action.AddSchema{
+						Schema: studentCourseGQLSchema,
+					},
+				},
+				test.Actions...,
+			),
+		},
+	)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryManyToMany_WithCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many query, with count",
+		Actions: append(
+			createCourses(),
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "John", courses: {link: ["%s", "%s"]}}) {
+							name
+						}
+					}`,
+					physicsID,
+					chemistryID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "Fred", courses: {link: ["%s"]}}) {
+							name
+						}
+					}`,
+					physicsID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Course {
+						title
+						_count(students: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Course": []map[string]any{
+						{
+							"title":  "Physics",
+							"_count": 2,
+						},
+						{
+							"title":  "History",
+							"_count": 0,
+						},
+						{
+							"title":  "Chemistry",
+							"_count": 1,
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_WithSumOfRelatedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many query, with sum of a field of the related documents",
+		Actions: append(
+			createCourses(),
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "John", courses: {link: ["%s", "%s"]}}) {
+							name
+						}
+					}`,
+					physicsID,
+					historyID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Student {
+						name
+						_sum(courses: {field: credits})
+					}
+				}`,
+				Results: map[string]any{
+					"Student": []map[string]any{
+						{
+							"name": "John",
+							"_sum": int64(7),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryManyToMany_WithFilterOnRelatedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many query, with filter on a field of the related documents",
+		Actions: append(
+			createCourses(),
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "John", courses: {link: ["%s", "%s"]}}) {
+							name
+						}
+					}`,
+					physicsID,
+					chemistryID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "Fred", courses: {link: ["%s"]}}) {
+							name
+						}
+					}`,
+					historyID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Student(filter: {courses: {title: {_eq: "Chemistry"}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_WithFilterOnRelatedDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many query, with filter on the related documents",
+		Actions: append(
+			createCourses(),
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Student(input: {name: "John", courses: {link: ["%s", "%s", "%s"]}}) {
+							name
+						}
+					}`,
+					physicsID,
+					chemistryID,
+					historyID,
+				),
+				Results: map[string]any{
+					"create_Student": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Student {
+						name
+						courses(filter: {credits: {_gt: 2}}) {
+							title
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Student": []map[string]any{
+						{
+							"name": "John",
+							"courses": []map[string]any{
+								{
+									"title": "Physics",
+								},
+								{
+									"title": "Chemistry",
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
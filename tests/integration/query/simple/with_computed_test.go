@@ -0,0 +1,124 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const computedOrdersSchema = `
+	type Orders {
+		item: String
+		qty: Int
+		price: Float
+		total: Float @computed(expr: "qty * price", stored: false)
+	}
+`
+
+func TestQuerySimple_WithNotStoredComputedFieldFilter_ReturnsMatchingDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: computedOrdersSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"item": "Pen",
+					"qty": 10,
+					"price": 1.5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"item": "Chair",
+					"qty": 2,
+					"price": 45
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Orders(filter: {total: {_gt: 20}}) {
+						item
+					}
+				}`,
+				Results: map[string]any{
+					"Orders": []map[string]any{
+						{
+							"item": "Chair",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithNotStoredComputedFieldOrder_OrdersByComputedValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: computedOrdersSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"item": "Chair",
+					"qty": 2,
+					"price": 45
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"item": "Pen",
+					"qty": 10,
+					"price": 1.5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"item": "Desk",
+					"qty": 1,
+					"price": 120
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Orders(order: {total: DESC}) {
+						item
+						total
+					}
+				}`,
+				Results: map[string]any{
+					"Orders": []map[string]any{
+						{
+							"item":  "Desk",
+							"total": float64(120),
+						},
+						{
+							"item":  "Chair",
+							"total": float64(90),
+						},
+						{
+							"item":  "Pen",
+							"total": float64(15),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
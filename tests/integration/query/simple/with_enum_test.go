@@ -0,0 +1,299 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const enumIssuesSchema = `
+	enum Status {
+		OPEN
+		IN_PROGRESS
+		CLOSED
+	}
+	type Issues {
+		title: String
+		status: Status @index
+	}
+`
+
+// Enum values are given by name in json documents, GQL mutations require enum literals
+// and are tested separately.
+var enumCollectionMutationTypes = immutable.Some([]testUtils.MutationType{
+	testUtils.CollectionSaveMutationType,
+	testUtils.CollectionNamedMutationType,
+})
+
+func TestQuerySimple_WithEnumField_RendersValueNames(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedMutationTypes: enumCollectionMutationTypes,
+		Actions: []any{
+			&action.AddSchema{
+				Schema: enumIssuesSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Crash on start",
+					"status": "IN_PROGRESS"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Typo in docs"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(order: {title: ASC}) {
+						title
+						status
+					}
+				}`,
+				Results: map[string]any{
+					"Issues": []map[string]any{
+						{
+							"title":  "Crash on start",
+							"status": "IN_PROGRESS",
+						},
+						{
+							"title":  "Typo in docs",
+							"status": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithEnumFieldOrder_OrdersByDeclaration(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedMutationTypes: enumCollectionMutationTypes,
+		Actions: []any{
+			&action.AddSchema{
+				Schema: enumIssuesSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Closed",
+					"status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Open",
+					"status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "In progress",
+					"status": "IN_PROGRESS"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(order: {status: ASC}) {
+						title
+					}
+				}`,
+				Results: map[string]any{
+					"Issues": []map[string]any{
+						{
+							"title": "Open",
+						},
+						{
+							"title": "In progress",
+						},
+						{
+							"title": "Closed",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithEnumFieldFilter_ReturnsMatchingDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedMutationTypes: enumCollectionMutationTypes,
+		Actions: []any{
+			&action.AddSchema{
+				Schema: enumIssuesSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Closed",
+					"status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Open",
+					"status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "In progress",
+					"status": "IN_PROGRESS"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(filter: {status: {_eq: OPEN}}) {
+						title
+						status
+					}
+				}`,
+				Results: map[string]any{
+					"Issues": []map[string]any{
+						{
+							"title":  "Open",
+							"status": "OPEN",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(filter: {status: {_in: [OPEN, CLOSED]}}, order: {title: ASC}) {
+						title
+					}
+				}`,
+				Results: map[string]any{
+					"Issues": []map[string]any{
+						{
+							"title": "Closed",
+						},
+						{
+							"title": "Open",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(filter: {status: {_gt: OPEN}}, order: {status: ASC}) {
+						title
+					}
+				}`,
+				Results: map[string]any{
+					"Issues": []map[string]any{
+						{
+							"title": "In progress",
+						},
+						{
+							"title": "Closed",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithEnumFieldCreatedViaGQL_RendersValueNames(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: enumIssuesSchema,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Issues(input: {title: "Crash on start", status: CLOSED}) {
+						title
+						status
+					}
+				}`,
+				Results: map[string]any{
+					"create_Issues": []map[string]any{
+						{
+							"title":  "Crash on start",
+							"status": "CLOSED",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Issues(input: {status: OPEN}) {
+						title
+						status
+					}
+				}`,
+				Results: map[string]any{
+					"update_Issues": []map[string]any{
+						{
+							"title":  "Crash on start",
+							"status": "OPEN",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedMutationTypes: enumCollectionMutationTypes,
+		Actions: []any{
+			&action.AddSchema{
+				Schema: enumIssuesSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Crash on start",
+					"status": "REOPENED"
+				}`,
+				ExpectedError: "invalid enum value. Field: status, Enum: Status, Value: REOPENED",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidEnumLiteral_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: enumIssuesSchema,
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(filter: {status: {_eq: REOPENED}}) {
+						title
+					}
+				}`,
+				ExpectedError: `Argument "filter" has invalid value {status: {_eq: REOPENED}}.`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
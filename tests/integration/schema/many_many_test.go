@@ -0,0 +1,91 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaManyMany_JoinCollectionNotQueriable(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Student {
+						name: String
+						courses: [Course] @relation(name: "enrollment")
+					}
+					type Course {
+						title: String
+						students: [Student] @relation(name: "enrollment")
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_enrollment {
+						linked
+					}
+				}`,
+				ExpectedError: `Cannot query field "_enrollment" on type "Query".`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaManyMany_FieldNamedLinked_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Student {
+						name: String
+						linked: [Course] @relation(name: "enrollment")
+					}
+					type Course {
+						title: String
+						students: [Student] @relation(name: "enrollment")
+					}
+				`,
+				ExpectedError: "the fields of a many-to-many relation must have distinct names, and may not be named `linked`",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaManyMany_FieldsWithSameName_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Student {
+						name: String
+						related: [Course] @relation(name: "enrollment")
+					}
+					type Course {
+						title: String
+						related: [Student] @relation(name: "enrollment")
+					}
+				`,
+				ExpectedError: "the fields of a many-to-many relation must have distinct names",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,362 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaMigrationMigrateDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents in batches",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						height: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"height": 1.5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"height": 1.8
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"height": 1.7
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "heightCm", "Kind": "Float"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformConvert,
+							Arguments: map[string]any{
+								"src":        "height",
+								"dst":        "heightCm",
+								"multiplier": 100,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.MigrateDocuments{
+				BatchSize: 2,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  2,
+						Processed: 2,
+						Total:     3,
+					},
+					{
+						Migrated:  1,
+						Processed: 3,
+						Total:     3,
+						Done:      true,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						heightCm
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "Fred",
+							"heightCm": float64(180),
+						},
+						{
+							"name":     "Shahzad",
+							"heightCm": float64(170),
+						},
+						{
+							"name":     "John",
+							"heightCm": float64(150),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationMigrateDocuments_AlreadyMigrated(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents twice",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformSetDefault,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"verified": false
+				}`,
+			},
+			testUtils.MigrateDocuments{
+				BatchSize: 10,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  1,
+						Processed: 2,
+						Total:     2,
+						Done:      true,
+					},
+				},
+			},
+			testUtils.MigrateDocuments{
+				BatchSize: 10,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Processed: 2,
+						Total:     2,
+						Done:      true,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"verified": true,
+						},
+						{
+							"name":     "Fred",
+							"verified": false,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationMigrateDocuments_Resumed(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents resumed after a batch",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformSetDefault,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.MigrateDocuments{
+				BatchSize:  1,
+				MaxBatches: 1,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  1,
+						Processed: 1,
+						Total:     2,
+					},
+				},
+			},
+			testUtils.MigrateDocuments{
+				BatchSize: 1,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  1,
+						Processed: 2,
+						Total:     2,
+						Done:      true,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationMigrateDocuments_ToPreviousVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents to a previous version",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/1" },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "fullName", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformRename,
+							Arguments: map[string]any{
+								"src": "name",
+								"dst": "fullName",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"fullName": "Fred"
+				}`,
+			},
+			testUtils.MigrateDocuments{
+				VersionID: immutable.Some("bafkreia3o3cetvcnnxyu5spucimoos77ifungfmacxdkva4zah2is3aooe"),
+				BatchSize: 10,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  1,
+						Processed: 1,
+						Total:     1,
+						Done:      true,
+					},
+				},
+			},
+			testUtils.SetActiveSchemaVersion{
+				SchemaVersionID: "bafkreia3o3cetvcnnxyu5spucimoos77ifungfmacxdkva4zah2is3aooe",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationMigrateDocuments_InvalidBatchSize(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents with an invalid batch size",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.MigrateDocuments{
+				BatchSize:     0,
+				ExpectedError: "migration batch size must be greater than zero",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaMigrationMigrateDocuments_WithoutAccessToDoc_SkipsDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents without access to one of them",
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy: `
+                    name: test
+                    description: a test policy which marks a collection in a database as a resource
+
+                    actor:
+                      name: actor
+
+                    resources:
+                      users:
+                        permissions:
+                          read:
+                            expr: owner
+                          update:
+                            expr: owner
+                          delete:
+                            expr: owner
+
+                        relations:
+                          owner:
+                            types:
+                              - actor
+                `,
+			},
+			&action.AddSchema{
+				Schema: `
+					type Users @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(2),
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformSetDefault,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.MigrateDocuments{
+				Identity:  testUtils.ClientIdentity(1),
+				BatchSize: 10,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  1,
+						Processed: 2,
+						Skipped:   1,
+						Total:     2,
+						Done:      true,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaMigrationMigrateDocuments_WithIndexOnMigratedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, migrate stored documents updates index entries",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						height: Float @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"height": 1.5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"height": 1.8
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformConvert,
+							Arguments: map[string]any{
+								"src":        "height",
+								"multiplier": 100,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.MigrateDocuments{
+				BatchSize: 10,
+				ExpectedProgress: []client.DocumentMigrationProgress{
+					{
+						Migrated:  2,
+						Processed: 2,
+						Total:     2,
+						Done:      true,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {height: {_eq: 150}}) {
+						name
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":   "John",
+							"height": float64(150),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {height: {_eq: 1.8}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,652 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/lenses"
+)
+
+func TestSchemaMigrationQueryWithDeclarativeRename(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative rename",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/1" },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "fullName", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformRename,
+							Arguments: map[string]any{
+								"src": "name",
+								"dst": "fullName",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						fullName
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"fullName": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeRename_InversedToPreviousVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative rename inversed to previous version",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/1" },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "fullName", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformRename,
+							Arguments: map[string]any{
+								"src": "name",
+								"dst": "fullName",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"fullName": "Fred"
+				}`,
+			},
+			testUtils.SetActiveSchemaVersion{
+				SchemaVersionID: "bafkreia3o3cetvcnnxyu5spucimoos77ifungfmacxdkva4zah2is3aooe",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeSetDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative set default",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformSetDefault,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"verified": true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeCopy(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative copy",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "displayName", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformCopy,
+							Arguments: map[string]any{
+								"src": "name",
+								"dst": "displayName",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						displayName
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":        "John",
+							"displayName": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeSplit(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative split",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John Smith"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "firstName", "Kind": "String"} },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "lastName", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformSplit,
+							Arguments: map[string]any{
+								"src":       "name",
+								"dst":       []any{"firstName", "lastName"},
+								"separator": " ",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						firstName
+						lastName
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":      "John Smith",
+							"firstName": "John",
+							"lastName":  "Smith",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeConcat(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative concat",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						firstName: String
+						lastName: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"firstName": "John",
+					"lastName": "Smith"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "name", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformConcat,
+							Arguments: map[string]any{
+								"src":       []any{"firstName", "lastName"},
+								"dst":       "name",
+								"separator": " ",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John Smith",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeConvert(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative unit conversion",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						height: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"height": 1.5
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "heightCm", "Kind": "Float"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformConvert,
+							Arguments: map[string]any{
+								"src":        "height",
+								"dst":        "heightCm",
+								"multiplier": 100,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						heightCm
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"heightCm": float64(150),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeAndWasmTransforms(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative and wasm transforms in the same migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "displayName", "Kind": "String"} },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformCopy,
+							Arguments: map[string]any{
+								"src": "name",
+								"dst": "displayName",
+							},
+						},
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						displayName
+						verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"displayName": "John",
+							"verified":    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithUnknownDeclarativeTransformErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, unknown declarative transform",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformPathPrefix + "reverse",
+						},
+					},
+				}),
+				ExpectedError: "unknown lens transform. Path: defradb:reverse",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithMissingDeclarativeTransformArgErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative transform missing argument",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "displayName", "Kind": "String"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: client.LensTransformCopy,
+							Arguments: map[string]any{
+								"src": "name",
+							},
+						},
+					},
+				}),
+				ExpectedError: "missing lens transform argument. Path: defradb:copy, Argument: dst",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationQueryWithDeclarativeSetDefault_WithUncommittedTxn_ShouldNotMigrateOutsideTxn(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema migration, declarative set default changed within a txn that is not committed",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+			},
+			testUtils.ConfigureMigration{
+				LensConfig: client.LensConfig{
+					SourceSchemaVersionID:      "bafkreia3o3cetvcnnxyu5spucimoos77ifungfmacxdkva4zah2is3aooe",
+					DestinationSchemaVersionID: "bafkreiahhaeagyfsxaxmv3d665qvnbtyn3ts6jshhghy5bijwztbe7efpq",
+					Lens: model.Lens{
+						Lenses: []model.LensModule{
+							{
+								Path: client.LensTransformSetDefault,
+								Arguments: map[string]any{
+									"dst":   "verified",
+									"value": true,
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.ConfigureMigration{
+				TransactionID: immutable.Some(0),
+				LensConfig: client.LensConfig{
+					SourceSchemaVersionID:      "bafkreia3o3cetvcnnxyu5spucimoos77ifungfmacxdkva4zah2is3aooe",
+					DestinationSchemaVersionID: "bafkreiahhaeagyfsxaxmv3d665qvnbtyn3ts6jshhghy5bijwztbe7efpq",
+					Lens: model.Lens{
+						Lenses: []model.LensModule{
+							{
+								Path: client.LensTransformSetDefault,
+								Arguments: map[string]any{
+									"dst":   "verified",
+									"value": false,
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				TransactionID: immutable.Some(0),
+				Request: `query {
+					Users {
+						name
+						verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"verified": false,
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"verified": true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
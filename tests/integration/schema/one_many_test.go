@@ -294,3 +294,25 @@ func TestSchemaOneMany_SelfUsingActualName(t *testing.T) {
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestSchemaOneMany_OnDeleteOnSecondarySide_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+						dogs: [Dog] @relation(onDelete: CASCADE)
+					}
+					type Dog {
+						name: String
+						owner: User
+					}
+				`,
+				ExpectedError: "onDelete may only be set on the primary side of a relation",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
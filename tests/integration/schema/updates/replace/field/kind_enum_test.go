@@ -0,0 +1,172 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replace
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesReplaceFieldKind_WithEnumValueAppended(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, append a value to an indexed enum field",
+		// Enum values are given by name in json documents, GQL mutations require enum literals.
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			testUtils.CollectionSaveMutationType,
+			testUtils.CollectionNamedMutationType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+					type Issues {
+						title: String
+						status: Status @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Crash on start",
+					"status": "CLOSED"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Issues/Fields/1/Kind/Values/-", "value": "ARCHIVED" }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"title": "Typo in docs",
+					"status": "ARCHIVED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Issues(filter: {status: {_in: [CLOSED, ARCHIVED]}}, order: {status: ASC}) {
+						title
+						status
+					}
+				}`,
+				Results: map[string]any{
+					"Issues": []map[string]any{
+						{
+							"title":  "Crash on start",
+							"status": "CLOSED",
+						},
+						{
+							"title":  "Typo in docs",
+							"status": "ARCHIVED",
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_WithEnumValuesReordered_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, reorder the values of an enum field",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+					type Issues {
+						title: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Issues/Fields/1/Kind/Values", "value": ["CLOSED", "OPEN"] }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ProposedName: status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_WithEnumValueRemoved_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove a value of an enum field",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+					type Issues {
+						title: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Issues/Fields/1/Kind/Values/1" }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ProposedName: status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_WithEnumValueAppendedToOneOfItsFields_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, append a value to the enum of only one of the fields holding it",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+					type Issues {
+						previousStatus: Status
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Issues/Fields/2/Kind/Values/-", "value": "ARCHIVED" }
+					]
+				`,
+				ExpectedError: "enums of the same name must hold the same values. Name: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
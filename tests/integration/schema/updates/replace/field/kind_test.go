@@ -0,0 +1,395 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replace
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/lens/host-go/config/model"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/lenses"
+)
+
+func TestSchemaUpdatesReplaceFieldKindErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind without migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "Float64" }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ProposedName: age",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindWithMigration(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind with migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "Float64" },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"age": 32.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+						verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "Fred",
+							"age":      float64(32.5),
+							"verified": nil,
+						},
+						{
+							"name":     "John",
+							"age":      float64(21),
+							"verified": true,
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindToArrayWithMigrationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind with array kind with migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "[Int]" }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "age",
+								"value": []int{},
+							},
+						},
+					},
+				}),
+				ExpectedError: "changing the kind of this field is not supported. Name: age",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceIndexedFieldKindWithMigrationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace indexed field kind with migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @index
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "Float64" }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "age",
+								"value": 0,
+							},
+						},
+					},
+				}),
+				ExpectedError: "changing the kind of an indexed field is not supported. Field: age",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindStringToJSONWithMigration(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace string field kind with json, the migration does not write the field",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						bio: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"bio": "Likes trains"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "JSON" },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "verified", "Kind": "Boolean"} }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "verified",
+								"value": true,
+							},
+						},
+					},
+				}),
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"bio": {"likes": "planes"}
+				}`,
+			},
+			testUtils.Request{
+				// The bio of John was stored as a string, and is read as a json string.
+				Request: `query {
+					Users {
+						name
+						bio
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Fred",
+							"bio": map[string]any{
+								"likes": "planes",
+							},
+						},
+						{
+							"name": "John",
+							"bio":  "Likes trains",
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindWithMigration_FilterOnUnmigratedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind with migration, filter on the value of an old document",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam",
+					"age": 40
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "Float64" }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "name",
+								"value": "Unknown",
+							},
+						},
+					},
+				}),
+			},
+			testUtils.Request{
+				// The ages were stored as ints, and are not written by the migration.
+				Request: `query {
+					Users(filter: {age: {_lt: 30.5}}) {
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"age": float64(21),
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindJSONToStringWithMigrationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace json field kind with string with migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						bio: JSON
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "String" }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "bio",
+								"value": "",
+							},
+						},
+					},
+				}),
+				ExpectedError: "changing the kind of this field is not supported. Name: bio",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindStringToIntWithMigrationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace string field kind with int with migration",
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String
+						age: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Kind", "value": "Int" }
+					]
+				`,
+				Lens: immutable.Some(model.Lens{
+					Lenses: []model.LensModule{
+						{
+							Path: lenses.SetDefaultModulePath,
+							Arguments: map[string]any{
+								"dst":   "age",
+								"value": 0,
+							},
+						},
+					},
+				}),
+				ExpectedError: "changing the kind of this field is not supported. Name: age",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchema_WithConstraints_ExposesConstraintsThroughIntrospection(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Users {
+						name: String @constraints(notNull: true, minLength: 2, maxLength: 10, pattern: "^[A-Z]\\w*$")
+						age: Int @constraints(min: 0, max: 150)
+						score: Float @constraints(min: 0.5)
+						status: String @constraints(oneOf: ["active", "inactive"])
+						level: Int @constraints(oneOf: [1, 2, 3])
+						nickname: String
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "Users") {
+							fields {
+								name
+								description
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"fields": []any{
+							map[string]any{
+								"name":        "name",
+								"description": `@constraints(minLength: 2, maxLength: 10, pattern: "^[A-Z]\\w*$", notNull: true)`,
+							},
+							map[string]any{
+								"name":        "age",
+								"description": "@constraints(min: 0, max: 150)",
+							},
+							map[string]any{
+								"name":        "score",
+								"description": "@constraints(min: 0.5)",
+							},
+							map[string]any{
+								"name":        "status",
+								"description": `@constraints(oneOf: ["active", "inactive"])`,
+							},
+							map[string]any{
+								"name":        "level",
+								"description": "@constraints(oneOf: [1, 2, 3])",
+							},
+							map[string]any{
+								"name":        "nickname",
+								"description": "",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
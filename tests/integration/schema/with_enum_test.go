@@ -0,0 +1,133 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchema_WithEnumField_GeneratesEnumType(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Status {
+						OPEN
+						IN_PROGRESS
+						CLOSED
+					}
+					type Issues {
+						title: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+				{
+					__type(name: "Status") {
+						name
+						kind
+					}
+				}
+				`,
+				ExpectedData: map[string]any{
+					"__type": map[string]any{
+						"name": "Status",
+						"kind": "ENUM",
+					},
+				},
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+				{
+					__type(name: "Issues") {
+						fields {
+							name
+							type {
+								name
+								kind
+							}
+						}
+					}
+				}
+				`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"fields": DefaultFields.Append(
+							Field{
+								"name": "title",
+								"type": map[string]any{
+									"name": "String",
+									"kind": "SCALAR",
+								},
+							},
+						).Append(
+							Field{
+								"name": "status",
+								"type": map[string]any{
+									"name": "Status",
+									"kind": "ENUM",
+								},
+							},
+						).Tidy(),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchema_WithEnumArrayField_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+					type Issues {
+						statuses: [Status]
+					}
+				`,
+				ExpectedError: "array fields of enum types are not supported. Field: statuses, Enum: Status",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchema_WithEnumNameMatchingCollection_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					enum Users {
+						ACTIVE
+					}
+					type Users {
+						status: Users
+					}
+				`,
+				ExpectedError: "schema type already exists. Name: Users",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -77,6 +77,9 @@ type TestCase struct {
 	// Use [IdentityTypes] to customize the key type that is used for identity and signing.
 	EnableSigning bool
 
+	// EnableACPAuditLog indicates if the document acp audit log should be enabled for the test.
+	EnableACPAuditLog bool
+
 	// IdentityTypes is a map of identity to key type.
 	// Use it to customize the key type that is used for identity and signing.
 	IdentityTypes map[state.Identity]crypto.KeyType
@@ -264,6 +267,15 @@ type CreateView struct {
 	// An optional Lens transform to add to the view.
 	Transform immutable.Option[model.Lens]
 
+	// The identity of this request. Optional.
+	//
+	// If an Identity is provided and the view has a policy, then the view
+	// will be owned by this Identity.
+	//
+	// Use `ClientIdentity` to create a client identity and `NodeIdentity` to create a node identity.
+	// Default value is `NoIdentity()`.
+	Identity immutable.Option[state.Identity]
+
 	// Any error expected from the action. Optional.
 	//
 	// String can be a partial, and the test will pass if an error is returned that
@@ -281,6 +293,15 @@ type RefreshViews struct {
 	// The set of fetch options for the views.
 	FilterOptions client.CollectionFetchOptions
 
+	// The identity of this request. Optional.
+	//
+	// If an Identity is provided, the views will be refreshed with the documents
+	// visible to this Identity.
+	//
+	// Use `ClientIdentity` to create a client identity and `NodeIdentity` to create a node identity.
+	// Default value is `NoIdentity()`.
+	Identity immutable.Option[state.Identity]
+
 	// Any error expected from the action. Optional.
 	//
 	// String can be a partial, and the test will pass if an error is returned that
@@ -288,6 +309,20 @@ type RefreshViews struct {
 	ExpectedError string
 }
 
+// WaitForViewRefresh action will wait for auto refreshed views to be refreshed following a change
+// to the documents they are sourced from.
+type WaitForViewRefresh struct {
+	// NodeID may hold the ID (index) of a node to wait on.
+	//
+	// If a value is not provided the test will wait on all nodes.
+	NodeID immutable.Option[int]
+
+	// The number of view refreshes to wait for.
+	//
+	// If a value is not provided a single refresh will be waited for.
+	Count immutable.Option[int]
+}
+
 // CreateDoc will attempt to create the given document in the given collection
 // using the set [MutationType].
 type CreateDoc struct {
@@ -508,6 +543,9 @@ type CreateIndex struct {
 	// If Unique is true, the index will be created as a unique index.
 	Unique bool
 
+	// If Blind is true, the index will be created as a blind index.
+	Blind bool
+
 	// Any error expected from the action. Optional.
 	//
 	// String can be a partial, and the test will pass if an error is returned that
@@ -610,6 +648,10 @@ type Request struct {
 	// Used to identify the transaction for this to run against. Optional.
 	TransactionID immutable.Option[int]
 
+	// The index of the capability token to execute this request with, in the order the tokens
+	// were created by [NewCapabilityToken] actions. Optional.
+	Capability immutable.Option[int]
+
 	// OperationName sets the operation name option for the request.
 	OperationName immutable.Option[string]
 
@@ -838,6 +880,44 @@ type VerifyBlockSignature struct {
 	ExpectedError string
 }
 
+// ExportBundle will export the documents of a node to an offline bundle.
+//
+// Bundles are indexed in the order they were exported.
+type ExportBundle struct {
+	// NodeID holds the ID (index) of a node to export the bundle from.
+	NodeID int
+
+	// The indices of the collections to export.
+	//
+	// If empty, all collections are exported.
+	CollectionIDs []int
+
+	// The index of a previously exported bundle to use as the checkpoint of the export.
+	//
+	// If set, only the blocks created after that export are included in the bundle.
+	Since immutable.Option[int]
+
+	// Any error expected from the action.
+	ExpectedError string
+}
+
+// ImportBundle will import a previously exported bundle into a node.
+type ImportBundle struct {
+	// NodeID holds the ID (index) of a node to import the bundle into.
+	NodeID int
+
+	// The index of the bundle to import.
+	BundleID int
+
+	// Tamper, if set, is called with the content of the bundle file before it is imported.
+	//
+	// It can be used to corrupt the bundle.
+	Tamper func([]byte) []byte
+
+	// Any error expected from the action.
+	ExpectedError string
+}
+
 // SyncDocs will synchronize documents from the network via P2P.
 type SyncDocs struct {
 	// NodeID holds the ID (index) of a node to execute the sync on.
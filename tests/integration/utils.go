@@ -379,9 +379,15 @@ func performAction(
 	case RefreshViews:
 		refreshViews(s, action)
 
+	case WaitForViewRefresh:
+		waitForViewRefresh(s, action)
+
 	case ConfigureMigration:
 		configureMigration(s, action)
 
+	case MigrateDocuments:
+		migrateDocuments(s, action)
+
 	case AddDACPolicy:
 		addDACPolicy(s, action)
 
@@ -391,6 +397,18 @@ func performAction(
 	case DeleteDACActorRelationship:
 		deleteDACActorRelationship(s, action)
 
+	case AddDACActorRelationships:
+		addDACActorRelationships(s, action)
+
+	case DeleteDACActorRelationships:
+		deleteDACActorRelationships(s, action)
+
+	case GetACPAuditLog:
+		getACPAuditLog(s, action)
+
+	case NewCapabilityToken:
+		newCapabilityToken(s, action)
+
 	case ReEnableNAC:
 		reEnableNAC(s, action)
 
@@ -418,6 +436,9 @@ func performAction(
 	case UpdateWithFilter:
 		updateWithFilter(s, action)
 
+	case RotateEncryptionKey:
+		rotateEncryptionKey(s, action)
+
 	case CreateIndex:
 		createIndex(s, action)
 
@@ -457,6 +478,12 @@ func performAction(
 	case SyncDocs:
 		syncDocs(s, action)
 
+	case ExportBundle:
+		exportBundle(s, action)
+
+	case ImportBundle:
+		importBundle(s, action)
+
 	case Wait:
 		<-time.After(action.Duration)
 
@@ -1226,22 +1253,26 @@ func createView(
 		}, "")
 	}
 
-	_, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
-	for _, node := range nodes {
-		_, err := node.AddView(s.Ctx, action.Query, action.SDL, action.Transform)
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		ctx := getContextWithIdentity(s.Ctx, s, action.Identity, nodeIDs[index])
+		_, err := node.AddView(ctx, action.Query, action.SDL, action.Transform)
 		expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
 
 		assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
 	}
+
+	refreshCollections(s)
 }
 
 func refreshViews(
 	s *state.State,
 	action RefreshViews,
 ) {
-	_, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
-	for _, node := range nodes {
-		err := node.RefreshViews(s.Ctx, action.FilterOptions)
+	nodeIDs, nodes := getNodesWithIDs(action.NodeID, s.Nodes)
+	for index, node := range nodes {
+		ctx := getContextWithIdentity(s.Ctx, s, action.Identity, nodeIDs[index])
+		err := node.RefreshViews(ctx, action.FilterOptions)
 		expectedErrorRaised := AssertError(s.T, err, action.ExpectedError)
 		assertExpectedErrorRaised(s.T, action.ExpectedError, expectedErrorRaised)
 	}
@@ -1447,19 +1478,29 @@ func createDocViaGQL(
 // substituteRelations scans the fields defined in [action.DocMap], if any are of type [DocIndex]
 // it will substitute the [DocIndex] for the the corresponding document ID found in the state.
 //
+// Fields holding identities (or lists of identities) are substituted for the DIDs of the identities.
+//
 // If a document at that index is not found it will panic.
 func substituteRelations(
 	s *state.State,
 	action CreateDoc,
 ) {
 	for k, v := range action.DocMap {
-		index, isIndex := v.(DocIndex)
-		if !isIndex {
-			continue
-		}
+		switch value := v.(type) {
+		case DocIndex:
+			docID := s.DocIDs[value.CollectionIndex][value.Index]
+			action.DocMap[k] = docID.String()
+
+		case immutable.Option[state.Identity]:
+			action.DocMap[k] = getIdentityDID(s, value)
 
-		docID := s.DocIDs[index.CollectionIndex][index.Index]
-		action.DocMap[k] = docID.String()
+		case []immutable.Option[state.Identity]:
+			dids := make([]any, len(value))
+			for i, identity := range value {
+				dids[i] = getIdentityDID(s, identity)
+			}
+			action.DocMap[k] = dids
+		}
 	}
 }
 
@@ -1684,6 +1725,7 @@ func createIndex(
 		}
 
 		indexDesc.Unique = action.Unique
+		indexDesc.Blind = action.Blind
 		err := withRetryOnNode(
 			node,
 			func() error {
@@ -1860,6 +1902,9 @@ nodeLoop:
 		nodeID := nodeIDs[index]
 		txn := getTransaction(s, node, action.TransactionID, action.ExpectedError)
 		ctx := getContextWithIdentity(db.InitContext(s.Ctx, txn), s, action.Identity, nodeID)
+		if action.Capability.HasValue() {
+			ctx = getContextWithCapability(ctx, s, action.Capability.Value())
+		}
 
 		var options []client.RequestOption
 		if action.OperationName.HasValue() {
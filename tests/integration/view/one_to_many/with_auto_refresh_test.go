@@ -0,0 +1,306 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestView_OneToManyAutoRefreshed_UpdatesOnRelatedDocChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			// As the MaterializedViewType will refresh views immediately prior to executing
+			// requests, this test of auto refreshed views only supports running with the
+			// CachelessViewType flag.
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Author {
+						name: String
+						books: [Book]
+					}
+					type Book {
+						name: String
+						author: Author
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					Author {
+						name
+						books {
+							name
+						}
+					}
+				`,
+				SDL: `
+					type AuthorView @materialized(autoRefresh: true) {
+						name: String
+						books: [BookView]
+					}
+					interface BookView {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name":	"Harper Lee"
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				DocMap: map[string]any{
+					"name":      "To Kill a Mockingbird",
+					"author_id": testUtils.NewDocIndex(0, 0),
+				},
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				DocMap: map[string]any{
+					"name":      "Go Set a Watchman",
+					"author_id": testUtils.NewDocIndex(0, 0),
+				},
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.UpdateDoc{
+				CollectionID: 1,
+				DocID:        0,
+				Doc: `{
+					"name":	"To Kill a Mockingbird (50th Anniversary Edition)"
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							AuthorView {
+								name
+								books {
+									name
+								}
+							}
+						}`,
+				Results: map[string]any{
+					"AuthorView": []map[string]any{
+						{
+							"name": "Harper Lee",
+							"books": []map[string]any{
+								{
+									"name": "Go Set a Watchman",
+								},
+								{
+									"name": "To Kill a Mockingbird (50th Anniversary Edition)",
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        1,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							AuthorView {
+								name
+								books {
+									name
+								}
+							}
+						}`,
+				Results: map[string]any{
+					"AuthorView": []map[string]any{
+						{
+							"name": "Harper Lee",
+							"books": []map[string]any{
+								{
+									"name": "To Kill a Mockingbird (50th Anniversary Edition)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_OneToManyAutoRefreshedWithRelatedFilter_UpdatesOnRelatedDocChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Author {
+						name: String
+						books: [Book]
+					}
+					type Book {
+						name: String
+						author: Author
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					Author(filter: {books: {name: {_eq: "To Kill a Mockingbird"}}}) {
+						name
+					}
+				`,
+				SDL: `
+					type AuthorView @materialized(autoRefresh: true) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name":	"Harper Lee"
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							AuthorView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"AuthorView": []map[string]any{},
+				},
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				DocMap: map[string]any{
+					"name":      "To Kill a Mockingbird",
+					"author_id": testUtils.NewDocIndex(0, 0),
+				},
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							AuthorView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"AuthorView": []map[string]any{
+						{
+							"name": "Harper Lee",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_OneToManyAutoRefreshedWithCount_RebuildsOnRelatedDocChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type Author {
+						name: String
+						books: [Book]
+					}
+					type Book {
+						name: String
+						author: Author
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					Author {
+						name
+						numberOfBooks: _count(books: {})
+					}
+				`,
+				SDL: `
+					type AuthorView @materialized(autoRefresh: true) {
+						name: String
+						numberOfBooks: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name":	"Harper Lee"
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				DocMap: map[string]any{
+					"name":      "To Kill a Mockingbird",
+					"author_id": testUtils.NewDocIndex(0, 0),
+				},
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				DocMap: map[string]any{
+					"name":      "Go Set a Watchman",
+					"author_id": testUtils.NewDocIndex(0, 0),
+				},
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							AuthorView {
+								name
+								numberOfBooks
+							}
+						}`,
+				Results: map[string]any{
+					"AuthorView": []map[string]any{
+						{
+							"name":          "Harper Lee",
+							"numberOfBooks": 1,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
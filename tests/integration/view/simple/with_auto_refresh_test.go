@@ -0,0 +1,427 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestView_SimpleAutoRefreshed_PopulatesOnViewCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			// As the MaterializedViewType will refresh views immediately prior to executing
+			// requests, this test of auto refreshed views only supports running with the
+			// CachelessViewType flag.
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleAutoRefreshed_UpdatesOnDocCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred"
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+						},
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleAutoRefreshedWithFilter_UpdatesOnDocUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User(filter: {age: {_gt: 30}}) {
+						name
+						age
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age": 31
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age": 25
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 29
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc: `{
+					"age": 35
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							UserView {
+								name
+								age
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "Fred",
+							"age":  int64(35),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleAutoRefreshed_UpdatesOnDocDelete(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred"
+				}`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) {
+						name: String
+					}
+				`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleAutoRefreshedWithLimit_RebuildsOnDocCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User(order: {age: DESC}, limit: 1) {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age": 31
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age": 35
+				}`,
+			},
+			testUtils.WaitForViewRefresh{},
+			testUtils.Request{
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleAutoRefreshed_DoesNotAutoRefreshWithoutCache(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			// The MaterializedViewType would add a @materialized directive to the view.
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(if: false, autoRefresh: true) {
+						name: String
+					}
+				`,
+				ExpectedError: "only materialized views may be auto refreshed",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleAutoRefreshed_UpdatesOnDocSync(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) {
+						name: String
+					}
+				`,
+			},
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.CreateDoc{
+				// Create John on the first (source) node only, the view of the second node
+				// must be refreshed once the document has been merged into it.
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.WaitForViewRefresh{
+				NodeID: immutable.Some(1),
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
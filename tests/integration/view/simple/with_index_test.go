@@ -0,0 +1,331 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestView_SimpleWithIndex_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(if: false) {
+						name: String @index
+					}
+				`,
+				ExpectedError: "only materialized views may be indexed",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleMaterializedWithUniqueIndex_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView {
+						name: String @index(unique: true)
+					}
+				`,
+				ExpectedError: "unique indexes are not supported on views",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleMaterializedCreateUniqueIndex_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				CollectionID:  1,
+				FieldName:     "name",
+				Unique:        true,
+				ExpectedError: "unique indexes are not supported on views",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleMaterializedWithIndex_FilterEqOnIndexedField(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+						age
+					}
+				`,
+				SDL: `
+					type UserView {
+						name: String @index
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+							UserView(filter: {name: {_eq: "John"}}) {
+								name
+								age
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(30),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleMaterializedWithIndex_FilterInOnIndexedField(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+						age
+					}
+				`,
+				SDL: `
+					type UserView {
+						name: String
+						age: Int @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age": 40
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Islam",
+					"age": 50
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+							UserView(filter: {age: {_in: [30, 50]}}) {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "Islam",
+						},
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleMaterializedWithIndex_FilterOnIndexedFieldAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView {
+						name: String @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+							UserView(filter: {name: {_eq: "John"}}) {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name":	"Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+							UserView(filter: {name: {_eq: "John"}}) {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+							UserView(filter: {name: {_eq: "Fred"}}) {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "Fred",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,354 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/tests/action"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const userViewPolicy = `
+    name: test
+    description: a test policy which marks a collection and a view in a database as resources
+
+    actor:
+      name: actor
+
+    resources:
+      users:
+        permissions:
+          read:
+            expr: owner + reader
+          update:
+            expr: owner
+          delete:
+            expr: owner
+
+        relations:
+          owner:
+            types:
+              - actor
+          reader:
+            types:
+              - actor
+
+      userView:
+        permissions:
+          read:
+            expr: owner + reader
+          update:
+            expr: owner
+          delete:
+            expr: owner
+
+        relations:
+          owner:
+            types:
+              - actor
+          reader:
+            types:
+              - actor
+`
+
+func TestView_SimpleWithPolicy_SharedWithActorThatCanNotReadSource(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			// The policy of a view only controls access to the items of its cache, cacheless
+			// views read from their sources using the requesting identity.
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userViewPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type User @policy(
+						id: "{{.Policy0}}",
+						resource: "users"
+					) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Identity: testUtils.ClientIdentity(1),
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @policy(
+						id: "4310849cc3aef1c7895d585fc80fd8bc0ee399c8a40ad4071565b9c8de9fd657",
+						resource: "userView"
+					) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Identity: testUtils.ClientIdentity(1),
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.RefreshViews{
+				Identity: testUtils.ClientIdentity(1),
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+							User {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"User": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{},
+				},
+			},
+			testUtils.AddDACActorRelationship{
+				RequestorIdentity: testUtils.ClientIdentity(1),
+				TargetIdentity:    testUtils.ClientIdentity(2),
+				CollectionID:      1,
+				DocID:             -1,
+				Relation:          "reader",
+				ExpectedExistence: false,
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleWithPolicy_RefreshWithoutUpdatePermission_DoesNotRefresh(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userViewPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Identity: testUtils.ClientIdentity(1),
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @policy(
+						id: "4310849cc3aef1c7895d585fc80fd8bc0ee399c8a40ad4071565b9c8de9fd657",
+						resource: "userView"
+					) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.RefreshViews{
+				// The second identity does not have the update permission of the view, so the
+				// cache of the view is left as is.
+				Identity: testUtils.ClientIdentity(2),
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{},
+				},
+			},
+			testUtils.RefreshViews{
+				Identity: testUtils.ClientIdentity(1),
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(1),
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleWithPolicy_ReadWithoutReadPermission_ReturnsNoItems(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			testUtils.MaterializedViewType,
+		}),
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userViewPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Identity: testUtils.ClientIdentity(1),
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @policy(
+						id: "4310849cc3aef1c7895d585fc80fd8bc0ee399c8a40ad4071565b9c8de9fd657",
+						resource: "userView"
+					) {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.RefreshViews{
+				Identity: testUtils.ClientIdentity(1),
+			},
+			testUtils.Request{
+				// The second identity may read the source documents, but not the items of the view.
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+							UserView {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"UserView": []map[string]any{},
+				},
+			},
+			testUtils.Request{
+				Identity: testUtils.ClientIdentity(2),
+				Request: `query {
+							User {
+								name
+							}
+						}`,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestView_SimpleWithPolicyAutoRefreshed_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		SupportedViewTypes: immutable.Some([]testUtils.ViewType{
+			// The MaterializedViewType would add a second @materialized directive to the view.
+			testUtils.CachelessViewType,
+		}),
+		Actions: []any{
+			testUtils.AddDACPolicy{
+				Identity: testUtils.ClientIdentity(1),
+				Policy:   userViewPolicy,
+			},
+			&action.AddSchema{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateView{
+				Identity: testUtils.ClientIdentity(1),
+				Query: `
+					User {
+						name
+					}
+				`,
+				SDL: `
+					type UserView @materialized(autoRefresh: true) @policy(
+						id: "4310849cc3aef1c7895d585fc80fd8bc0ee399c8a40ad4071565b9c8de9fd657",
+						resource: "userView"
+					) {
+						name: String
+					}
+				`,
+				ExpectedError: "views with a policy may not be auto refreshed",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
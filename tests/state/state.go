@@ -122,6 +122,9 @@ type EventState struct {
 
 	// Replicator is the `event.ReplicatorCompletedName` subscription
 	Replicator event.Subscription
+
+	// ViewRefresh is the `event.ViewRefreshName` subscription
+	ViewRefresh event.Subscription
 }
 
 // NewEventState returns an eventState with all required subscriptions.
@@ -138,10 +141,15 @@ func NewEventState(bus event.Bus) (*EventState, error) {
 	if err != nil {
 		return nil, err
 	}
+	viewRefresh, err := bus.Subscribe(event.ViewRefreshName)
+	if err != nil {
+		return nil, err
+	}
 	return &EventState{
-		Merge:      merge,
-		Update:     update,
-		Replicator: replicator,
+		Merge:       merge,
+		Update:      update,
+		Replicator:  replicator,
+		ViewRefresh: viewRefresh,
 	}, nil
 }
 
@@ -167,6 +175,15 @@ type NodeState struct {
 }
 
 // State contains all testing State.
+// Bundle is an offline bundle exported during a test.
+type Bundle struct {
+	// Filepath is the location of the bundle file.
+	Filepath string
+
+	// Checkpoint is the checkpoint returned by the export of the bundle.
+	Checkpoint string
+}
+
 type State struct {
 	// The test context.
 	Ctx context.Context
@@ -202,6 +219,14 @@ type State struct {
 	// The seed for the next identity generation. We want identities to be deterministic.
 	NextIdentityGenSeed int
 
+	// The capability tokens created in this test, by capability token index (in the order
+	// they were created).
+	CapabilityTokens []string
+
+	// The offline bundles exported in this test, by bundle index (in the order they
+	// were exported).
+	Bundles []Bundle
+
 	// Policy IDs, by node index, by policyID index (in the order they were added).
 	//
 	// Note: In case acp type is sourcehub, all nodes will have the same state of PolicyIDs.
@@ -294,6 +319,7 @@ func NewState(
 		CollectionNames:                 collectionNames,
 		CollectionIndexesByCollectionID: map[string]int{},
 		DocIDs:                          [][]client.DocID{},
+		Bundles:                         []Bundle{},
 		PolicyIDs:                       [][]string{},
 		IsBench:                         false,
 	}